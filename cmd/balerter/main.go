@@ -143,10 +143,12 @@ func run(
 
 	// ChannelsManager
 	lgr.Logger().Info("init channels manager")
-	channelsMgr := channelsManager.New(lgr.Logger())
+	channelsMgr := channelsManager.New(coreStorageKV.KV(), lgr.Logger())
+	channelsMgr.SetUploader(uploadStoragesMgr)
 	if err = channelsMgr.Init(cfg.Channels, version); err != nil {
 		return fmt.Sprintf("error init channels manager, %v", err), 1
 	}
+	channelsMgr.HealthCheck(ctx, wg)
 
 	coreModules := initCoreModules(coreStorageAlert, coreStorageKV, channelsMgr, lgr.Logger(), flg)
 
@@ -194,6 +196,9 @@ func run(
 	lgr.Logger().Info("run runner")
 	go rnr.Watch(ctx, ctxCancel, flg.Once)
 
+	channelsMgr.Listen(ctx, wg, rnr)
+	channelsMgr.Resend(ctx, wg, coreStorageAlert.Alert())
+
 	// ---------------------
 	// |
 	// | API
@@ -241,6 +246,7 @@ func run(
 
 	wg.Wait()
 
+	channelsMgr.Stop()
 	dsMgr.Stop()
 	coreStoragesMgr.Stop()
 
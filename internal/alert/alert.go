@@ -2,6 +2,8 @@ package alert
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 	"strconv"
 	"time"
 )
@@ -13,6 +15,40 @@ type Options struct {
 	Repeat   int               `json:"repeat"`
 	Image    string            `json:"image"`
 	Fields   map[string]string `json:"fields"`
+	// Attachments are the files sent with the alert message
+	Attachments []Attachment `json:"attachments,omitempty"`
+	// Links are rendered as the buttons or the links by the channels, e.g. a runbook or a dashboard
+	Links []Link `json:"links,omitempty"`
+	// Script is the name of the script which sent the alert, empty for alerts from the API
+	Script string `json:"script,omitempty"`
+}
+
+// Attachment is the file sent with the alert message. The channels which support uploads send it natively,
+// others send the link to the file in the upload storage
+type Attachment struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Data        []byte `json:"data"`
+	// URL is the link to the file in the upload storage, it is set after the upload
+	URL string `json:"url,omitempty"`
+}
+
+// Link is the labeled URL of the alert message
+type Link struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+// Validate checks the link label is not empty and the URL is absolute http(s) URL
+func (l Link) Validate() error {
+	if l.Label == "" {
+		return fmt.Errorf("link label must be not empty")
+	}
+	u, err := url.Parse(l.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("link url must be an absolute http or https url, %s", l.URL)
+	}
+	return nil
 }
 
 func NewOptions() *Options {
@@ -2,6 +2,7 @@ package alert
 
 import (
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -160,3 +161,18 @@ func TestNew(t *testing.T) {
 	assert.Equal(t, "foo", a.Name)
 	assert.Equal(t, LevelSuccess, a.Level)
 }
+
+func TestLink_Validate(t *testing.T) {
+	assert.NoError(t, Link{Label: "Runbook", URL: "https://wiki.example.com/runbook"}.Validate())
+	assert.NoError(t, Link{Label: "Logs", URL: "http://logs.example.com/?q=a"}.Validate())
+
+	err := Link{URL: "https://example.com"}.Validate()
+	require.Error(t, err)
+	assert.Equal(t, "link label must be not empty", err.Error())
+
+	for _, u := range []string{"", "example.com", "ftp://example.com", "https://", "javascript:alert(1)"} {
+		err = Link{Label: "a", URL: u}.Validate()
+		require.Error(t, err, u)
+		assert.Equal(t, "link url must be an absolute http or https url, "+u, err.Error())
+	}
+}
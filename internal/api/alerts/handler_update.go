@@ -17,6 +17,8 @@ type alertUpdatePayload struct {
 	Quiet    bool     `json:"quiet,omitempty"`
 	Repeat   int      `json:"repeat,omitempty"`
 	Image    string   `json:"image,omitempty"`
+	// Links are rendered as the buttons or the links, e.g. a runbook or a dashboard
+	Links []alert.Link `json:"links,omitempty"`
 }
 
 func (a *Alerts) handlerUpdate(rw http.ResponseWriter, req *http.Request) {
@@ -44,6 +46,13 @@ func (a *Alerts) handlerUpdate(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	for _, link := range payload.Links {
+		if err := link.Validate(); err != nil {
+			http.Error(rw, fmt.Sprintf("error validate links, %v", err), http.StatusBadRequest)
+			return
+		}
+	}
+
 	l, err := alert.LevelFromString(payload.Level)
 	if err != nil {
 		http.Error(rw, fmt.Sprintf("error parse level %s, %v", payload.Level, err), http.StatusBadRequest)
@@ -63,6 +72,7 @@ func (a *Alerts) handlerUpdate(rw http.ResponseWriter, req *http.Request) {
 			Quiet:    payload.Quiet,
 			Repeat:   payload.Repeat,
 			Image:    payload.Image,
+			Links:    payload.Links,
 		})
 	}
 
@@ -257,3 +257,49 @@ func TestHandlerUpdate_resend(t *testing.T) {
 
 	ch.AssertExpectations(t)
 }
+
+func TestHandlerUpdate_links(t *testing.T) {
+	al := &alert2.Alert{Name: "1", Level: alert2.LevelError}
+
+	m := &corestorage.AlertMock{
+		UpdateFunc: func(name string, level alert2.Level) (*alert2.Alert, bool, error) {
+			return al, true, nil
+		},
+	}
+
+	ch := &chManagerMock{}
+	ch.On("Send", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	a := Alerts{
+		alertManager: m,
+		chManager:    ch,
+		logger:       zap.NewNop(),
+	}
+
+	chiCtx := chi.NewRouteContext()
+	chiCtx.URLParams.Add("name", "foo")
+	ctx := context.WithValue(context.Background(), chi.RouteCtxKey, chiCtx)
+
+	mr := bytes.NewBuffer([]byte(`{"level":"error","text":"a","links":[{"label":"Runbook","url":"https://wiki.example.com/rb"}]}`))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", mr)
+	require.NoError(t, err)
+
+	rw := httptest.NewRecorder()
+	a.handlerUpdate(rw, req)
+
+	assert.Equal(t, 200, rw.Code)
+	require.Equal(t, 1, len(ch.Calls))
+	opts := ch.Calls[0].Arguments.Get(2).(*alert2.Options)
+	assert.Equal(t, []alert2.Link{{Label: "Runbook", URL: "https://wiki.example.com/rb"}}, opts.Links)
+
+	mr = bytes.NewBuffer([]byte(`{"level":"error","text":"a","links":[{"label":"Runbook","url":"wiki"}]}`))
+	req, err = http.NewRequestWithContext(ctx, http.MethodPost, "/", mr)
+	require.NoError(t, err)
+
+	rw = httptest.NewRecorder()
+	a.handlerUpdate(rw, req)
+
+	assert.Equal(t, 400, rw.Code)
+	assert.Equal(t, "error validate links, link url must be an absolute http or https url, wiki\n", rw.Body.String())
+	assert.Equal(t, 1, len(ch.Calls))
+}
@@ -0,0 +1,35 @@
+package channels
+
+import (
+	chmanager "github.com/balerter/balerter/internal/chmanager"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+)
+
+// ChManager represents interface of the Channel Manager
+type ChManager interface {
+	SendTest(name, text string) error
+	Health() map[string]chmanager.Health
+}
+
+// Channels represents channels API module
+type Channels struct {
+	chManager ChManager
+	logger    *zap.Logger
+}
+
+// New creates new Channels API module
+func New(chManager ChManager, logger *zap.Logger) *Channels {
+	c := &Channels{
+		chManager: chManager,
+		logger:    logger,
+	}
+
+	return c
+}
+
+// Handler creates API handlers for Channels API module
+func (c *Channels) Handler(r chi.Router) {
+	r.Get("/health", c.handlerHealth)
+	r.Post("/{name}/test", c.handlerTest)
+}
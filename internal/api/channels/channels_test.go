@@ -0,0 +1,12 @@
+package channels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	c := New(nil, nil)
+	assert.IsType(t, &Channels{}, c)
+}
@@ -0,0 +1,22 @@
+package channels
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// GET /api/v1/channels/health returns the last health check results by the channel name.
+// The channels without the health check are not listed
+func (c *Channels) handlerHealth(rw http.ResponseWriter, _ *http.Request) {
+	buf, err := json.Marshal(c.chManager.Health())
+	if err != nil {
+		c.logger.Error("error marshal health", zap.Error(err))
+		http.Error(rw, "error marshal data", http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Write(buf)
+}
@@ -0,0 +1,36 @@
+package channels
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	chmanager "github.com/balerter/balerter/internal/chmanager"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestHandlerHealth(t *testing.T) {
+	checkedAt := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	m := &chManagerMock{}
+	m.On("Health").Return(map[string]chmanager.Health{
+		"slack1": {Healthy: true, CheckedAt: checkedAt},
+		"email1": {Healthy: false, Error: "error connect to smtp server", CheckedAt: checkedAt},
+	})
+
+	r := chi.NewRouter()
+	r.Route("/channels", New(m, zap.NewNop()).Handler)
+	rw := httptest.NewRecorder()
+	r.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/channels/health", nil))
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "application/json", rw.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"email1": {"healthy": false, "error": "error connect to smtp server", "checked_at": "2022-01-02T03:04:05Z"},
+		"slack1": {"healthy": true, "checked_at": "2022-01-02T03:04:05Z"}
+	}`, rw.Body.String())
+	m.AssertExpectations(t)
+}
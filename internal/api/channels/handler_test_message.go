@@ -0,0 +1,73 @@
+package channels
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	chmanager "github.com/balerter/balerter/internal/chmanager"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+)
+
+type testPayload struct {
+	Text string `json:"text"`
+}
+
+type testResult struct {
+	Channel   string `json:"channel"`
+	Delivered bool   `json:"delivered"`
+	Error     string `json:"error,omitempty"`
+}
+
+// handlerTest sends the test message to the channel. The body is optional: {"text": "..."}
+func (c *Channels) handlerTest(rw http.ResponseWriter, req *http.Request) {
+	channelName := chi.URLParam(req, "name")
+	if channelName == "" {
+		http.Error(rw, "empty name", http.StatusBadRequest)
+		return
+	}
+
+	defer req.Body.Close()
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		c.logger.Error("error read body", zap.Error(err))
+		http.Error(rw, "error read body", http.StatusInternalServerError)
+		return
+	}
+
+	payload := &testPayload{}
+
+	if len(buf) > 0 {
+		if err := json.Unmarshal(buf, payload); err != nil {
+			http.Error(rw, fmt.Sprintf("error unmarshal body, %v", err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	res := &testResult{
+		Channel:   channelName,
+		Delivered: true,
+	}
+
+	err = c.chManager.SendTest(channelName, payload.Text)
+	if errors.Is(err, chmanager.ErrChannelNotFound) {
+		http.Error(rw, "channel not found", http.StatusNotFound)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+
+	if err != nil {
+		res.Delivered = false
+		res.Error = err.Error()
+		rw.WriteHeader(http.StatusBadGateway)
+	}
+
+	if err := json.NewEncoder(rw).Encode(res); err != nil {
+		c.logger.Error("error write response", zap.Error(err))
+	}
+}
@@ -0,0 +1,91 @@
+package channels
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chmanager "github.com/balerter/balerter/internal/chmanager"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+type chManagerMock struct {
+	mock.Mock
+}
+
+func (m *chManagerMock) SendTest(name, text string) error {
+	args := m.Called(name, text)
+	return args.Error(0)
+}
+
+func (m *chManagerMock) Health() map[string]chmanager.Health {
+	args := m.Called()
+	return args.Get(0).(map[string]chmanager.Health)
+}
+
+func serve(c *Channels, path, body string) *httptest.ResponseRecorder {
+	r := chi.NewRouter()
+	r.Route("/channels", c.Handler)
+
+	rw := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+	r.ServeHTTP(rw, req)
+
+	return rw
+}
+
+func TestHandlerTest(t *testing.T) {
+	m := &chManagerMock{}
+	m.On("SendTest", "foo", "hello").Return(nil)
+
+	rw := serve(New(m, zap.NewNop()), "/channels/foo/test", `{"text":"hello"}`)
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, "{\"channel\":\"foo\",\"delivered\":true}\n", rw.Body.String())
+	m.AssertExpectations(t)
+}
+
+func TestHandlerTest_empty_body(t *testing.T) {
+	m := &chManagerMock{}
+	m.On("SendTest", "foo", "").Return(nil)
+
+	rw := serve(New(m, zap.NewNop()), "/channels/foo/test", "")
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	m.AssertExpectations(t)
+}
+
+func TestHandlerTest_bad_body(t *testing.T) {
+	m := &chManagerMock{}
+
+	rw := serve(New(m, zap.NewNop()), "/channels/foo/test", "{")
+
+	assert.Equal(t, http.StatusBadRequest, rw.Code)
+	assert.Equal(t, "error unmarshal body, unexpected end of JSON input\n", rw.Body.String())
+	m.AssertNotCalled(t, "SendTest", mock.Anything, mock.Anything)
+}
+
+func TestHandlerTest_not_found(t *testing.T) {
+	m := &chManagerMock{}
+	m.On("SendTest", "foo", "").Return(chmanager.ErrChannelNotFound)
+
+	rw := serve(New(m, zap.NewNop()), "/channels/foo/test", "")
+
+	assert.Equal(t, http.StatusNotFound, rw.Code)
+	assert.Equal(t, "channel not found\n", rw.Body.String())
+}
+
+func TestHandlerTest_error(t *testing.T) {
+	m := &chManagerMock{}
+	m.On("SendTest", "foo", "").Return(fmt.Errorf("err1"))
+
+	rw := serve(New(m, zap.NewNop()), "/channels/foo/test", "")
+
+	assert.Equal(t, http.StatusBadGateway, rw.Code)
+	assert.Equal(t, "{\"channel\":\"foo\",\"delivered\":false,\"error\":\"err1\"}\n", rw.Body.String())
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"github.com/balerter/balerter/internal/alert"
 	"github.com/balerter/balerter/internal/api/alerts"
+	"github.com/balerter/balerter/internal/api/channels"
 	"github.com/balerter/balerter/internal/api/kv"
 	"github.com/balerter/balerter/internal/api/runtime"
+	chmanager "github.com/balerter/balerter/internal/chmanager"
 	coreStorage "github.com/balerter/balerter/internal/corestorage"
 	"github.com/go-chi/chi"
 	"go.uber.org/zap"
@@ -18,6 +20,8 @@ import (
 // ChManager is an interface for channel manager
 type ChManager interface {
 	Send(a *alert.Alert, text string, options *alert.Options)
+	SendTest(name, text string) error
+	Health() map[string]chmanager.Health
 }
 
 // httpServer is an interface for http server
@@ -50,6 +54,7 @@ func New(
 	alertsRouter := alerts.New(coreStorageAlert.Alert(), chManager, logger)
 	kvRouter := kv.New(coreStorageKV.KV(), logger)
 	runtimeRouter := runtime.New(runner, logger)
+	channelsRouter := channels.New(chManager, logger)
 
 	router := chi.NewRouter()
 
@@ -57,6 +62,7 @@ func New(
 		r.Route("/alerts", alertsRouter.Handler)
 		r.Route("/kv", kvRouter.Handler)
 		r.Route("/runtime", runtimeRouter.Handler)
+		r.Route("/channels", channelsRouter.Handler)
 	})
 
 	api := &API{
@@ -1,16 +1,26 @@
 package alertmanager
 
 import (
+	"context"
 	"github.com/balerter/balerter/internal/channels/webhook"
 	"github.com/balerter/balerter/internal/config/channels/alertmanager"
+	"github.com/balerter/balerter/internal/corestorage"
 	"github.com/balerter/balerter/internal/message"
 	"go.uber.org/zap"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultResendInterval = time.Minute
 )
 
 type webHookCore interface {
 	Send(body io.Reader, m *message.Message) (*http.Response, error)
+	Check(ctx context.Context) error
 }
 
 // AlertManager represents AlertManager
@@ -19,20 +29,66 @@ type AlertManager struct {
 	whCore webHookCore
 	logger *zap.Logger
 	ignore bool
+
+	labels         map[string]struct{}
+	staticLabels   map[string]string
+	generatorURL   string
+	resendInterval time.Duration
+	// kv keeps the last sent active alerts for re-sending. May be nil
+	kv corestorage.KV
 }
 
 // New creates new AlertManager
-func New(cfg alertmanager.Alertmanager, version string, logger *zap.Logger) (*AlertManager, error) {
+func New(cfg alertmanager.Alertmanager, kv corestorage.KV, version string, logger *zap.Logger) (*AlertManager, error) {
+	cfg.Settings.URL = alertsURL(cfg.Settings.URL, cfg.APIVersion)
+	if strings.TrimSpace(cfg.Settings.Method) == "" {
+		cfg.Settings.Method = http.MethodPost
+	}
+
+	core, err := webhook.NewCore(cfg.Settings, version)
+	if err != nil {
+		return nil, err
+	}
+
 	a := &AlertManager{
-		name:   cfg.Name,
-		logger: logger,
-		whCore: webhook.NewCore(cfg.Settings, version),
-		ignore: cfg.Ignore,
+		name:           cfg.Name,
+		logger:         logger,
+		whCore:         core,
+		ignore:         cfg.Ignore,
+		labels:         make(map[string]struct{}, len(cfg.Labels)),
+		staticLabels:   cfg.StaticLabels,
+		generatorURL:   strings.TrimRight(cfg.GeneratorURL, "/"),
+		resendInterval: time.Millisecond * time.Duration(cfg.ResendInterval),
+		kv:             kv,
+	}
+
+	for _, l := range cfg.Labels {
+		a.labels[l] = struct{}{}
+	}
+
+	if a.resendInterval == 0 {
+		a.resendInterval = defaultResendInterval
 	}
 
 	return a, nil
 }
 
+// alertsURL adds the alerts api path of the version to the url without a path
+func alertsURL(u, version string) string {
+	parsed, err := url.Parse(u)
+	if err != nil || (parsed.Path != "" && parsed.Path != "/") {
+		return u
+	}
+
+	if version == "" {
+		version = alertmanager.APIVersionV1
+	}
+
+	parsed.Path = "/api/" + version + "/alerts"
+
+	return parsed.String()
+}
+
 // Name returns name of the AlertManager
 func (a *AlertManager) Name() string {
 	return a.name
@@ -41,3 +97,8 @@ func (a *AlertManager) Name() string {
 func (a *AlertManager) Ignore() bool {
 	return a.ignore
 }
+
+// Check checks the Alertmanager url is available
+func (a *AlertManager) Check(ctx context.Context) error {
+	return a.whCore.Check(ctx)
+}
@@ -1,16 +1,40 @@
 package alertmanager
 
 import (
+	"context"
+	"fmt"
 	"github.com/balerter/balerter/internal/config/channels/alertmanager"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
-	a, err := New(alertmanager.Alertmanager{}, "", nil)
+	a, err := New(alertmanager.Alertmanager{}, nil, "", nil)
 	require.NoError(t, err)
 	assert.IsType(t, &AlertManager{}, a)
+	assert.Equal(t, defaultResendInterval, a.resendInterval)
+}
+
+func TestNew_Options(t *testing.T) {
+	a, err := New(alertmanager.Alertmanager{
+		Labels:         []string{"host"},
+		GeneratorURL:   "http://balerter:2000/",
+		ResendInterval: 1000,
+	}, nil, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"host": {}}, a.labels)
+	assert.Equal(t, "http://balerter:2000", a.generatorURL)
+	assert.Equal(t, time.Second, a.resendInterval)
+}
+
+func Test_alertsURL(t *testing.T) {
+	assert.Equal(t, "http://127.0.0.1:9093/api/v1/alerts", alertsURL("http://127.0.0.1:9093", ""))
+	assert.Equal(t, "http://127.0.0.1:9093/api/v1/alerts", alertsURL("http://127.0.0.1:9093/", "v1"))
+	assert.Equal(t, "http://127.0.0.1:9093/api/v2/alerts", alertsURL("http://127.0.0.1:9093", "v2"))
+	assert.Equal(t, "http://127.0.0.1:9093/foo/api/v2/alerts", alertsURL("http://127.0.0.1:9093/foo/api/v2/alerts", "v1"))
 }
 
 func TestName(t *testing.T) {
@@ -27,3 +51,14 @@ func TestAlertManager_Ignore2(t *testing.T) {
 	a := &AlertManager{ignore: true}
 	assert.True(t, a.Ignore())
 }
+
+func TestAlertManager_Check(t *testing.T) {
+	m := &webHookCoreMock{}
+	m.On("Check", mock.Anything).Return(fmt.Errorf("err1"))
+
+	a := &AlertManager{whCore: m}
+
+	err := a.Check(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, "err1", err.Error())
+}
@@ -0,0 +1,122 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/corestorage"
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+/*
+Alertmanager resolves an alert after its endsAt time, so firing alerts must be re-sent periodically.
+The last sent firing alert is kept in the KV storage and is re-sent while the alert is active in the alert storage
+*/
+
+func (a *AlertManager) keyPrefix() string {
+	return "alertmanager:" + a.name + ":"
+}
+
+// remember saves the firing alert for re-sending, the resolved alert is removed
+func (a *AlertManager) remember(alertName string, promAlert *modelAlert) {
+	if a.kv == nil {
+		return
+	}
+
+	key := a.keyPrefix() + alertName
+
+	if !promAlert.EndsAt.IsZero() && !promAlert.EndsAt.After(time.Now()) {
+		if err := a.kv.Delete(key); err != nil {
+			a.logger.Debug("error delete alert", zap.String("alert name", alertName), zap.Error(err))
+		}
+		return
+	}
+
+	data, err := json.Marshal(promAlert)
+	if err != nil {
+		a.logger.Error("error marshal alert", zap.String("alert name", alertName), zap.Error(err))
+		return
+	}
+
+	if err := a.kv.Upsert(key, string(data)); err != nil {
+		a.logger.Error("error save alert", zap.String("alert name", alertName), zap.Error(err))
+	}
+}
+
+// Resend re-sends the active alerts every resend interval, until the ctx is done
+func (a *AlertManager) Resend(ctx context.Context, alerts corestorage.Alert) {
+	if a.kv == nil {
+		return
+	}
+
+	ticker := time.NewTicker(a.resendInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.resend(alerts, time.Now()); err != nil {
+				a.logger.Error("error resend alerts", zap.String("channel name", a.name), zap.Error(err))
+			}
+		}
+	}
+}
+
+// resend posts the saved alerts which are active in the alert storage and removes the others
+func (a *AlertManager) resend(alerts corestorage.Alert, now time.Time) error {
+	active, err := alerts.Index([]alert.Level{alert.LevelWarn, alert.LevelError})
+	if err != nil {
+		return fmt.Errorf("error get active alerts, %w", err)
+	}
+
+	activeNames := make(map[string]struct{}, len(active))
+	for _, al := range active {
+		activeNames[al.Name] = struct{}{}
+	}
+
+	all, err := a.kv.All()
+	if err != nil {
+		return fmt.Errorf("error get saved alerts, %w", err)
+	}
+
+	prefix := a.keyPrefix()
+
+	var promAlerts []*modelAlert
+
+	for key, value := range all {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		alertName := strings.TrimPrefix(key, prefix)
+
+		if _, ok := activeNames[alertName]; !ok {
+			if err := a.kv.Delete(key); err != nil {
+				a.logger.Debug("error delete alert", zap.String("alert name", alertName), zap.Error(err))
+			}
+			continue
+		}
+
+		promAlert := newPromAlert()
+		if err := json.Unmarshal([]byte(value), promAlert); err != nil {
+			a.logger.Error("error unmarshal alert", zap.String("alert name", alertName), zap.Error(err))
+			continue
+		}
+		promAlert.EndsAt = a.endsAt(now)
+
+		promAlerts = append(promAlerts, promAlert)
+	}
+
+	if len(promAlerts) == 0 {
+		return nil
+	}
+
+	return a.post(promAlerts, &message.Message{})
+}
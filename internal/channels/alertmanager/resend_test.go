@@ -0,0 +1,107 @@
+package alertmanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/corestorage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newAlerts(names ...string) *corestorage.AlertMock {
+	return &corestorage.AlertMock{
+		IndexFunc: func(levels []alert.Level) (alert.Alerts, error) {
+			var res alert.Alerts
+			for _, name := range names {
+				a := alert.New(name)
+				a.Level = alert.LevelError
+				res = append(res, a)
+			}
+			return res, nil
+		},
+	}
+}
+
+func TestAlertManager_resend(t *testing.T) {
+	m := &webHookCoreMock{}
+
+	kvData := map[string]string{
+		"alertmanager:am:foo":    `{"labels":{"name":"foo"},"annotations":{"description":"text"}}`,
+		"alertmanager:am:bar":    `{"labels":{"name":"bar"},"annotations":{}}`,
+		"alertmanager:other:baz": `{"labels":{"name":"baz"},"annotations":{}}`,
+	}
+
+	a := &AlertManager{
+		name:           "am",
+		whCore:         m,
+		logger:         zap.NewNop(),
+		resendInterval: time.Minute,
+		kv:             newKV(kvData),
+	}
+
+	var body []byte
+	m.On("Send", mock.Anything, mock.Anything).Return(&http.Response{
+		Body:       io.NopCloser(bytes.NewBuffer(nil)),
+		StatusCode: 200,
+	}, nil).Run(func(args mock.Arguments) {
+		body, _ = io.ReadAll(args.Get(0).(io.Reader))
+	})
+
+	now := time.Now()
+
+	err := a.resend(newAlerts("foo", "baz"), now)
+	require.NoError(t, err)
+
+	var sent []*modelAlert
+	require.NoError(t, json.Unmarshal(body, &sent))
+	require.Len(t, sent, 1)
+	assert.Equal(t, "foo", sent[0].Labels["name"])
+	assert.Equal(t, "text", sent[0].Annotations["description"])
+	assert.True(t, sent[0].EndsAt.Equal(now.Add(time.Minute*4)))
+
+	assert.NotContains(t, kvData, "alertmanager:am:bar")
+	assert.Contains(t, kvData, "alertmanager:am:foo")
+	assert.Contains(t, kvData, "alertmanager:other:baz")
+}
+
+func TestAlertManager_resend_empty(t *testing.T) {
+	m := &webHookCoreMock{}
+
+	a := &AlertManager{
+		name:   "am",
+		whCore: m,
+		logger: zap.NewNop(),
+		kv:     newKV(map[string]string{}),
+	}
+
+	err := a.resend(newAlerts("foo"), time.Now())
+	require.NoError(t, err)
+	m.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestAlertManager_resend_error(t *testing.T) {
+	a := &AlertManager{
+		name: "am",
+		kv:   newKV(map[string]string{}),
+	}
+
+	alerts := &corestorage.AlertMock{
+		IndexFunc: func(levels []alert.Level) (alert.Alerts, error) {
+			return nil, fmt.Errorf("err1")
+		},
+	}
+
+	err := a.resend(alerts, time.Now())
+	require.Error(t, err)
+	assert.Equal(t, "error get active alerts, err1", err.Error())
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/balerter/balerter/internal/message"
@@ -30,17 +31,69 @@ func newPromAlert() *modelAlert {
 
 // Send a message to AlertManager
 func (a *AlertManager) Send(mes *message.Message) error {
-	promAlert := newPromAlert()
+	now := time.Now()
+
+	promAlert := a.newAlert(mes)
 
-	// TODO (negasus): After refactoring with pass Alert to 'send' method, this condition should be refactoring
 	if mes.Level == "success" {
-		promAlert.EndsAt = time.Now()
+		promAlert.EndsAt = now
+	} else {
+		promAlert.EndsAt = a.endsAt(now)
+	}
+
+	if err := a.post([]*modelAlert{promAlert}, mes); err != nil {
+		return err
+	}
+
+	a.remember(mes.AlertName, promAlert)
+
+	return nil
+}
+
+// newAlert maps the message to the alert. The message fields from the labels list are sent as labels,
+// other fields are sent as annotations
+func (a *AlertManager) newAlert(mes *message.Message) *modelAlert {
+	promAlert := newPromAlert()
+
+	for k, v := range a.staticLabels {
+		promAlert.Labels[k] = v
+	}
+
+	for k, v := range mes.Fields {
+		if _, ok := a.labels[k]; ok {
+			promAlert.Labels[k] = v
+			continue
+		}
+		promAlert.Annotations[k] = v
 	}
 
 	promAlert.Labels["name"] = mes.AlertName
+	promAlert.Labels["alertname"] = mes.AlertName
 	promAlert.Annotations["description"] = mes.Text
+	promAlert.Annotations["level"] = mes.Level
+
+	if mes.Script != "" {
+		promAlert.Annotations["script"] = mes.Script
+	}
+
+	if mes.Alert != nil {
+		promAlert.StartsAt = mes.Alert.LastChange
+	}
+
+	if a.generatorURL != "" {
+		promAlert.GeneratorURL = a.generatorURL + "/api/v1/alerts/" + url.PathEscape(mes.AlertName)
+	}
+
+	return promAlert
+}
+
+// endsAt returns the time until the firing alert is valid without re-sending
+func (a *AlertManager) endsAt(now time.Time) time.Time {
+	return now.Add(a.resendInterval * 4) //nolint:gomnd // allows to miss a few re-sends
+}
 
-	data, err := json.Marshal([]*modelAlert{promAlert})
+func (a *AlertManager) post(alerts []*modelAlert, mes *message.Message) error {
+	data, err := json.Marshal(alerts)
 	if err != nil {
 		return fmt.Errorf("error marshal prometheus alert, %w", err)
 	}
@@ -2,11 +2,16 @@ package alertmanager
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/corestorage"
 	"github.com/balerter/balerter/internal/message"
 
 	"github.com/stretchr/testify/assert"
@@ -27,6 +32,31 @@ func (mm *webHookCoreMock) Send(body io.Reader, m *message.Message) (*http.Respo
 	return args.Get(0).(*http.Response), args.Error(1)
 }
 
+func (mm *webHookCoreMock) Check(ctx context.Context) error {
+	args := mm.Called(ctx)
+	return args.Error(0)
+}
+
+func newKV(m map[string]string) *corestorage.KVMock {
+	return &corestorage.KVMock{
+		AllFunc: func() (map[string]string, error) {
+			r := map[string]string{}
+			for k, v := range m {
+				r[k] = v
+			}
+			return r, nil
+		},
+		UpsertFunc: func(k, v string) error {
+			m[k] = v
+			return nil
+		},
+		DeleteFunc: func(k string) error {
+			delete(m, k)
+			return nil
+		},
+	}
+}
+
 func Test_newPromAlert(t *testing.T) {
 	a := newPromAlert()
 	assert.IsType(t, &modelAlert{}, a)
@@ -106,3 +136,67 @@ func TestSend(t *testing.T) {
 	err := a.Send(mes)
 	require.NoError(t, err)
 }
+
+func TestAlertManager_newAlert(t *testing.T) {
+	a := &AlertManager{
+		labels:       map[string]struct{}{"host": {}},
+		staticLabels: map[string]string{"env": "prod"},
+		generatorURL: "http://balerter:2000",
+	}
+
+	al := alert.New("foo bar")
+
+	mes := &message.Message{
+		Level:     "error",
+		AlertName: "foo bar",
+		Text:      "text",
+		Fields:    map[string]string{"host": "h1", "value": "42"},
+		Script:    "s1",
+		Alert:     al,
+	}
+
+	promAlert := a.newAlert(mes)
+
+	assert.Equal(t, map[string]string{"env": "prod", "host": "h1", "name": "foo bar", "alertname": "foo bar"}, promAlert.Labels)
+	assert.Equal(t, map[string]string{"value": "42", "description": "text", "level": "error", "script": "s1"}, promAlert.Annotations)
+	assert.Equal(t, "http://balerter:2000/api/v1/alerts/foo%20bar", promAlert.GeneratorURL)
+	assert.Equal(t, al.LastChange, promAlert.StartsAt)
+	assert.True(t, promAlert.EndsAt.IsZero())
+}
+
+func TestSend_remember(t *testing.T) {
+	m := &webHookCoreMock{}
+
+	kvData := map[string]string{}
+
+	a := &AlertManager{
+		name:           "am",
+		whCore:         m,
+		logger:         zap.NewNop(),
+		resendInterval: time.Minute,
+		kv:             newKV(kvData),
+	}
+
+	var body []byte
+	m.On("Send", mock.Anything, mock.Anything).Return(&http.Response{
+		Body:       io.NopCloser(bytes.NewBuffer(nil)),
+		StatusCode: 200,
+	}, nil).Run(func(args mock.Arguments) {
+		body, _ = io.ReadAll(args.Get(0).(io.Reader))
+	})
+
+	err := a.Send(&message.Message{Level: "error", AlertName: "foo", Text: "bar"})
+	require.NoError(t, err)
+
+	var sent []*modelAlert
+	require.NoError(t, json.Unmarshal(body, &sent))
+	require.Len(t, sent, 1)
+	assert.True(t, sent[0].EndsAt.After(time.Now().Add(time.Minute*3)))
+
+	require.Contains(t, kvData, "alertmanager:am:foo")
+	assert.Contains(t, kvData["alertmanager:am:foo"], `"description":"bar"`)
+
+	err = a.Send(&message.Message{Level: "success", AlertName: "foo", Text: "bar"})
+	require.NoError(t, err)
+	assert.NotContains(t, kvData, "alertmanager:am:foo")
+}
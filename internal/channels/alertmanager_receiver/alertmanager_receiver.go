@@ -25,10 +25,15 @@ type AMReceiver struct {
 func New(cfg alertmanagerreceiver.AlertmanagerReceiver, version string, logger *zap.Logger) (*AMReceiver, error) {
 	//cfg.Settings.Headers["content-type"] = "application/json" // todo(negasus): init headers map?
 
+	core, err := webhook.NewCore(cfg.Settings, version)
+	if err != nil {
+		return nil, err
+	}
+
 	a := &AMReceiver{
 		name:   cfg.Name,
 		logger: logger,
-		whCore: webhook.NewCore(cfg.Settings, version),
+		whCore: core,
 		ignore: cfg.Ignore,
 	}
 
@@ -1,16 +1,28 @@
 package discord
 
 import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
 	discordCfg "github.com/balerter/balerter/internal/config/channels/discord"
+	"github.com/diamondburned/arikawa/api"
 	"github.com/diamondburned/arikawa/discord"
 	"github.com/diamondburned/arikawa/session"
+	"github.com/diamondburned/arikawa/webhook"
 	"go.uber.org/zap"
 )
 
 //go:generate moq -out module_mock_session.go -skip-ensure -fmt goimports . isession
+//go:generate moq -out module_mock_webhook.go -skip-ensure -fmt goimports . iwebhook
 
 type isession interface {
-	SendMessage(channelID discord.ChannelID, content string, embed *discord.Embed) (*discord.Message, error)
+	SendMessageComplex(channelID discord.ChannelID, data api.SendMessageData) (*discord.Message, error)
+}
+
+type iwebhook interface {
+	Execute(data api.ExecuteWebhookData) error
 }
 
 // Discord implements a Provider for discord notifications.
@@ -20,24 +32,58 @@ type Discord struct {
 	session isession
 	chanID  discord.ChannelID
 	ignore  bool
+
+	// webhook is used instead of the session in the webhook mode
+	webhook  iwebhook
+	mentions []discordCfg.Mention
 }
 
 // New returns the new Discord instance
 func New(cfg discordCfg.Discord, logger *zap.Logger) (*Discord, error) {
+	d := &Discord{
+		logger:   logger,
+		name:     cfg.Name,
+		chanID:   discord.ChannelID(cfg.ChannelID),
+		ignore:   cfg.Ignore,
+		mentions: cfg.Mentions,
+	}
+
+	if cfg.WebhookURL != "" {
+		id, token, err := parseWebhookURL(cfg.WebhookURL)
+		if err != nil {
+			return nil, err
+		}
+		d.webhook = webhook.NewClient(id, token)
+		return d, nil
+	}
+
 	s, err := session.New("Bot " + cfg.Token)
 	if err != nil {
 		return nil, err
 	}
+	d.session = s
 
-	d := &Discord{
-		logger:  logger,
-		name:    cfg.Name,
-		session: s,
-		chanID:  discord.ChannelID(cfg.ChannelID),
-		ignore:  cfg.Ignore,
+	return d, nil
+}
+
+// parseWebhookURL returns the webhook id and token from the URL '.../webhooks/<id>/<token>'
+func parseWebhookURL(u string) (discord.WebhookID, string, error) {
+	pu, err := url.Parse(u)
+	if err != nil {
+		return 0, "", fmt.Errorf("error parse webhook url, %w", err)
 	}
 
-	return d, nil
+	parts := strings.Split(strings.Trim(pu.Path, "/"), "/")
+	if len(parts) < 3 || parts[len(parts)-3] != "webhooks" {
+		return 0, "", fmt.Errorf("bad webhook url")
+	}
+
+	id, err := strconv.ParseUint(parts[len(parts)-2], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("error parse webhook id, %w", err)
+	}
+
+	return discord.WebhookID(id), parts[len(parts)-1], nil
 }
 
 // Name returns the Discord channel name
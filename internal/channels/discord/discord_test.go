@@ -25,3 +25,25 @@ func TestDiscord_Ignore(t *testing.T) {
 	d := &Discord{ignore: true}
 	assert.True(t, d.Ignore())
 }
+
+func TestNew_webhook(t *testing.T) {
+	d, err := New(discord.Discord{Name: "foo", WebhookURL: "https://discord.com/api/webhooks/123/abc"}, nil)
+	require.NoError(t, err)
+	assert.NotNil(t, d.webhook)
+	assert.Nil(t, d.session)
+}
+
+func Test_parseWebhookURL(t *testing.T) {
+	id, token, err := parseWebhookURL("https://discord.com/api/v8/webhooks/123/abc-DEF")
+	require.NoError(t, err)
+	assert.Equal(t, "123", id.String())
+	assert.Equal(t, "abc-DEF", token)
+
+	_, _, err = parseWebhookURL("https://discord.com/api/webhooks/abc")
+	require.Error(t, err)
+	assert.Equal(t, "bad webhook url", err.Error())
+
+	_, _, err = parseWebhookURL("https://discord.com/api/webhooks/abc/def")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error parse webhook id")
+}
@@ -6,6 +6,7 @@ package discord
 import (
 	"sync"
 
+	"github.com/diamondburned/arikawa/api"
 	"github.com/diamondburned/arikawa/discord"
 )
 
@@ -15,8 +16,8 @@ import (
 //
 // 		// make and configure a mocked isession
 // 		mockedisession := &isessionMock{
-// 			SendMessageFunc: func(channelID discord.ChannelID, content string, embed *discord.Embed) (*discord.Message, error) {
-// 				panic("mock out the SendMessage method")
+// 			SendMessageComplexFunc: func(channelID discord.ChannelID, data api.SendMessageData) (*discord.Message, error) {
+// 				panic("mock out the SendMessageComplex method")
 // 			},
 // 		}
 //
@@ -25,59 +26,54 @@ import (
 //
 // 	}
 type isessionMock struct {
-	// SendMessageFunc mocks the SendMessage method.
-	SendMessageFunc func(channelID discord.ChannelID, content string, embed *discord.Embed) (*discord.Message, error)
+	// SendMessageComplexFunc mocks the SendMessageComplex method.
+	SendMessageComplexFunc func(channelID discord.ChannelID, data api.SendMessageData) (*discord.Message, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// SendMessage holds details about calls to the SendMessage method.
-		SendMessage []struct {
+		// SendMessageComplex holds details about calls to the SendMessageComplex method.
+		SendMessageComplex []struct {
 			// ChannelID is the channelID argument value.
 			ChannelID discord.ChannelID
-			// Content is the content argument value.
-			Content string
-			// Embed is the embed argument value.
-			Embed *discord.Embed
+			// Data is the data argument value.
+			Data api.SendMessageData
 		}
 	}
-	lockSendMessage sync.RWMutex
+	lockSendMessageComplex sync.RWMutex
 }
 
-// SendMessage calls SendMessageFunc.
-func (mock *isessionMock) SendMessage(channelID discord.ChannelID, content string, embed *discord.Embed) (*discord.Message, error) {
-	if mock.SendMessageFunc == nil {
-		panic("isessionMock.SendMessageFunc: method is nil but isession.SendMessage was just called")
+// SendMessageComplex calls SendMessageComplexFunc.
+func (mock *isessionMock) SendMessageComplex(channelID discord.ChannelID, data api.SendMessageData) (*discord.Message, error) {
+	if mock.SendMessageComplexFunc == nil {
+		panic("isessionMock.SendMessageComplexFunc: method is nil but isession.SendMessageComplex was just called")
 	}
 	callInfo := struct {
 		ChannelID discord.ChannelID
-		Content   string
-		Embed     *discord.Embed
+		Data      api.SendMessageData
 	}{
 		ChannelID: channelID,
-		Content:   content,
-		Embed:     embed,
+		Data:      data,
 	}
-	mock.lockSendMessage.Lock()
-	mock.calls.SendMessage = append(mock.calls.SendMessage, callInfo)
-	mock.lockSendMessage.Unlock()
-	return mock.SendMessageFunc(channelID, content, embed)
+	mock.lockSendMessageComplex.Lock()
+	mock.calls.SendMessageComplex = append(mock.calls.SendMessageComplex, callInfo)
+	mock.lockSendMessageComplex.Unlock()
+	return mock.SendMessageComplexFunc(channelID, data)
 }
 
-// SendMessageCalls gets all the calls that were made to SendMessage.
+// SendMessageComplexCalls gets all the calls that were made to SendMessageComplex.
 // Check the length with:
-//     len(mockedisession.SendMessageCalls())
-func (mock *isessionMock) SendMessageCalls() []struct {
+//
+//     len(mockedisession.SendMessageComplexCalls())
+func (mock *isessionMock) SendMessageComplexCalls() []struct {
 	ChannelID discord.ChannelID
-	Content   string
-	Embed     *discord.Embed
+	Data      api.SendMessageData
 } {
 	var calls []struct {
 		ChannelID discord.ChannelID
-		Content   string
-		Embed     *discord.Embed
+		Data      api.SendMessageData
 	}
-	mock.lockSendMessage.RLock()
-	calls = mock.calls.SendMessage
-	mock.lockSendMessage.RUnlock()
+	mock.lockSendMessageComplex.RLock()
+	calls = mock.calls.SendMessageComplex
+	mock.lockSendMessageComplex.RUnlock()
 	return calls
 }
@@ -1,22 +1,153 @@
 package discord
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/balerter/balerter/internal/message"
+	"github.com/diamondburned/arikawa/api"
+	"github.com/diamondburned/arikawa/discord"
+)
+
+const (
+	// discord embed limits
+	maxTitleLen       = 256
+	maxDescriptionLen = 2048
+	maxFields         = 25
+	maxFieldNameLen   = 256
+	maxFieldValueLen  = 1024
+
+	imageName = "chart.png"
 )
 
 // Send implements
 func (d *Discord) Send(mes *message.Message) error {
-	if len(mes.Fields) > 0 {
-		mes.Text += "\n\n"
-		for k, v := range mes.Fields {
-			mes.Text += fmt.Sprintf("%s = %s\n", k, v)
-		}
+	embed, files := newEmbed(mes)
+	for _, a := range mes.Attachments {
+		files = append(files, api.SendMessageFile{
+			Name:   a.Name,
+			Reader: bytes.NewReader(a.Data),
+		})
 	}
+	content, allowed := d.mention(mes.Level)
 
-	_, err := d.session.SendMessage(d.chanID, mes.Text, nil)
+	if d.webhook != nil {
+		return d.webhook.Execute(api.ExecuteWebhookData{
+			Content:         content,
+			Embeds:          []discord.Embed{*embed},
+			Files:           files,
+			AllowedMentions: allowed,
+		})
+	}
+
+	_, err := d.session.SendMessageComplex(d.chanID, api.SendMessageData{
+		Content:         content,
+		Embed:           embed,
+		Files:           files,
+		AllowedMentions: allowed,
+	})
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// SupportsAttachments returns true, the attachments are uploaded with the message
+func (d *Discord) SupportsAttachments() bool {
+	return true
+}
+
+func levelColor(level string) discord.Color {
+	switch level {
+	case "success":
+		return 0x00aa00
+	case "warning":
+		return 0xffcc00
+	case "error":
+		return 0xff0000
+	}
+	return discord.DefaultEmbedColor
+}
+
+// newEmbed returns the embed of the message and the files to upload with it.
+// The image data is uploaded as the file, the image URL is used as is
+func newEmbed(mes *message.Message) (*discord.Embed, []api.SendMessageFile) {
+	embed := &discord.Embed{
+		Type:        discord.NormalEmbed,
+		Title:       truncate(mes.AlertName, maxTitleLen),
+		Description: truncate(mes.Text, maxDescriptionLen),
+		Color:       levelColor(mes.Level),
+	}
+
+	keys := make([]string, 0, len(mes.Fields))
+	for k := range mes.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(keys) > maxFields {
+		keys = keys[:maxFields]
+	}
+	for _, k := range keys {
+		embed.Fields = append(embed.Fields, discord.EmbedField{
+			Name:   truncate(k, maxFieldNameLen),
+			Value:  truncate(mes.Fields[k], maxFieldValueLen),
+			Inline: true,
+		})
+	}
+
+	if mes.Image == "" {
+		return embed, nil
+	}
+
+	if strings.HasPrefix(mes.Image, "http://") || strings.HasPrefix(mes.Image, "https://") {
+		embed.Image = &discord.EmbedImage{URL: discord.URL(mes.Image)}
+		return embed, nil
+	}
+
+	embed.Image = &discord.EmbedImage{URL: "attachment://" + imageName}
+	files := []api.SendMessageFile{{
+		Name:   imageName,
+		Reader: bytes.NewBufferString(mes.Image),
+	}}
+
+	return embed, files
+}
+
+// mention returns the message content with the mentions of the level and the allowed mentions.
+// Only the configured roles and users are allowed to be mentioned
+func (d *Discord) mention(level string) (string, *api.AllowedMentions) {
+	allowed := &api.AllowedMentions{
+		Parse: []api.AllowedMentionType{},
+	}
+
+	var content []string
+
+	for _, m := range d.mentions {
+		if m.Level != level {
+			continue
+		}
+		for _, id := range m.Roles {
+			allowed.Roles = append(allowed.Roles, discord.RoleID(id))
+			content = append(content, fmt.Sprintf("<@&%d>", id))
+		}
+		for _, id := range m.Users {
+			allowed.Users = append(allowed.Users, discord.UserID(id))
+			content = append(content, fmt.Sprintf("<@%d>", id))
+		}
+	}
+
+	return strings.Join(content, " "), allowed
+}
+
+// truncate cuts the string to n characters, as discord limits are counted in characters
+func truncate(s string, n int) string {
+	return message.Truncate(s, n, "…")
+}
+
+// TextLimit returns the limit of the message text, the text is sent as the embed description.
+// The long text is split into several messages
+func (d *Discord) TextLimit(*message.Message) message.Limit {
+	return message.Limit{MaxLength: maxDescriptionLen, Mode: message.LimitSplit}
+}
@@ -4,8 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
+	"github.com/balerter/balerter/internal/alert"
+	discordCfg "github.com/balerter/balerter/internal/config/channels/discord"
 	"github.com/balerter/balerter/internal/message"
 	"github.com/diamondburned/arikawa/api"
 	"github.com/diamondburned/arikawa/discord"
@@ -157,7 +161,7 @@ func User(u discord.User, id discord.UserID) discord.User {
 
 func TestTest_error_send(t *testing.T) {
 	m := &isessionMock{
-		SendMessageFunc: func(channelID discord.ChannelID, content string, embed *discord.Embed) (*discord.Message, error) {
+		SendMessageComplexFunc: func(channelID discord.ChannelID, data api.SendMessageData) (*discord.Message, error) {
 			return nil, fmt.Errorf("err1")
 		},
 	}
@@ -173,21 +177,147 @@ func TestTest_error_send(t *testing.T) {
 }
 
 func TestTest(t *testing.T) {
-	assertContent := "foo\n\na = b\n"
-
 	m := &isessionMock{
-		SendMessageFunc: func(channelID discord.ChannelID, content string, embed *discord.Embed) (*discord.Message, error) {
-			assert.Equal(t, assertContent, content)
+		SendMessageComplexFunc: func(channelID discord.ChannelID, data api.SendMessageData) (*discord.Message, error) {
 			return nil, nil
 		},
 	}
 	d := &Discord{
 		session: m,
+		chanID:  123,
+		mentions: []discordCfg.Mention{
+			{Level: "error", Roles: []int64{10}, Users: []int64{20}},
+			{Level: "warning", Users: []int64{30}},
+		},
+	}
+	mes := &message.Message{
+		Level:     "error",
+		AlertName: "alert1",
+		Text:      "foo",
+		Fields:    map[string]string{"b": "2", "a": "1"},
+	}
+	err := d.Send(mes)
+	assert.NoError(t, err)
+
+	require.Equal(t, 1, len(m.SendMessageComplexCalls()))
+	call := m.SendMessageComplexCalls()[0]
+	assert.Equal(t, discord.ChannelID(123), call.ChannelID)
+	assert.Equal(t, "<@&10> <@20>", call.Data.Content)
+	assert.Equal(t, &api.AllowedMentions{
+		Parse: []api.AllowedMentionType{},
+		Roles: []discord.RoleID{10},
+		Users: []discord.UserID{20},
+	}, call.Data.AllowedMentions)
+	assert.Equal(t, &discord.Embed{
+		Type:        discord.NormalEmbed,
+		Title:       "alert1",
+		Description: "foo",
+		Color:       0xff0000,
+		Fields: []discord.EmbedField{
+			{Name: "a", Value: "1", Inline: true},
+			{Name: "b", Value: "2", Inline: true},
+		},
+	}, call.Data.Embed)
+	assert.Empty(t, call.Data.Files)
+}
+
+func TestSend_webhook(t *testing.T) {
+	m := &iwebhookMock{
+		ExecuteFunc: func(data api.ExecuteWebhookData) error {
+			return nil
+		},
+	}
+	d := &Discord{
+		webhook: m,
 	}
 	mes := &message.Message{
-		Text:   "foo",
-		Fields: map[string]string{"a": "b"},
+		Level:     "success",
+		AlertName: "alert1",
+		Text:      "foo",
+		Image:     "image data",
 	}
 	err := d.Send(mes)
 	assert.NoError(t, err)
+
+	require.Equal(t, 1, len(m.ExecuteCalls()))
+	data := m.ExecuteCalls()[0].Data
+	assert.Equal(t, "", data.Content)
+	require.Equal(t, 1, len(data.Embeds))
+	assert.Equal(t, discord.Color(0x00aa00), data.Embeds[0].Color)
+	assert.Equal(t, &discord.EmbedImage{URL: "attachment://chart.png"}, data.Embeds[0].Image)
+	require.Equal(t, 1, len(data.Files))
+	assert.Equal(t, "chart.png", data.Files[0].Name)
+	b, err := io.ReadAll(data.Files[0].Reader)
+	require.NoError(t, err)
+	assert.Equal(t, "image data", string(b))
+}
+
+func TestSend_webhook_attachments(t *testing.T) {
+	m := &iwebhookMock{
+		ExecuteFunc: func(data api.ExecuteWebhookData) error {
+			return nil
+		},
+	}
+	d := &Discord{
+		webhook: m,
+	}
+	err := d.Send(&message.Message{
+		Level:       "error",
+		Text:        "foo",
+		Attachments: []alert.Attachment{{Name: "rows.csv", Data: []byte("a,b")}},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, len(m.ExecuteCalls()))
+	data := m.ExecuteCalls()[0].Data
+	require.Equal(t, 1, len(data.Files))
+	assert.Equal(t, "rows.csv", data.Files[0].Name)
+	b, err := io.ReadAll(data.Files[0].Reader)
+	require.NoError(t, err)
+	assert.Equal(t, "a,b", string(b))
+}
+
+func Test_newEmbed_imageURL(t *testing.T) {
+	embed, files := newEmbed(&message.Message{Level: "warning", Image: "https://example.com/chart.png"})
+	assert.Empty(t, files)
+	assert.Equal(t, discord.Color(0xffcc00), embed.Color)
+	assert.Equal(t, &discord.EmbedImage{URL: "https://example.com/chart.png"}, embed.Image)
+}
+
+func Test_newEmbed_limits(t *testing.T) {
+	fields := map[string]string{}
+	for i := 0; i < 30; i++ {
+		fields[fmt.Sprintf("f%02d", i)] = "v"
+	}
+	embed, _ := newEmbed(&message.Message{AlertName: strings.Repeat("a", 300), Text: strings.Repeat("ы", 2000), Fields: fields})
+	assert.Equal(t, 256, utf8.RuneCountInString(embed.Title))
+	assert.True(t, strings.HasSuffix(embed.Title, "…"))
+	assert.Equal(t, strings.Repeat("ы", 2000), embed.Description)
+	assert.Equal(t, 25, len(embed.Fields))
+	assert.Equal(t, "f24", embed.Fields[24].Name)
+}
+
+func Test_truncate(t *testing.T) {
+	assert.Equal(t, "abc", truncate("abc", 3))
+	assert.Equal(t, "abc…", truncate("abcde", 4))
+	assert.Equal(t, "привет", truncate("привет", 6))
+	assert.Equal(t, "при…", truncate("привет мир", 4))
+	assert.Equal(t, "🔥🔥…", truncate("🔥🔥🔥🔥", 3))
+}
+
+func Test_newEmbed_multibyte_split(t *testing.T) {
+	d := &Discord{}
+	text := strings.Repeat("日本語のテキスト ", 500)
+
+	parts := message.ApplyLimit(&message.Message{Text: text}, d.TextLimit(nil), "")
+	require.True(t, len(parts) > 1)
+
+	var got string
+	for _, part := range parts {
+		embed, _ := newEmbed(part)
+		assert.Equal(t, part.Text, embed.Description)
+		assert.True(t, utf8.RuneCountInString(embed.Description) <= maxDescriptionLen)
+		got += embed.Description + " "
+	}
+	assert.Equal(t, strings.TrimSpace(text), strings.TrimSpace(got))
 }
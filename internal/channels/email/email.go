@@ -1,12 +1,22 @@
 package email
 
 import (
-	"github.com/balerter/balerter/internal/config/channels/email"
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
 	"os"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/channels/email"
+	"github.com/balerter/balerter/internal/corestorage"
 
+	mail "github.com/xhit/go-simple-mail/v2"
 	"go.uber.org/zap"
 )
 
+const defaultTimeout = 10
+
 // Email implements a Provider for email notifications.
 type Email struct {
 	conf     email.Email
@@ -14,16 +24,47 @@ type Email struct {
 	logger   *zap.Logger
 	name     string
 	ignore   bool
+
+	// kv stores the first Message-ID of the alert incident for the threading headers, may be nil
+	kv         corestorage.KV
+	template   *template.Template
+	httpClient *http.Client
 }
 
 // New returns the new Email instance
-func New(cfg email.Email, logger *zap.Logger) (*Email, error) {
+func New(cfg email.Email, kv corestorage.KV, logger *zap.Logger) (*Email, error) {
 	h, err := os.Hostname()
 	// Use localhost if os.Hostname() fails
 	if err != nil {
 		h = "localhost.localdomain"
 	}
-	return &Email{conf: cfg, hostname: h, logger: logger, name: cfg.Name}, nil
+
+	tmpl := defaultTemplate
+	if cfg.Template != "" {
+		tmpl = cfg.Template
+	}
+	t, err := template.New("email").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parse template, %w", err)
+	}
+
+	timeout := cfg.Timeout
+	if timeout < 1 {
+		timeout = defaultTimeout
+	}
+
+	e := &Email{
+		conf:       cfg,
+		hostname:   h,
+		logger:     logger,
+		name:       cfg.Name,
+		ignore:     cfg.Ignore,
+		kv:         kv,
+		template:   t,
+		httpClient: &http.Client{Timeout: time.Duration(timeout) * time.Second},
+	}
+
+	return e, nil
 }
 
 // Name returns the Email channel name
@@ -34,3 +75,52 @@ func (e *Email) Name() string {
 func (e *Email) Ignore() bool {
 	return e.ignore
 }
+
+// Check connects and authenticates to the SMTP server, the connection is bounded by the ctx
+func (e *Email) Check(ctx context.Context) error {
+	server, err := e.smtpServer()
+	if err != nil {
+		return fmt.Errorf("error parse port, %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if left := time.Until(deadline); left < server.ConnectTimeout {
+			server.ConnectTimeout = left
+			server.SendTimeout = left
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("error connect to smtp server, %w", err)
+	}
+
+	type connectResult struct {
+		client *mail.SMTPClient
+		err    error
+	}
+
+	res := make(chan connectResult, 1)
+	go func() {
+		smtpClient, errConnect := server.Connect()
+		res <- connectResult{client: smtpClient, err: errConnect}
+	}()
+
+	select {
+	case <-ctx.Done():
+		go func() {
+			if r := <-res; r.err == nil {
+				r.client.Quit() //nolint:errcheck // the check is already done
+			}
+		}()
+		return fmt.Errorf("error connect to smtp server, %w", ctx.Err())
+	case r := <-res:
+		if r.err != nil {
+			return fmt.Errorf("error connect to smtp server, %w", r.err)
+		}
+		if err := r.client.Quit(); err != nil {
+			e.logger.Debug("error quit smtp session", zap.String("channel name", e.name), zap.Error(err))
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,12 @@
 package email
 
 import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"time"
+
 	"github.com/balerter/balerter/internal/config/channels/email"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -15,7 +21,7 @@ func TestEmailName(t *testing.T) {
 }
 
 func TestNew(t *testing.T) {
-	e, err := New(email.Email{}, zap.NewNop())
+	e, err := New(email.Email{}, nil, zap.NewNop())
 	require.NoError(t, err)
 	assert.IsType(t, &Email{}, e)
 }
@@ -24,3 +30,121 @@ func TestEmail_Ignore(t *testing.T) {
 	e := &Email{ignore: true}
 	assert.True(t, e.Ignore())
 }
+
+func TestNew_template(t *testing.T) {
+	e, err := New(email.Email{Template: "<p>{{ .Text }}</p>", Ignore: true}, nil, zap.NewNop())
+	require.NoError(t, err)
+	assert.True(t, e.ignore)
+
+	_, err = New(email.Email{Template: "{{ .Text "}, nil, zap.NewNop())
+	require.Error(t, err)
+	assert.Equal(t, "error parse template, template: email:1: unclosed action", err.Error())
+}
+
+// runSMTPServer runs a minimal SMTP server, which accepts the session without auth
+func runSMTPServer(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, errAccept := ln.Accept()
+			if errAccept != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				conn.Write([]byte("220 localhost ESMTP\r\n")) //nolint:errcheck // test server
+				r := bufio.NewReader(conn)
+				for {
+					line, errRead := r.ReadString('\n')
+					if errRead != nil {
+						return
+					}
+					switch cmd := strings.ToUpper(strings.Fields(line + " ")[0]); cmd {
+					case "EHLO", "HELO":
+						conn.Write([]byte("250 localhost\r\n")) //nolint:errcheck // test server
+					case "QUIT":
+						conn.Write([]byte("221 bye\r\n")) //nolint:errcheck // test server
+						return
+					default:
+						conn.Write([]byte("502 not implemented\r\n")) //nolint:errcheck // test server
+					}
+				}
+			}(conn)
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func TestEmail_Check(t *testing.T) {
+	host, port, err := net.SplitHostPort(runSMTPServer(t))
+	require.NoError(t, err)
+
+	e, err := New(email.Email{Name: "foo", Host: host, Port: port, Secure: "none"}, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, e.Check(context.Background()))
+}
+
+func TestEmail_Check_Error(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host, port, err := net.SplitHostPort(ln.Addr().String())
+	require.NoError(t, err)
+	ln.Close()
+
+	e, err := New(email.Email{Name: "foo", Host: host, Port: port, Secure: "none"}, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	err = e.Check(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error connect to smtp server")
+
+	e.conf.Port = "foo"
+	err = e.Check(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error parse port")
+}
+
+func TestEmail_Check_Context(t *testing.T) {
+	// the server accepts the connection but never sends the greeting
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		var conns []net.Conn
+		for {
+			conn, errAccept := ln.Accept()
+			if errAccept != nil {
+				for _, c := range conns {
+					c.Close()
+				}
+				return
+			}
+			conns = append(conns, conn)
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(ln.Addr().String())
+	require.NoError(t, err)
+
+	e, err := New(email.Email{Name: "foo", Host: host, Port: port, Secure: "none"}, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = e.Check(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error connect to smtp server")
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	cancelled, cancel2 := context.WithCancel(context.Background())
+	cancel2()
+	err = e.Check(cancelled)
+	require.ErrorIs(t, err, context.Canceled)
+}
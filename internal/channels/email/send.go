@@ -1,31 +1,71 @@
 package email
 
 import (
-	"encoding/base64"
 	"fmt"
+	"html/template"
+	"io"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/balerter/balerter/internal/message"
 	mail "github.com/xhit/go-simple-mail/v2"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSubject = "[{ALERT_NAME}/{LEVEL}]"
+	imageName      = "chart.png"
 )
 
 // Send implements
 func (e *Email) Send(mes *message.Message) error {
-	var err error
+	root, err := e.threadRoot(mes.AlertName)
+	if err != nil {
+		return err
+	}
+
+	id := e.messageID(mes.AlertName)
+
+	email, err := e.buildMessage(mes, id, root)
+	if err != nil {
+		return err
+	}
+
+	server, err := e.smtpServer()
+	if err != nil {
+		return err
+	}
+
+	smtpClient, err := server.Connect()
+	if err != nil {
+		return err
+	}
+
+	if err := email.Send(smtpClient); err != nil {
+		return err
+	}
+
+	return e.updateThread(mes.AlertName, mes.Level, root, id)
+}
+
+// smtpServer returns the SMTP server settings
+func (e *Email) smtpServer() (*mail.SMTPServer, error) {
 	server := mail.NewSMTPClient()
 
+	var err error
+
 	server.Host = e.conf.Host
 	server.Port, err = strconv.Atoi(e.conf.Port)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	server.Username = e.conf.Username
 	server.Password = e.conf.Password
 	timeout := e.conf.Timeout
 	if timeout < 1 {
-		timeout = 10
+		timeout = defaultTimeout
 	}
 	server.ConnectTimeout = time.Duration(timeout) * time.Second
 	server.SendTimeout = time.Duration(timeout) * time.Second
@@ -43,34 +83,147 @@ func (e *Email) Send(mes *message.Message) error {
 		server.Encryption = mail.EncryptionSSL
 	}
 
-	smtpClient, err := server.Connect()
-	if err != nil {
-		return err
-	}
+	return server, nil
+}
 
+// buildMessage creates the mail with the Message-ID id, replying to the root if it is not empty
+func (e *Email) buildMessage(mes *message.Message, id, root string) (*mail.Email, error) {
 	email := mail.NewMSG()
-	to := strings.Split(e.conf.To, ";")
-	subject := fmt.Sprintf("[%s/%s]", mes.AlertName, mes.Level)
-	email.SetFrom(e.conf.From).AddTo(to...).SetSubject(subject)
 
-	if len(e.conf.Cc) > 0 {
-		cc := strings.Split(e.conf.Cc, ";")
+	subject := defaultSubject
+	if e.conf.Subject != "" {
+		subject = e.conf.Subject
+	}
+
+	to, cc, bcc := e.recipients(mes.Level)
+
+	email.SetFrom(e.conf.From).AddTo(to...).SetSubject(mes.Render(subject))
+	if len(cc) > 0 {
 		email.AddCc(cc...)
 	}
+	if len(bcc) > 0 {
+		email.AddBcc(bcc...)
+	}
+	if e.conf.ReplyTo != "" {
+		email.SetReplyTo(e.conf.ReplyTo)
+	}
 
-	if len(mes.Fields) > 0 {
-		mes.Text += "\n\n"
-		for k, v := range mes.Fields {
-			mes.Text += fmt.Sprintf("%s = %s\n", k, v)
+	email.AddHeader("Message-ID", id)
+	if root != "" {
+		email.AddHeader("In-Reply-To", root)
+		email.AddHeader("References", root)
+	}
+
+	var image template.URL
+	if mes.Image != "" {
+		image = e.attachImage(email, mes.Image)
+	}
+
+	html, err := e.renderHTML(mes, image)
+	if err != nil {
+		return nil, err
+	}
+
+	// the last alternative is preferred by mail clients
+	email.SetBody(mail.TextPlain, renderText(mes))
+	email.AddAlternative(mail.TextHTML, html)
+
+	for _, a := range mes.Attachments {
+		email.Attach(&mail.File{
+			Name:     a.Name,
+			MimeType: a.ContentType,
+			Data:     a.Data,
+		})
+	}
+
+	if email.Error != nil {
+		return nil, email.Error
+	}
+
+	return email, nil
+}
+
+// SupportsAttachments returns true, the attachments are attached to the mail
+func (e *Email) SupportsAttachments() bool {
+	return true
+}
+
+// SupportsLinks returns true, the links are rendered in the body
+func (e *Email) SupportsLinks() bool {
+	return true
+}
+
+// recipients returns to, cc and bcc addresses for the level
+func (e *Email) recipients(level string) (to, cc, bcc []string) {
+	to, cc, bcc = splitAddresses(e.conf.To), splitAddresses(e.conf.Cc), splitAddresses(e.conf.Bcc)
+
+	for _, r := range e.conf.Recipients {
+		if r.Level != level {
+			continue
+		}
+		if r.To != "" {
+			to = splitAddresses(r.To)
+		}
+		if r.Cc != "" {
+			cc = splitAddresses(r.Cc)
+		}
+		if r.Bcc != "" {
+			bcc = splitAddresses(r.Bcc)
+		}
+	}
+
+	return to, cc, bcc
+}
+
+func splitAddresses(s string) []string {
+	var res []string
+	for _, a := range strings.Split(s, ";") {
+		if a = strings.TrimSpace(a); a != "" {
+			res = append(res, a)
 		}
 	}
+	return res
+}
+
+// attachImage attaches the image inline and returns its 'cid:' reference.
+// The image is the image data or URL, the URL is downloaded. If the download fails, the URL is returned
+func (e *Email) attachImage(email *mail.Email, image string) template.URL {
+	data := []byte(image)
 
-	email.SetBody(mail.TextHTML, mes.Text)
+	if strings.HasPrefix(image, "http://") || strings.HasPrefix(image, "https://") {
+		var err error
+		data, err = e.download(image)
+		if err != nil {
+			e.logger.Error("error download image, the url is used", zap.String("url", image), zap.Error(err))
+			return template.URL(image) //nolint:gosec // the url is from the alert options
+		}
+	}
 
-	if len(mes.Image) > 0 {
-		img := base64.StdEncoding.EncodeToString([]byte(mes.Image))
-		email.AddAttachmentBase64(img, mes.AlertName+".png")
+	email.Attach(&mail.File{
+		Name:     imageName,
+		MimeType: http.DetectContentType(data),
+		Data:     data,
+		Inline:   true,
+	})
+
+	return "cid:" + imageName
+}
+
+func (e *Email) download(u string) ([]byte, error) {
+	res, err := e.httpClient.Get(u)
+	if err != nil {
+		return nil, fmt.Errorf("error send request, %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %d", res.StatusCode)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error read response body, %w", err)
 	}
 
-	return email.Send(smtpClient)
+	return data, nil
 }
@@ -1,9 +1,13 @@
 package email
 
 import (
+	"github.com/balerter/balerter/internal/alert"
 	"github.com/balerter/balerter/internal/config/channels/email"
 	"github.com/stretchr/testify/assert"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -49,3 +53,123 @@ func TestSend(t *testing.T) {
 	err = e.Send(msg)
 	require.NoError(t, err)
 }
+
+func newTestEmail(t *testing.T, cfg email.Email) *Email {
+	e, err := New(cfg, nil, zap.NewNop())
+	require.NoError(t, err)
+	e.hostname = "host1"
+	return e
+}
+
+func TestEmail_buildMessage(t *testing.T) {
+	e := newTestEmail(t, email.Email{
+		Name:    "foo",
+		From:    "gopher@example.net",
+		To:      "foo1@example.com; foo2@example.com",
+		Cc:      "foo3@example.com",
+		Bcc:     "foo4@example.com",
+		ReplyTo: "noc@example.com",
+	})
+
+	msg := &message.Message{
+		Level:     "error",
+		AlertName: "alert-id",
+		Text:      "alert text",
+		Image:     "\x89PNG\r\n\x1a\nimage data",
+	}
+
+	m, err := e.buildMessage(msg, "<2@host1>", "<1@host1>")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"foo1@example.com", "foo2@example.com", "foo3@example.com", "foo4@example.com"}, m.GetRecipients())
+
+	s := m.GetMessage()
+	assert.Contains(t, s, "Subject: [alert-id/error]\r\n")
+	assert.Contains(t, s, "To: <foo1@example.com>, <foo2@example.com>\r\n")
+	assert.Contains(t, s, "Cc: <foo3@example.com>\r\n")
+	assert.NotContains(t, s, "foo4@example.com")
+	assert.Contains(t, s, "Reply-To: <noc@example.com>\r\n")
+	assert.Contains(t, s, "Message-Id: <2@host1>\r\n")
+	assert.Contains(t, s, "In-Reply-To: <1@host1>\r\n")
+	assert.Contains(t, s, "References: <1@host1>\r\n")
+	assert.Contains(t, s, "multipart/related")
+	assert.Contains(t, s, "Content-Type: image/png")
+	assert.Contains(t, s, "Content-Disposition: inline")
+
+	// the cid reference in the body is replaced with the Content-ID
+	assert.NotContains(t, s, "cid:chart.png")
+	assert.Contains(t, s, "Content-Id: <")
+	assert.True(t, strings.Index(s, "text/plain") < strings.Index(s, "text/html"))
+}
+
+func TestEmail_buildMessage_levelRecipients(t *testing.T) {
+	e := newTestEmail(t, email.Email{
+		From: "gopher@example.net",
+		To:   "foo1@example.com",
+		Cc:   "foo2@example.com",
+		Recipients: []email.Recipients{
+			{Level: "error", To: "oncall@example.com"},
+		},
+		Subject: "{LEVEL}: {ALERT_NAME}",
+	})
+
+	m, err := e.buildMessage(&message.Message{Level: "error", AlertName: "alert-id", Text: "text"}, "<2@host1>", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"oncall@example.com", "foo2@example.com"}, m.GetRecipients())
+
+	s := m.GetMessage()
+	assert.Contains(t, s, "Subject: error: alert-id\r\n")
+	assert.NotContains(t, s, "In-Reply-To")
+	assert.NotContains(t, s, "multipart/related")
+
+	m, err = e.buildMessage(&message.Message{Level: "warning", AlertName: "alert-id", Text: "text"}, "<3@host1>", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo1@example.com", "foo2@example.com"}, m.GetRecipients())
+}
+
+func TestEmail_attachImage_url(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/chart.png" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write([]byte("\x89PNG\r\n\x1a\nimage data"))
+	}))
+	defer srv.Close()
+
+	e := newTestEmail(t, email.Email{From: "gopher@example.net", To: "foo1@example.com"})
+
+	m, err := e.buildMessage(&message.Message{Level: "error", AlertName: "a", Image: srv.URL + "/chart.png"}, "<1@host1>", "")
+	require.NoError(t, err)
+	s := m.GetMessage()
+	assert.Contains(t, s, "Content-Type: image/png")
+	assert.NotContains(t, s, srv.URL)
+
+	// the url is used if the download fails
+	m, err = e.buildMessage(&message.Message{Level: "error", AlertName: "a", Image: srv.URL + "/404.png"}, "<1@host1>", "")
+	require.NoError(t, err)
+	s = m.GetMessage()
+	assert.NotContains(t, s, "Content-Type: image/png")
+	assert.NotContains(t, s, "multipart/related")
+}
+
+func TestEmail_buildMessage_attachments(t *testing.T) {
+	e := newTestEmail(t, email.Email{From: "gopher@example.net", To: "foo1@example.com"})
+
+	m, err := e.buildMessage(&message.Message{
+		Level:       "error",
+		AlertName:   "a",
+		Text:        "text",
+		Attachments: []alert.Attachment{{Name: "rows.csv", ContentType: "text/csv", Data: []byte("a,b")}},
+	}, "<1@host1>", "")
+	require.NoError(t, err)
+	s := m.GetMessage()
+	assert.Contains(t, s, "multipart/mixed")
+	assert.Contains(t, s, "Content-Type: text/csv")
+	assert.Contains(t, s, `filename="rows.csv"`)
+}
+
+func Test_splitAddresses(t *testing.T) {
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, splitAddresses(" a@example.com;;b@example.com; "))
+	assert.Nil(t, splitAddresses(""))
+}
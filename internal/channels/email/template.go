@@ -0,0 +1,122 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
+)
+
+const defaultTemplate = `<!DOCTYPE html>
+<html>
+<body style="font-family: Arial, Helvetica, sans-serif; font-size: 14px;">
+<table cellpadding="0" cellspacing="0" style="border-left: 6px solid {{ .Color }};">
+<tr><td style="padding: 8px 16px;">
+<h2 style="margin: 0 0 4px 0;">{{ .AlertName }}</h2>
+<p style="margin: 0 0 12px 0; color: {{ .Color }}; font-weight: bold; text-transform: uppercase;">{{ .Level }}</p>
+<div>{{ .Text }}</div>
+{{- if .Fields }}
+<table cellpadding="4" cellspacing="0" style="border-collapse: collapse; margin-top: 12px;">
+{{- range .Fields }}
+<tr><td style="border: 1px solid #dddddd; font-weight: bold;">{{ .Name }}</td><td style="border: 1px solid #dddddd;">{{ .Value }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- if .Links }}
+<p style="margin-top: 12px;">
+{{- range .Links }}
+<a href="{{ .URL }}" style="display: inline-block; margin-right: 8px; padding: 6px 12px; border: 1px solid {{ $.Color }}; color: {{ $.Color }}; text-decoration: none;">{{ .Label }}</a>
+{{- end }}
+</p>
+{{- end }}
+{{- if .Image }}
+<p><img src="{{ .Image }}" alt="{{ .AlertName }}"></p>
+{{- end }}
+</td></tr>
+</table>
+</body>
+</html>
+`
+
+// templateData is the data of the body template
+type templateData struct {
+	Level     string
+	AlertName string
+	// Text is not escaped, as the email body was always sent as HTML
+	Text   template.HTML
+	Color  string
+	Fields []field
+	// Image is 'cid:' reference to the inline image or the image URL
+	Image template.URL
+	Links []alert.Link
+}
+
+type field struct {
+	Name  string
+	Value string
+}
+
+func levelColor(level string) string {
+	switch level {
+	case "success":
+		return "#00aa00"
+	case "warning":
+		return "#ffcc00"
+	case "error":
+		return "#ff0000"
+	}
+	return "#808080"
+}
+
+func sortedFields(fields map[string]string) []field {
+	res := make([]field, 0, len(fields))
+	for k, v := range fields {
+		res = append(res, field{Name: k, Value: v})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+// renderHTML renders the HTML body with the template
+func (e *Email) renderHTML(mes *message.Message, image template.URL) (string, error) {
+	data := templateData{
+		Level:     mes.Level,
+		AlertName: mes.AlertName,
+		Text:      template.HTML(mes.Text), //nolint:gosec // the text is HTML by design
+		Color:     levelColor(mes.Level),
+		Fields:    sortedFields(mes.Fields),
+		Image:     image,
+		Links:     mes.Links,
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := e.template.Execute(buf, data); err != nil {
+		return "", fmt.Errorf("error execute template, %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// renderText renders the plain text alternative of the body
+func renderText(mes *message.Message) string {
+	s := mes.Text
+	if len(mes.Fields) > 0 {
+		s += "\n\n"
+		for _, f := range sortedFields(mes.Fields) {
+			s += fmt.Sprintf("%s = %s\n", f.Name, f.Value)
+		}
+	}
+	if len(mes.Links) > 0 {
+		s = strings.TrimRight(s, "\n") + "\n\n"
+		for _, l := range mes.Links {
+			s += fmt.Sprintf("%s: %s\n", l.Label, l.URL)
+		}
+	}
+	return strings.TrimRight(s, "\n")
+}
@@ -0,0 +1,66 @@
+package email
+
+import (
+	"html/template"
+	"testing"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/config/channels/email"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestEmail_renderHTML(t *testing.T) {
+	e, err := New(email.Email{}, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	mes := &message.Message{
+		Level:     "error",
+		AlertName: "alert1",
+		Text:      "<b>disk</b> is full",
+		Fields:    map[string]string{"host": "db<1>", "disk": "/data"},
+		Links:     []alert.Link{{Label: "Runbook <1>", URL: "https://wiki.example.com/rb?a=1&b=2"}},
+	}
+
+	s, err := e.renderHTML(mes, "cid:chart.png")
+	require.NoError(t, err)
+
+	assert.Contains(t, s, `border-left: 6px solid #ff0000;`)
+	assert.Contains(t, s, `<h2 style="margin: 0 0 4px 0;">alert1</h2>`)
+	assert.Contains(t, s, `<div><b>disk</b> is full</div>`)
+	assert.Contains(t, s, `font-weight: bold;">disk</td><td style="border: 1px solid #dddddd;">/data</td></tr>`+"\n"+
+		`<tr><td style="border: 1px solid #dddddd; font-weight: bold;">host</td><td style="border: 1px solid #dddddd;">db&lt;1&gt;</td>`)
+	assert.Contains(t, s, `<img src="cid:chart.png" alt="alert1">`)
+	assert.Contains(t, s, `<a href="https://wiki.example.com/rb?a=1&amp;b=2" style="display: inline-block; margin-right: 8px; `+
+		`padding: 6px 12px; border: 1px solid #ff0000; color: #ff0000; text-decoration: none;">Runbook &lt;1&gt;</a>`)
+}
+
+func TestEmail_renderHTML_custom(t *testing.T) {
+	e, err := New(email.Email{Template: `{{ .Level }}|{{ .Color }}|{{ .Text }}|{{ range .Fields }}{{ .Name }}={{ .Value }};{{ end }}|{{ .Image }}`}, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	s, err := e.renderHTML(&message.Message{Level: "success", Text: "ok", Fields: map[string]string{"a": "1"}}, template.URL(""))
+	require.NoError(t, err)
+	assert.Equal(t, "success|#00aa00|ok|a=1;|", s)
+}
+
+func Test_levelColor(t *testing.T) {
+	assert.Equal(t, "#00aa00", levelColor("success"))
+	assert.Equal(t, "#ffcc00", levelColor("warning"))
+	assert.Equal(t, "#ff0000", levelColor("error"))
+	assert.Equal(t, "#808080", levelColor("foo"))
+}
+
+func Test_renderText(t *testing.T) {
+	s := renderText(&message.Message{Text: "text", Fields: map[string]string{"b": "2", "a": "1"}})
+	assert.Equal(t, "text\n\na = 1\nb = 2", s)
+
+	s = renderText(&message.Message{
+		Text:   "text",
+		Fields: map[string]string{"a": "1"},
+		Links:  []alert.Link{{Label: "Runbook", URL: "https://wiki.example.com/rb"}},
+	})
+	assert.Equal(t, "text\n\na = 1\n\nRunbook: https://wiki.example.com/rb", s)
+}
@@ -0,0 +1,69 @@
+package email
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/corestorage"
+)
+
+/*
+All mails of the alert incident are grouped into one thread: the Message-ID of the first mail
+is stored in the core KV storage and is referenced by the In-Reply-To and References headers
+of the next mails. The success mail closes the thread.
+*/
+
+func (e *Email) threadKey(alertName string) string {
+	return "email:" + e.name + ":" + alertName
+}
+
+// messageID returns new unique Message-ID for the alert
+func (e *Email) messageID(alertName string) string {
+	return "<" + strconv.FormatInt(time.Now().UnixNano(), 36) + "." + sanitizeID(alertName) + "@" + e.hostname + ">"
+}
+
+// sanitizeID keeps only the characters allowed in the Message-ID
+func sanitizeID(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_.+=", r) {
+			return r
+		}
+		return '-'
+	}, s)
+}
+
+// threadRoot returns the Message-ID of the first mail of the incident or empty string
+func (e *Email) threadRoot(alertName string) (string, error) {
+	if e.kv == nil {
+		return "", nil
+	}
+
+	root, _, err := corestorage.Lookup(e.kv, e.threadKey(alertName))
+	if err != nil {
+		return "", fmt.Errorf("error get kv value, %w", err)
+	}
+
+	return root, nil
+}
+
+// updateThread starts the thread with the first mail and closes it on success
+func (e *Email) updateThread(alertName, level, root, id string) error {
+	if e.kv == nil {
+		return nil
+	}
+
+	switch {
+	case root == "" && level != "success":
+		if err := e.kv.Upsert(e.threadKey(alertName), id); err != nil {
+			return fmt.Errorf("error save thread, %w", err)
+		}
+	case root != "" && level == "success":
+		if err := e.kv.Delete(e.threadKey(alertName)); err != nil {
+			return fmt.Errorf("error delete thread, %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package email
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/balerter/balerter/internal/corestorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newKV returns KV mock backed by the map
+func newKV(m map[string]string) *corestorage.KVMock {
+	return &corestorage.KVMock{
+		GetFunc: func(k string) (string, error) {
+			v, ok := m[k]
+			if !ok {
+				return "", corestorage.ErrKVNotFound
+			}
+			return v, nil
+		},
+		UpsertFunc: func(k, v string) error {
+			m[k] = v
+			return nil
+		},
+		DeleteFunc: func(k string) error {
+			delete(m, k)
+			return nil
+		},
+	}
+}
+
+func TestEmail_messageID(t *testing.T) {
+	e := &Email{hostname: "host1"}
+	id := e.messageID("disk usage@db 1")
+	assert.Regexp(t, regexp.MustCompile(`^<[0-9a-z]+\.disk-usage-db-1@host1>$`), id)
+	assert.NotEqual(t, id, e.messageID("disk usage@db 1"))
+}
+
+func TestEmail_thread(t *testing.T) {
+	store := map[string]string{}
+	e := &Email{name: "email1", kv: newKV(store)}
+
+	root, err := e.threadRoot("foo")
+	require.NoError(t, err)
+	assert.Equal(t, "", root)
+
+	require.NoError(t, e.updateThread("foo", "error", "", "<1@h>"))
+	assert.Equal(t, map[string]string{"email:email1:foo": "<1@h>"}, store)
+
+	root, err = e.threadRoot("foo")
+	require.NoError(t, err)
+	assert.Equal(t, "<1@h>", root)
+
+	// the thread is continued
+	require.NoError(t, e.updateThread("foo", "warning", root, "<2@h>"))
+	assert.Equal(t, map[string]string{"email:email1:foo": "<1@h>"}, store)
+
+	require.NoError(t, e.updateThread("foo", "success", root, "<3@h>"))
+	assert.Empty(t, store)
+
+	// success without the thread does not start it
+	require.NoError(t, e.updateThread("foo", "success", "", "<4@h>"))
+	assert.Empty(t, store)
+}
+
+func TestEmail_thread_noKV(t *testing.T) {
+	e := &Email{}
+
+	root, err := e.threadRoot("foo")
+	require.NoError(t, err)
+	assert.Equal(t, "", root)
+	require.NoError(t, e.updateThread("foo", "error", "", "<1@h>"))
+}
+
+func TestEmail_threadRoot_error(t *testing.T) {
+	e := &Email{kv: &corestorage.KVMock{
+		GetFunc: func(string) (string, error) {
+			return "", fmt.Errorf("err1")
+		},
+	}}
+
+	_, err := e.threadRoot("foo")
+	require.Error(t, err)
+	assert.Equal(t, "error get kv value, err1", err.Error())
+}
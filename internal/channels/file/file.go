@@ -0,0 +1,100 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	fileCfg "github.com/balerter/balerter/internal/config/channels/file"
+	"go.uber.org/zap"
+)
+
+const (
+	megabyte = 1024 * 1024
+
+	filePerm = 0o640
+	dirPerm  = 0o750
+)
+
+// File represents a channel of type File. It appends the messages to the file as JSON lines
+type File struct {
+	name   string
+	ignore bool
+	logger *zap.Logger
+
+	path           string
+	maxSize        int64
+	rotateInterval time.Duration
+	compress       bool
+	maxBackups     int
+	fsync          bool
+
+	mu sync.Mutex
+	f  *os.File
+	// size of the current file
+	size int64
+	// openedAt is the time of the last write to the current file, it is used for the time rotation
+	openedAt time.Time
+
+	now func() time.Time
+}
+
+// New creates new File channel
+func New(cfg fileCfg.File, logger *zap.Logger) (*File, error) {
+	f := &File{
+		name:           cfg.Name,
+		ignore:         cfg.Ignore,
+		logger:         logger,
+		path:           cfg.Path,
+		maxSize:        int64(cfg.MaxSize) * megabyte,
+		rotateInterval: time.Millisecond * time.Duration(cfg.RotateInterval),
+		compress:       cfg.Compress,
+		maxBackups:     cfg.MaxBackups,
+		fsync:          cfg.Fsync,
+		now:            time.Now,
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), dirPerm); err != nil {
+		return nil, fmt.Errorf("error create directory, %w", err)
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// open opens the file for appending
+func (f *File) open() error {
+	fl, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePerm)
+	if err != nil {
+		return fmt.Errorf("error open file, %w", err)
+	}
+
+	info, err := fl.Stat()
+	if err != nil {
+		fl.Close()
+		return fmt.Errorf("error stat file, %w", err)
+	}
+
+	f.f = fl
+	f.size = info.Size()
+	f.openedAt = info.ModTime()
+	if f.size == 0 {
+		f.openedAt = f.now()
+	}
+
+	return nil
+}
+
+// Name returns the channel name
+func (f *File) Name() string {
+	return f.name
+}
+
+func (f *File) Ignore() bool {
+	return f.ignore
+}
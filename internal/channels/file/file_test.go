@@ -0,0 +1,65 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	fileCfg "github.com/balerter/balerter/internal/config/channels/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNew(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", "alerts.jsonl")
+
+	f, err := New(fileCfg.File{
+		Name:           "foo",
+		Path:           path,
+		MaxSize:        2,
+		RotateInterval: 1000,
+		Compress:       true,
+		MaxBackups:     3,
+		Fsync:          true,
+	}, zap.NewNop())
+	require.NoError(t, err)
+	defer f.f.Close()
+
+	assert.Equal(t, "foo", f.Name())
+	assert.Equal(t, int64(2*megabyte), f.maxSize)
+	assert.Equal(t, time.Second, f.rotateInterval)
+	assert.True(t, f.compress)
+	assert.Equal(t, 3, f.maxBackups)
+	assert.True(t, f.fsync)
+	assert.Equal(t, int64(0), f.size)
+
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+}
+
+func TestNew_ExistsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("{}\n"), 0o600))
+
+	f, err := New(fileCfg.File{Name: "foo", Path: path}, zap.NewNop())
+	require.NoError(t, err)
+	defer f.f.Close()
+
+	assert.Equal(t, int64(3), f.size)
+}
+
+func TestNew_Error(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+	require.NoError(t, os.Mkdir(path, 0o750))
+
+	_, err := New(fileCfg.File{Name: "foo", Path: path}, zap.NewNop())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error open file")
+}
+
+func TestFile_Ignore(t *testing.T) {
+	f := &File{ignore: true}
+	assert.True(t, f.Ignore())
+}
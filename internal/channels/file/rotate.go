@@ -0,0 +1,155 @@
+package file
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+/*
+The rotated file is renamed to <name>-<time><ext>, e.g. alerts-2021-01-02T03-04-05.000.jsonl,
+and compressed to <name>-<time><ext>.gz if the compress option is enabled
+*/
+
+const (
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+	gzipExt          = ".gz"
+)
+
+// needRotate returns true if the file exceeds the max size with the next write,
+// or the last write was in the previous rotation interval
+func (f *File) needRotate(n int64, now time.Time) bool {
+	if f.size == 0 {
+		return false
+	}
+	if f.maxSize > 0 && f.size+n > f.maxSize {
+		return true
+	}
+	if f.rotateInterval > 0 && !now.Truncate(f.rotateInterval).Equal(f.openedAt.Truncate(f.rotateInterval)) {
+		return true
+	}
+	return false
+}
+
+// backupName returns the prefix and the extension of the rotated files
+func (f *File) backupName() (prefix, ext string) {
+	ext = filepath.Ext(f.path)
+	return strings.TrimSuffix(f.path, ext) + "-", ext
+}
+
+// rotate renames the current file, opens a new one, compresses the rotated file and removes the old ones
+func (f *File) rotate(now time.Time) error {
+	if err := f.f.Close(); err != nil {
+		f.logger.Error("error close file", zap.String("channel name", f.name), zap.Error(err))
+	}
+
+	prefix, ext := f.backupName()
+	backup := prefix + now.UTC().Format(backupTimeFormat) + ext
+
+	if err := os.Rename(f.path, backup); err != nil {
+		// the file is reopened to continue writing to the current file
+		if errOpen := f.open(); errOpen != nil {
+			return fmt.Errorf("error rename file, %v; %w", err, errOpen)
+		}
+		return fmt.Errorf("error rename file, %w", err)
+	}
+
+	if err := f.open(); err != nil {
+		return err
+	}
+
+	if f.compress {
+		if err := compress(backup); err != nil {
+			f.logger.Error("error compress file", zap.String("channel name", f.name), zap.String("file", backup), zap.Error(err))
+		}
+	}
+
+	if f.maxBackups > 0 {
+		if err := f.removeOld(prefix, ext); err != nil {
+			f.logger.Error("error remove old files", zap.String("channel name", f.name), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// compress gzips the file and removes the source
+func compress(name string) error {
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(name+gzipExt, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePerm)
+	if err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(dst)
+	zw.Name = filepath.Base(name)
+
+	if _, err = io.Copy(zw, src); err == nil {
+		err = zw.Close()
+	}
+	if err == nil {
+		err = dst.Sync()
+	}
+	if errClose := dst.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		os.Remove(name + gzipExt)
+		return err
+	}
+
+	return os.Remove(name)
+}
+
+// removeOld removes the rotated files except the last maxBackups files
+func (f *File) removeOld(prefix, ext string) error {
+	entries, err := os.ReadDir(filepath.Dir(f.path))
+	if err != nil {
+		return err
+	}
+
+	base := filepath.Base(prefix)
+
+	var backups []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, base) {
+			continue
+		}
+		if !strings.HasSuffix(name, ext) && !strings.HasSuffix(name, ext+gzipExt) {
+			continue
+		}
+		ts := strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(name, base), gzipExt), ext)
+		if _, errParse := time.Parse(backupTimeFormat, ts); errParse != nil {
+			continue
+		}
+		backups = append(backups, name)
+	}
+
+	if len(backups) <= f.maxBackups {
+		return nil
+	}
+
+	// the names contain the rotation time, so the sorted names are in order of rotation
+	sort.Strings(backups)
+
+	for _, name := range backups[:len(backups)-f.maxBackups] {
+		if err := os.Remove(filepath.Join(filepath.Dir(f.path), name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
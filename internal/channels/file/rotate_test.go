@@ -0,0 +1,134 @@
+package file
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	fileCfg "github.com/balerter/balerter/internal/config/channels/file"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func readDir(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestFile_needRotate(t *testing.T) {
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	f := &File{}
+	assert.False(t, f.needRotate(100, ts))
+
+	f = &File{size: 10, maxSize: 100}
+	assert.False(t, f.needRotate(90, ts))
+	assert.True(t, f.needRotate(91, ts))
+
+	f = &File{size: 10, rotateInterval: time.Hour, openedAt: ts}
+	assert.False(t, f.needRotate(1, ts.Add(time.Minute*50)))
+	assert.True(t, f.needRotate(1, ts.Add(time.Minute*56)))
+
+	f = &File{size: 0, maxSize: 10, rotateInterval: time.Hour, openedAt: ts}
+	assert.False(t, f.needRotate(100, ts.Add(time.Hour)))
+}
+
+func TestFile_Rotate_Size(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "alerts.jsonl")
+
+	f, err := New(fileCfg.File{Name: "foo", Path: path, MaxBackups: 2}, zap.NewNop())
+	require.NoError(t, err)
+	defer f.f.Close()
+
+	f.maxSize = 10
+
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		now := ts.Add(time.Second * time.Duration(i))
+		f.now = func() time.Time { return now }
+		require.NoError(t, f.Send(&message.Message{AlertName: "foo"}))
+	}
+
+	assert.Equal(t, []string{
+		"alerts-2021-01-02T03-04-07.000.jsonl",
+		"alerts-2021-01-02T03-04-08.000.jsonl",
+		"alerts.jsonl",
+	}, readDir(t, dir))
+
+	data, err := os.ReadFile(filepath.Join(dir, "alerts-2021-01-02T03-04-08.000.jsonl"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"time":"2021-01-02T03:04:07Z"`)
+
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"time":"2021-01-02T03:04:08Z"`)
+}
+
+func TestFile_Rotate_Interval_Compress(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "alerts.log")
+
+	f, err := New(fileCfg.File{Name: "foo", Path: path, Compress: true}, zap.NewNop())
+	require.NoError(t, err)
+	defer f.f.Close()
+
+	f.rotateInterval = time.Hour
+
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	f.now = func() time.Time { return ts }
+	require.NoError(t, f.Send(&message.Message{AlertName: "foo"}))
+
+	ts = ts.Add(time.Hour)
+	require.NoError(t, f.Send(&message.Message{AlertName: "bar"}))
+
+	assert.Equal(t, []string{"alerts-2021-01-02T04-04-05.000.log.gz", "alerts.log"}, readDir(t, dir))
+
+	fl, err := os.Open(filepath.Join(dir, "alerts-2021-01-02T04-04-05.000.log.gz"))
+	require.NoError(t, err)
+	defer fl.Close()
+
+	zr, err := gzip.NewReader(fl)
+	require.NoError(t, err)
+	data, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"alert_name":"foo"`)
+	assert.NotContains(t, string(data), `"alert_name":"bar"`)
+}
+
+func TestFile_removeOld(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "alerts.jsonl")
+
+	for _, name := range []string{
+		"alerts-2021-01-01T00-00-00.000.jsonl.gz",
+		"alerts-2021-01-02T00-00-00.000.jsonl",
+		"alerts-2021-01-03T00-00-00.000.jsonl.gz",
+		"alerts-foo.jsonl",
+		"other.jsonl",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+
+	f := &File{path: path, maxBackups: 1}
+	prefix, ext := f.backupName()
+	require.NoError(t, f.removeOld(prefix, ext))
+
+	assert.Equal(t, []string{
+		"alerts-2021-01-03T00-00-00.000.jsonl.gz",
+		"alerts-foo.jsonl",
+		"other.jsonl",
+	}, readDir(t, dir))
+}
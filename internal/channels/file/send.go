@@ -0,0 +1,80 @@
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
+)
+
+// record is a line of the file
+type record struct {
+	Time    time.Time `json:"time"`
+	Channel string    `json:"channel"`
+	*message.Message
+	// Alert is the alert state at the moment of sending, if available
+	Alert *alertRecord `json:"alert,omitempty"`
+}
+
+type alertRecord struct {
+	Name       string    `json:"name"`
+	Level      string    `json:"level"`
+	LastChange time.Time `json:"last_change"`
+	Start      time.Time `json:"start"`
+	Count      int       `json:"count"`
+}
+
+func newAlertRecord(a *alert.Alert) *alertRecord {
+	if a == nil {
+		return nil
+	}
+	return &alertRecord{
+		Name:       a.Name,
+		Level:      a.Level.String(),
+		LastChange: a.LastChange,
+		Start:      a.Start,
+		Count:      a.Count,
+	}
+}
+
+// Send the message to the channel
+func (f *File) Send(mes *message.Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+
+	data, err := json.Marshal(&record{
+		Time:    now,
+		Channel: f.name,
+		Message: mes,
+		Alert:   newAlertRecord(mes.Alert),
+	})
+	if err != nil {
+		return fmt.Errorf("error marshal message, %w", err)
+	}
+	data = append(data, '\n')
+
+	if f.needRotate(int64(len(data)), now) {
+		if err := f.rotate(now); err != nil {
+			return fmt.Errorf("error rotate file, %w", err)
+		}
+	}
+
+	n, err := f.f.Write(data)
+	f.size += int64(n)
+	f.openedAt = now
+	if err != nil {
+		return fmt.Errorf("error write message, %w", err)
+	}
+
+	if f.fsync {
+		if err := f.f.Sync(); err != nil {
+			return fmt.Errorf("error sync file, %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	fileCfg "github.com/balerter/balerter/internal/config/channels/file"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+
+	f, err := New(fileCfg.File{Name: "file1", Path: path, Fsync: true}, zap.NewNop())
+	require.NoError(t, err)
+	defer f.f.Close()
+
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	f.now = func() time.Time { return ts }
+
+	a := alert.New("foo")
+	a.Level = alert.LevelError
+	a.Count = 2
+	a.Start = ts
+	a.LastChange = ts
+
+	require.NoError(t, f.Send(&message.Message{
+		Level:     "error",
+		AlertName: "foo",
+		Text:      "bar",
+		Fields:    map[string]string{"k": "v"},
+		Script:    "s1",
+		Alert:     a,
+	}))
+	require.NoError(t, f.Send(&message.Message{Level: "success", AlertName: "foo", Text: "ok"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, `{"time":"2021-01-02T03:04:05Z","channel":"file1","level":"error","alert_name":"foo","text":"bar",`+
+		`"fields":{"k":"v"},"script":"s1","alert":{"name":"foo","level":"error","last_change":"2021-01-02T03:04:05Z",`+
+		`"start":"2021-01-02T03:04:05Z","count":2}}`, lines[0])
+	assert.Equal(t, `{"time":"2021-01-02T03:04:05Z","channel":"file1","level":"success","alert_name":"foo","text":"ok"}`, lines[1])
+	assert.Equal(t, int64(len(data)), f.size)
+}
@@ -0,0 +1,57 @@
+package googlechat
+
+import (
+	"github.com/balerter/balerter/internal/config/channels/googlechat"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+//go:generate moq -out http_client_mock.go -skip-ensure -fmt goimports . httpClient
+
+const (
+	defaultTimeout = time.Second * 5
+)
+
+type httpClient interface {
+	Do(r *http.Request) (*http.Response, error)
+}
+
+// GoogleChat represents a channel of type Google Chat
+type GoogleChat struct {
+	name    string
+	url     string
+	ignore  bool
+	timeout time.Duration
+
+	client httpClient
+	logger *zap.Logger
+}
+
+// New creates new Google Chat channel
+func New(cfg googlechat.GoogleChat, logger *zap.Logger) (*GoogleChat, error) {
+	gc := &GoogleChat{
+		name:    cfg.Name,
+		url:     cfg.URL,
+		ignore:  cfg.Ignore,
+		timeout: time.Millisecond * time.Duration(cfg.Timeout),
+		logger:  logger,
+	}
+
+	if gc.timeout == 0 {
+		gc.timeout = defaultTimeout
+	}
+
+	gc.client = &http.Client{}
+
+	return gc, nil
+}
+
+// Name returns the channel name
+func (gc *GoogleChat) Name() string {
+	return gc.name
+}
+
+func (gc *GoogleChat) Ignore() bool {
+	return gc.ignore
+}
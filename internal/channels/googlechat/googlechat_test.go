@@ -0,0 +1,35 @@
+package googlechat
+
+import (
+	"github.com/balerter/balerter/internal/config/channels/googlechat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	gc, err := New(googlechat.GoogleChat{Name: "foo", URL: "http://domain.com"}, zap.NewNop())
+	require.NoError(t, err)
+	assert.IsType(t, &GoogleChat{}, gc)
+	assert.Equal(t, "foo", gc.name)
+	assert.Equal(t, "http://domain.com", gc.url)
+	assert.Equal(t, defaultTimeout, gc.timeout)
+}
+
+func TestNew_timeout(t *testing.T) {
+	gc, err := New(googlechat.GoogleChat{Timeout: 100}, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, time.Millisecond*100, gc.timeout)
+}
+
+func TestName(t *testing.T) {
+	gc := &GoogleChat{name: "foo"}
+	assert.Equal(t, "foo", gc.Name())
+}
+
+func TestGoogleChat_Ignore(t *testing.T) {
+	gc := &GoogleChat{ignore: true}
+	assert.True(t, gc.Ignore())
+}
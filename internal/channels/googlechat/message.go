@@ -0,0 +1,130 @@
+package googlechat
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/balerter/balerter/internal/message"
+)
+
+type chatMessage struct {
+	CardsV2 []cardV2 `json:"cardsV2"`
+	Thread  *thread  `json:"thread,omitempty"`
+}
+
+type thread struct {
+	ThreadKey string `json:"threadKey"`
+}
+
+type cardV2 struct {
+	CardID string `json:"cardId"`
+	Card   card   `json:"card"`
+}
+
+type card struct {
+	Header   cardHeader `json:"header"`
+	Sections []section  `json:"sections"`
+}
+
+type cardHeader struct {
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+}
+
+type section struct {
+	Header  string   `json:"header,omitempty"`
+	Widgets []widget `json:"widgets"`
+}
+
+type widget struct {
+	TextParagraph *textParagraph `json:"textParagraph,omitempty"`
+	DecoratedText *decoratedText `json:"decoratedText,omitempty"`
+	Image         *image         `json:"image,omitempty"`
+}
+
+type textParagraph struct {
+	Text string `json:"text"`
+}
+
+type decoratedText struct {
+	TopLabel string `json:"topLabel"`
+	Text     string `json:"text"`
+}
+
+type image struct {
+	ImageURL string `json:"imageUrl"`
+	AltText  string `json:"altText,omitempty"`
+}
+
+// threadKey returns the thread key for an alert, so all messages of the alert land in the same thread
+func threadKey(alertName string) string {
+	return "balerter-" + alertName
+}
+
+func newChatMessage(mes *message.Message) *chatMessage {
+	m := &chatMessage{
+		Thread: &thread{
+			ThreadKey: threadKey(mes.AlertName),
+		},
+	}
+
+	mainSection := section{
+		Header: fmt.Sprintf(`<font color="%s"><b>%s</b></font>`, getColorByLevel(mes.Level), strings.ToUpper(mes.Level)),
+	}
+	if mes.Text != "" {
+		mainSection.Widgets = append(mainSection.Widgets, widget{
+			TextParagraph: &textParagraph{Text: html.EscapeString(mes.Text)},
+		})
+	}
+	if mes.Image != "" {
+		mainSection.Widgets = append(mainSection.Widgets, widget{
+			Image: &image{ImageURL: mes.Image, AltText: "chart"},
+		})
+	}
+
+	c := card{
+		Header: cardHeader{
+			Title:    mes.AlertName,
+			Subtitle: mes.Level,
+		},
+	}
+
+	if len(mainSection.Widgets) > 0 {
+		c.Sections = append(c.Sections, mainSection)
+	}
+
+	if len(mes.Fields) > 0 {
+		keys := make([]string, 0, len(mes.Fields))
+		for k := range mes.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		fieldsSection := section{}
+		for _, k := range keys {
+			fieldsSection.Widgets = append(fieldsSection.Widgets, widget{
+				DecoratedText: &decoratedText{TopLabel: k, Text: html.EscapeString(mes.Fields[k])},
+			})
+		}
+		c.Sections = append(c.Sections, fieldsSection)
+	}
+
+	m.CardsV2 = []cardV2{{CardID: "balerter", Card: c}}
+
+	return m
+}
+
+func getColorByLevel(l string) string {
+	switch l {
+	case "success":
+		return "#00aa00"
+	case "warning":
+		return "#ffcc00"
+	case "error":
+		return "#ff0000"
+	}
+
+	return "#cccccc"
+}
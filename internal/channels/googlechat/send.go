@@ -0,0 +1,68 @@
+package googlechat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+// Send the message to the Google Chat space
+func (gc *GoogleChat) Send(mes *message.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gc.timeout)
+	defer cancel()
+
+	data, err := json.Marshal(newChatMessage(mes))
+	if err != nil {
+		return fmt.Errorf("error marshal message, %w", err)
+	}
+
+	u, err := url.Parse(gc.url)
+	if err != nil {
+		return fmt.Errorf("error parse url, %w", err)
+	}
+	query := u.Query()
+	query.Set("messageReplyOption", "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD")
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+
+	resp, err := gc.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error read response body, %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		gc.logger.Error("unexpected status code from google chat request",
+			zap.Int("status", resp.StatusCode),
+			zap.ByteString("body", respBody),
+		)
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// google chat limits the message text
+const maxTextLength = 4096
+
+// TextLimit returns the limit of the message text. The long text is split into several messages
+func (gc *GoogleChat) TextLimit(*message.Message) message.Limit {
+	return message.Limit{MaxLength: maxTextLength, Mode: message.LimitSplit}
+}
@@ -0,0 +1,117 @@
+package googlechat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGoogleChat_Send_error_send_request(t *testing.T) {
+	c := &httpClientMock{
+		DoFunc: func(_ *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("err1")
+		},
+	}
+
+	gc := &GoogleChat{
+		client:  c,
+		timeout: time.Second,
+	}
+
+	err := gc.Send(&message.Message{})
+	require.Error(t, err)
+	assert.Equal(t, "err1", err.Error())
+}
+
+func TestGoogleChat_Send_bad_response_code(t *testing.T) {
+	c := &httpClientMock{
+		DoFunc: func(_ *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Body:       io.NopCloser(bytes.NewBuffer(nil)),
+				StatusCode: http.StatusBadRequest,
+			}, nil
+		},
+	}
+
+	gc := &GoogleChat{
+		client:  c,
+		timeout: time.Second,
+		logger:  zap.NewNop(),
+	}
+
+	err := gc.Send(&message.Message{})
+	require.Error(t, err)
+	assert.Equal(t, "unexpected status code 400", err.Error())
+}
+
+func TestGoogleChat_Send(t *testing.T) {
+	var body []byte
+	var reqURL string
+
+	c := &httpClientMock{
+		DoFunc: func(r *http.Request) (*http.Response, error) {
+			reqURL = r.URL.String()
+			body, _ = io.ReadAll(r.Body)
+			return &http.Response{
+				Body:       io.NopCloser(bytes.NewBuffer(nil)),
+				StatusCode: http.StatusOK,
+			}, nil
+		},
+	}
+
+	gc := &GoogleChat{
+		url:     "https://chat.googleapis.com/v1/spaces/1/messages?key=k",
+		client:  c,
+		timeout: time.Second,
+		logger:  zap.NewNop(),
+	}
+
+	err := gc.Send(&message.Message{
+		Level:     "error",
+		AlertName: "alert1",
+		Text:      "text <1>",
+		Image:     "http://domain.com/image.png",
+		Fields:    map[string]string{"b": "2", "a": "1"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://chat.googleapis.com/v1/spaces/1/messages?key=k&messageReplyOption=REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD", reqURL)
+
+	m := &chatMessage{}
+	require.NoError(t, json.Unmarshal(body, m))
+
+	require.NotNil(t, m.Thread)
+	assert.Equal(t, "balerter-alert1", m.Thread.ThreadKey)
+	require.Equal(t, 1, len(m.CardsV2))
+
+	card := m.CardsV2[0].Card
+	assert.Equal(t, "alert1", card.Header.Title)
+	assert.Equal(t, "error", card.Header.Subtitle)
+	require.Equal(t, 2, len(card.Sections))
+
+	assert.Equal(t, `<font color="#ff0000"><b>ERROR</b></font>`, card.Sections[0].Header)
+	require.Equal(t, 2, len(card.Sections[0].Widgets))
+	assert.Equal(t, "text &lt;1&gt;", card.Sections[0].Widgets[0].TextParagraph.Text)
+	assert.Equal(t, "http://domain.com/image.png", card.Sections[0].Widgets[1].Image.ImageURL)
+
+	require.Equal(t, 2, len(card.Sections[1].Widgets))
+	assert.Equal(t, "a", card.Sections[1].Widgets[0].DecoratedText.TopLabel)
+	assert.Equal(t, "1", card.Sections[1].Widgets[0].DecoratedText.Text)
+	assert.Equal(t, "b", card.Sections[1].Widgets[1].DecoratedText.TopLabel)
+}
+
+func Test_getColorByLevel(t *testing.T) {
+	assert.Equal(t, "#00aa00", getColorByLevel("success"))
+	assert.Equal(t, "#ffcc00", getColorByLevel("warning"))
+	assert.Equal(t, "#ff0000", getColorByLevel("error"))
+	assert.Equal(t, "#cccccc", getColorByLevel("foo"))
+}
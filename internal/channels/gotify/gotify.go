@@ -0,0 +1,67 @@
+package gotify
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/channels/gotify"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTimeout = time.Second * 5
+	defaultTitle   = "[{LEVEL}] {ALERT_NAME}"
+	defaultBody    = "{TEXT}\n\n{FIELDS}"
+)
+
+// Gotify represents a channel of type Gotify
+type Gotify struct {
+	name    string
+	url     string
+	token   string
+	title   string
+	body    string
+	ignore  bool
+	timeout time.Duration
+
+	client *http.Client
+	logger *zap.Logger
+}
+
+// New creates new Gotify channel
+func New(cfg gotify.Gotify, logger *zap.Logger) (*Gotify, error) {
+	g := &Gotify{
+		name:    cfg.Name,
+		url:     strings.TrimRight(cfg.URL, "/"),
+		token:   cfg.Token,
+		title:   cfg.Title,
+		body:    cfg.Body,
+		ignore:  cfg.Ignore,
+		timeout: time.Millisecond * time.Duration(cfg.Timeout),
+		logger:  logger,
+	}
+
+	if g.title == "" {
+		g.title = defaultTitle
+	}
+	if g.body == "" {
+		g.body = defaultBody
+	}
+	if g.timeout == 0 {
+		g.timeout = defaultTimeout
+	}
+
+	g.client = &http.Client{}
+
+	return g, nil
+}
+
+// Name returns the channel name
+func (g *Gotify) Name() string {
+	return g.name
+}
+
+func (g *Gotify) Ignore() bool {
+	return g.ignore
+}
@@ -0,0 +1,30 @@
+package gotify
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/channels/gotify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNew(t *testing.T) {
+	g, err := New(gotify.Gotify{Name: "foo", URL: "https://gotify.example.com/", Token: "t"}, zap.NewNop())
+	require.NoError(t, err)
+	assert.IsType(t, &Gotify{}, g)
+	assert.Equal(t, "https://gotify.example.com", g.url)
+	assert.Equal(t, defaultTitle, g.title)
+	assert.Equal(t, defaultBody, g.body)
+	assert.Equal(t, defaultTimeout, g.timeout)
+}
+
+func TestName(t *testing.T) {
+	g := &Gotify{name: "foo"}
+	assert.Equal(t, "foo", g.Name())
+}
+
+func TestGotify_Ignore(t *testing.T) {
+	g := &Gotify{ignore: true}
+	assert.True(t, g.Ignore())
+}
@@ -0,0 +1,88 @@
+package gotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+type gotifyMessage struct {
+	Title    string                 `json:"title"`
+	Message  string                 `json:"message"`
+	Priority int                    `json:"priority"`
+	Extras   map[string]interface{} `json:"extras,omitempty"`
+}
+
+// priority returns Gotify priority (0-10) for the alert level
+func priority(level string) int {
+	switch level {
+	case "success":
+		return 2
+	case "warning":
+		return 5
+	case "error":
+		return 8
+	}
+	return 2
+}
+
+// Send the message to the Gotify server
+func (g *Gotify) Send(mes *message.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+
+	m := &gotifyMessage{
+		Title:    mes.Render(g.title),
+		Message:  strings.TrimSpace(mes.Render(g.body)),
+		Priority: priority(mes.Level),
+	}
+
+	// Gotify clients support only images by URL
+	if strings.HasPrefix(mes.Image, "http") {
+		m.Extras = map[string]interface{}{
+			"client::notification": map[string]string{
+				"bigImageUrl": mes.Image,
+			},
+		}
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("error marshal message, %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.url+"/message", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gotify-Key", g.token)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error read response body, %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		g.logger.Error("unexpected status code from gotify request",
+			zap.Int("status", resp.StatusCode),
+			zap.ByteString("body", respBody),
+		)
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
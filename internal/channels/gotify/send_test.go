@@ -0,0 +1,79 @@
+package gotify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestGotify(url string) *Gotify {
+	return &Gotify{
+		url:     url,
+		token:   "token1",
+		title:   defaultTitle,
+		body:    defaultBody,
+		timeout: time.Second,
+		client:  &http.Client{},
+		logger:  zap.NewNop(),
+	}
+}
+
+func TestSend(t *testing.T) {
+	m := map[string]interface{}{}
+
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/message", req.URL.Path)
+		assert.Equal(t, "token1", req.Header.Get("X-Gotify-Key"))
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&m))
+	}))
+	defer s.Close()
+
+	err := newTestGotify(s.URL).Send(&message.Message{
+		Level:     "warning",
+		AlertName: "foo",
+		Text:      "bar",
+		Image:     "https://domain.com/image.png",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "[warning] foo", m["title"])
+	assert.Equal(t, "bar", m["message"])
+	assert.Equal(t, float64(5), m["priority"])
+	assert.Equal(t, map[string]interface{}{
+		"client::notification": map[string]interface{}{"bigImageUrl": "https://domain.com/image.png"},
+	}, m["extras"])
+}
+
+func TestSend_raw_image(t *testing.T) {
+	m := map[string]interface{}{}
+
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&m))
+	}))
+	defer s.Close()
+
+	err := newTestGotify(s.URL).Send(&message.Message{Level: "error", Image: "PNGDATA"})
+	require.NoError(t, err)
+
+	_, ok := m["extras"]
+	assert.False(t, ok)
+	assert.Equal(t, float64(8), m["priority"])
+}
+
+func TestSend_error(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer s.Close()
+
+	err := newTestGotify(s.URL).Send(&message.Message{Level: "success"})
+	require.Error(t, err)
+	assert.Equal(t, "unexpected status code 401", err.Error())
+}
@@ -0,0 +1,119 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/channels/kafka"
+	"github.com/balerter/balerter/internal/util"
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	"go.uber.org/zap"
+)
+
+//go:generate moq -out module_mock_producer.go -skip-ensure -fmt goimports . producer
+
+const (
+	defaultTimeout = time.Second * 10
+	clientID       = "balerter"
+)
+
+// producer writes the messages to the topic
+type producer interface {
+	WriteMessages(ctx context.Context, msgs ...kafkago.Message) error
+	Close() error
+}
+
+// Kafka represents a channel of type Kafka
+//
+// The channel keeps one synchronous producer: every message is written with acks=all,
+// so the send error is returned to the caller
+type Kafka struct {
+	name     string
+	topic    string
+	ignore   bool
+	timeout  time.Duration
+	producer producer
+
+	logger *zap.Logger
+}
+
+// New creates new Kafka channel
+func New(cfg kafka.Kafka, logger *zap.Logger) (*Kafka, error) {
+	k := &Kafka{
+		name:    cfg.Name,
+		topic:   cfg.Topic,
+		ignore:  cfg.Ignore,
+		timeout: time.Millisecond * time.Duration(cfg.Timeout),
+		logger:  logger,
+	}
+
+	if k.timeout == 0 {
+		k.timeout = defaultTimeout
+	}
+
+	transport := &kafkago.Transport{
+		ClientID:    clientID,
+		DialTimeout: k.timeout,
+	}
+
+	if cfg.TLS != nil {
+		var err error
+		transport.TLS, err = util.TLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("error create tls config, %w", err)
+		}
+	}
+
+	if cfg.SASL != nil {
+		var err error
+		transport.SASL, err = saslMechanism(cfg.SASL)
+		if err != nil {
+			return nil, fmt.Errorf("error create sasl mechanism, %w", err)
+		}
+	}
+
+	k.producer = &kafkago.Writer{
+		Addr:  kafkago.TCP(cfg.Brokers...),
+		Topic: cfg.Topic,
+		// the same partitioner as the java client, all messages of the alert go to the same partition
+		Balancer:     &kafkago.Murmur2Balancer{},
+		RequiredAcks: kafkago.RequireAll,
+		// the message is written immediately, without waiting for a batch
+		BatchSize:    1,
+		WriteTimeout: k.timeout,
+		ReadTimeout:  k.timeout,
+		Transport:    transport,
+	}
+
+	return k, nil
+}
+
+func saslMechanism(cfg *kafka.SASL) (sasl.Mechanism, error) {
+	switch cfg.Mechanism {
+	case "plain":
+		return plain.Mechanism{Username: cfg.Username, Password: cfg.Password}, nil
+	case "scram-sha-256":
+		return scram.Mechanism(scram.SHA256, cfg.Username, cfg.Password)
+	case "scram-sha-512":
+		return scram.Mechanism(scram.SHA512, cfg.Username, cfg.Password)
+	}
+	return nil, fmt.Errorf("unsupported sasl mechanism '%s'", cfg.Mechanism)
+}
+
+// Name returns the channel name
+func (k *Kafka) Name() string {
+	return k.name
+}
+
+func (k *Kafka) Ignore() bool {
+	return k.ignore
+}
+
+// Stop closes the producer
+func (k *Kafka) Stop() error {
+	return k.producer.Close()
+}
@@ -0,0 +1,75 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/channels/kafka"
+	"github.com/balerter/balerter/internal/config/common"
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNew(t *testing.T) {
+	k, err := New(kafka.Kafka{
+		Name:    "foo",
+		Brokers: []string{"127.0.0.1:9092"},
+		Topic:   "alerts",
+		TLS:     &common.TLS{ServerName: "kafka"},
+		SASL:    &kafka.SASL{Mechanism: "plain", Username: "u", Password: "p"},
+	}, zap.NewNop())
+	require.NoError(t, err)
+	assert.IsType(t, &Kafka{}, k)
+	assert.Equal(t, defaultTimeout, k.timeout)
+
+	w, ok := k.producer.(*kafkago.Writer)
+	require.True(t, ok)
+	assert.Equal(t, "alerts", w.Topic)
+	assert.Equal(t, "127.0.0.1:9092", w.Addr.String())
+	assert.Equal(t, kafkago.RequireAll, w.RequiredAcks)
+	assert.IsType(t, &kafkago.Murmur2Balancer{}, w.Balancer)
+
+	tr, ok := w.Transport.(*kafkago.Transport)
+	require.True(t, ok)
+	assert.Equal(t, "kafka", tr.TLS.ServerName)
+	assert.Equal(t, "PLAIN", tr.SASL.Name())
+
+	require.NoError(t, k.Stop())
+}
+
+func TestNew_scram(t *testing.T) {
+	k, err := New(kafka.Kafka{
+		Name:    "foo",
+		Brokers: []string{"127.0.0.1:9092"},
+		Topic:   "alerts",
+		SASL:    &kafka.SASL{Mechanism: "scram-sha-512", Username: "u", Password: "p"},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	tr := k.producer.(*kafkago.Writer).Transport.(*kafkago.Transport)
+	assert.Nil(t, tr.TLS)
+	assert.Equal(t, "SCRAM-SHA-512", tr.SASL.Name())
+}
+
+func TestNew_error_sasl(t *testing.T) {
+	_, err := New(kafka.Kafka{Name: "foo", SASL: &kafka.SASL{Mechanism: "gssapi"}}, zap.NewNop())
+	require.Error(t, err)
+	assert.Equal(t, "error create sasl mechanism, unsupported sasl mechanism 'gssapi'", err.Error())
+}
+
+func TestNew_error_tls(t *testing.T) {
+	_, err := New(kafka.Kafka{Name: "foo", TLS: &common.TLS{CA: "/not/exists"}}, zap.NewNop())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error create tls config, error read ca file")
+}
+
+func TestName(t *testing.T) {
+	k := &Kafka{name: "foo"}
+	assert.Equal(t, "foo", k.Name())
+}
+
+func TestKafka_Ignore(t *testing.T) {
+	k := &Kafka{ignore: true}
+	assert.True(t, k.Ignore())
+}
@@ -0,0 +1,38 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/balerter/balerter/internal/message"
+	kafkago "github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// Send the message to the Kafka topic. The alert name is used as the record key,
+// so all messages of the alert go to the same partition
+func (k *Kafka) Send(mes *message.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), k.timeout)
+	defer cancel()
+
+	value, err := json.Marshal(message.NewEvent(mes))
+	if err != nil {
+		return fmt.Errorf("error marshal message, %w", err)
+	}
+
+	err = k.producer.WriteMessages(ctx, kafkago.Message{
+		Key:   []byte(mes.AlertName),
+		Value: value,
+		Headers: []kafkago.Header{
+			{Key: "alert_name", Value: []byte(mes.AlertName)},
+			{Key: "level", Value: []byte(mes.Level)},
+		},
+	})
+	if err != nil {
+		k.logger.Error("error produce message to kafka", zap.String("topic", k.topic), zap.Error(err))
+		return fmt.Errorf("error produce message, %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestKafka_Send(t *testing.T) {
+	p := &producerMock{
+		WriteMessagesFunc: func(ctx context.Context, msgs ...kafkago.Message) error {
+			return nil
+		},
+	}
+	k := &Kafka{topic: "alerts", timeout: time.Second, producer: p, logger: zap.NewNop()}
+
+	start := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	mes := message.New("error", "foo", "text", "", nil)
+	mes.Alert = &alert.Alert{Name: "foo", Level: alert.LevelError, Count: 2, Start: start}
+
+	require.NoError(t, k.Send(mes))
+
+	require.Len(t, p.WriteMessagesCalls(), 1)
+	call := p.WriteMessagesCalls()[0]
+	_, ok := call.Ctx.Deadline()
+	assert.True(t, ok)
+	require.Len(t, call.Msgs, 1)
+
+	m := call.Msgs[0]
+	assert.Equal(t, []byte("foo"), m.Key)
+	assert.Equal(t, []kafkago.Header{
+		{Key: "alert_name", Value: []byte("foo")},
+		{Key: "level", Value: []byte("error")},
+	}, m.Headers)
+
+	e := &message.Event{}
+	require.NoError(t, json.Unmarshal(m.Value, e))
+	assert.Equal(t, "text", e.Message.Text)
+	assert.Equal(t, "foo", e.Alert.Name)
+	assert.Equal(t, "error", e.Alert.Level)
+	assert.Equal(t, 2, e.Alert.Count)
+	assert.Equal(t, start, e.Alert.Start)
+}
+
+func TestKafka_Send_error(t *testing.T) {
+	p := &producerMock{
+		WriteMessagesFunc: func(ctx context.Context, msgs ...kafkago.Message) error {
+			return fmt.Errorf("err1")
+		},
+	}
+	k := &Kafka{topic: "alerts", timeout: time.Second, producer: p, logger: zap.NewNop()}
+
+	err := k.Send(message.New("error", "foo", "text", "", nil))
+	require.Error(t, err)
+	assert.Equal(t, "error produce message, err1", err.Error())
+}
+
+func TestKafka_Stop(t *testing.T) {
+	p := &producerMock{
+		CloseFunc: func() error {
+			return nil
+		},
+	}
+	k := &Kafka{producer: p}
+
+	require.NoError(t, k.Stop())
+	assert.Len(t, p.CloseCalls(), 1)
+}
@@ -0,0 +1,71 @@
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type whoamiResponse struct {
+	UserID string `json:"user_id"`
+}
+
+type uploadResponse struct {
+	ContentURI string `json:"content_uri"`
+}
+
+type sendResponse struct {
+	EventID string `json:"event_id"`
+}
+
+// apiError represents an error response of the Matrix Client-Server API
+type apiError struct {
+	Status  int    `json:"-"`
+	Code    string `json:"errcode"`
+	Message string `json:"error"`
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("matrix api error %d %s: %s", e.Status, e.Code, e.Message)
+}
+
+// do sends a request to the homeserver and decodes a response to the out, if it is not nil
+func (m *Matrix) do(ctx context.Context, method, path string, body io.Reader, contentType string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, m.url+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+m.token)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error read response body, %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		e := &apiError{Status: resp.StatusCode}
+		_ = json.Unmarshal(respBody, e)
+		return e
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("error unmarshal response body, %w", err)
+	}
+
+	return nil
+}
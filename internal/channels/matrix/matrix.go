@@ -0,0 +1,94 @@
+package matrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/channels/matrix"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTimeout = time.Second * 5
+)
+
+var (
+	// ErrEncryptedRoom returns if the room has enabled end-to-end encryption
+	ErrEncryptedRoom = errors.New("end-to-end encrypted rooms are not supported, use an unencrypted room")
+)
+
+// Matrix represents a channel of type Matrix
+type Matrix struct {
+	name    string
+	url     string
+	token   string
+	roomID  string
+	ignore  bool
+	timeout time.Duration
+
+	client *http.Client
+	logger *zap.Logger
+}
+
+// New creates new Matrix channel
+func New(cfg matrix.Matrix, logger *zap.Logger) (*Matrix, error) {
+	m := &Matrix{
+		name:    cfg.Name,
+		url:     strings.TrimRight(cfg.URL, "/"),
+		token:   cfg.Token,
+		roomID:  cfg.RoomID,
+		ignore:  cfg.Ignore,
+		timeout: time.Millisecond * time.Duration(cfg.Timeout),
+		logger:  logger,
+	}
+
+	if m.timeout == 0 {
+		m.timeout = defaultTimeout
+	}
+
+	m.client = &http.Client{}
+
+	if err := m.login(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// login checks the access token and the room encryption state
+func (m *Matrix) login() error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	whoami := &whoamiResponse{}
+	if err := m.do(ctx, http.MethodGet, "/_matrix/client/v3/account/whoami", nil, "", whoami); err != nil {
+		return fmt.Errorf("error check access token, %w", err)
+	}
+
+	m.logger.Debug("matrix login", zap.String("channel", m.name), zap.String("user id", whoami.UserID))
+
+	err := m.do(ctx, http.MethodGet, "/_matrix/client/v3/rooms/"+url.PathEscape(m.roomID)+"/state/m.room.encryption", nil, "", nil)
+	if err == nil {
+		return ErrEncryptedRoom
+	}
+	var apiErr *apiError
+	if errors.As(err, &apiErr) && apiErr.Code == "M_NOT_FOUND" {
+		return nil
+	}
+
+	return fmt.Errorf("error check room encryption, %w", err)
+}
+
+// Name returns the channel name
+func (m *Matrix) Name() string {
+	return m.name
+}
+
+func (m *Matrix) Ignore() bool {
+	return m.ignore
+}
@@ -0,0 +1,75 @@
+package matrix
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/channels/matrix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestServer(t *testing.T, encrypted bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "Bearer token1", req.Header.Get("Authorization"))
+
+		switch req.URL.EscapedPath() {
+		case "/_matrix/client/v3/account/whoami":
+			_, _ = rw.Write([]byte(`{"user_id":"@bot:example.com"}`))
+		case "/_matrix/client/v3/rooms/%21room:example.com/state/m.room.encryption":
+			if encrypted {
+				_, _ = rw.Write([]byte(`{"algorithm":"m.megolm.v1.aes-sha2"}`))
+				return
+			}
+			rw.WriteHeader(http.StatusNotFound)
+			_, _ = rw.Write([]byte(`{"errcode":"M_NOT_FOUND","error":"Event not found."}`))
+		default:
+			rw.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+func TestNew(t *testing.T) {
+	s := newTestServer(t, false)
+	defer s.Close()
+
+	m, err := New(matrix.Matrix{Name: "foo", URL: s.URL + "/", Token: "token1", RoomID: "!room:example.com"}, zap.NewNop())
+	require.NoError(t, err)
+	assert.IsType(t, &Matrix{}, m)
+	assert.Equal(t, "foo", m.name)
+	assert.Equal(t, s.URL, m.url)
+	assert.Equal(t, defaultTimeout, m.timeout)
+}
+
+func TestNew_encrypted_room(t *testing.T) {
+	s := newTestServer(t, true)
+	defer s.Close()
+
+	_, err := New(matrix.Matrix{Name: "foo", URL: s.URL, Token: "token1", RoomID: "!room:example.com"}, zap.NewNop())
+	require.Error(t, err)
+	assert.Equal(t, ErrEncryptedRoom, err)
+}
+
+func TestNew_bad_token(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusUnauthorized)
+		_, _ = rw.Write([]byte(`{"errcode":"M_UNKNOWN_TOKEN","error":"Invalid access token"}`))
+	}))
+	defer s.Close()
+
+	_, err := New(matrix.Matrix{Name: "foo", URL: s.URL, Token: "token1", RoomID: "!room:example.com"}, zap.NewNop())
+	require.Error(t, err)
+	assert.Equal(t, "error check access token, matrix api error 401 M_UNKNOWN_TOKEN: Invalid access token", err.Error())
+}
+
+func TestName(t *testing.T) {
+	m := &Matrix{name: "foo"}
+	assert.Equal(t, "foo", m.Name())
+}
+
+func TestMatrix_Ignore(t *testing.T) {
+	m := &Matrix{ignore: true}
+	assert.True(t, m.Ignore())
+}
@@ -0,0 +1,89 @@
+package matrix
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/balerter/balerter/internal/message"
+)
+
+type textEvent struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format,omitempty"`
+	FormattedBody string `json:"formatted_body,omitempty"`
+}
+
+type fileEvent struct {
+	MsgType string   `json:"msgtype"`
+	Body    string   `json:"body"`
+	URL     string   `json:"url"`
+	Info    fileInfo `json:"info"`
+}
+
+type fileInfo struct {
+	MimeType string `json:"mimetype"`
+	Size     int    `json:"size"`
+}
+
+func newTextEvent(mes *message.Message) *textEvent {
+	keys := make([]string, 0, len(mes.Fields))
+	for k := range mes.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	plain := fmt.Sprintf("[%s] %s\n%s", strings.ToUpper(mes.Level), mes.AlertName, mes.Text)
+	for _, k := range keys {
+		plain += fmt.Sprintf("\n%s = %s", k, mes.Fields[k])
+	}
+
+	color := getColorByLevel(mes.Level)
+
+	formatted := fmt.Sprintf(`<font color="%s" data-mx-color="%s"><b>%s</b></font> <b>%s</b><br/>%s`,
+		color,
+		color,
+		strings.ToUpper(mes.Level),
+		html.EscapeString(mes.AlertName),
+		strings.ReplaceAll(html.EscapeString(mes.Text), "\n", "<br/>"),
+	)
+
+	if len(keys) > 0 {
+		formatted += "<table>"
+		for _, k := range keys {
+			formatted += fmt.Sprintf("<tr><td><b>%s</b></td><td>%s</td></tr>", html.EscapeString(k), html.EscapeString(mes.Fields[k]))
+		}
+		formatted += "</table>"
+	}
+
+	if len(mes.Links) > 0 {
+		links := make([]string, 0, len(mes.Links))
+		for _, l := range mes.Links {
+			plain += fmt.Sprintf("\n%s: %s", l.Label, l.URL)
+			links = append(links, fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(l.URL), html.EscapeString(l.Label)))
+		}
+		formatted += "<p>" + strings.Join(links, " | ") + "</p>"
+	}
+
+	return &textEvent{
+		MsgType:       "m.text",
+		Body:          plain,
+		Format:        "org.matrix.custom.html",
+		FormattedBody: formatted,
+	}
+}
+
+func getColorByLevel(l string) string {
+	switch l {
+	case "success":
+		return "#00aa00"
+	case "warning":
+		return "#ffcc00"
+	case "error":
+		return "#ff0000"
+	}
+
+	return "#cccccc"
+}
@@ -0,0 +1,159 @@
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+var txnCounter uint64
+
+// Send the message to the Matrix room
+func (m *Matrix) Send(mes *message.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	if err := m.sendEvent(ctx, newTextEvent(mes)); err != nil {
+		return fmt.Errorf("error send text message, %w", err)
+	}
+
+	if mes.Image != "" {
+		if err := m.sendImage(ctx, mes.Image); err != nil {
+			return err
+		}
+	}
+
+	for _, a := range mes.Attachments {
+		if err := m.sendFile(ctx, a.Name, a.ContentType, a.Data); err != nil {
+			return fmt.Errorf("error send attachment %s, %w", a.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SupportsAttachments returns true, the attachments are uploaded to the media repository and sent as the files
+func (m *Matrix) SupportsAttachments() bool {
+	return true
+}
+
+// SupportsLinks returns true, the links are rendered in the formatted body
+func (m *Matrix) SupportsLinks() bool {
+	return true
+}
+
+func (m *Matrix) sendImage(ctx context.Context, image string) error {
+	data, err := m.getImage(ctx, image)
+	if err != nil {
+		return fmt.Errorf("error get image, %w", err)
+	}
+
+	contentURI, err := m.upload(ctx, "chart.png", "image/png", data)
+	if err != nil {
+		return fmt.Errorf("error upload image, %w", err)
+	}
+
+	img := &fileEvent{
+		MsgType: "m.image",
+		Body:    "chart.png",
+		URL:     contentURI,
+		Info: fileInfo{
+			MimeType: "image/png",
+			Size:     len(data),
+		},
+	}
+
+	if err := m.sendEvent(ctx, img); err != nil {
+		return fmt.Errorf("error send image message, %w", err)
+	}
+
+	return nil
+}
+
+func (m *Matrix) sendFile(ctx context.Context, name, contentType string, data []byte) error {
+	contentURI, err := m.upload(ctx, name, contentType, data)
+	if err != nil {
+		return fmt.Errorf("error upload file, %w", err)
+	}
+
+	file := &fileEvent{
+		MsgType: "m.file",
+		Body:    name,
+		URL:     contentURI,
+		Info: fileInfo{
+			MimeType: contentType,
+			Size:     len(data),
+		},
+	}
+
+	if err := m.sendEvent(ctx, file); err != nil {
+		return fmt.Errorf("error send file message, %w", err)
+	}
+
+	return nil
+}
+
+// upload uploads the content to the media repository and returns the content URI
+func (m *Matrix) upload(ctx context.Context, name, contentType string, data []byte) (string, error) {
+	resp := &uploadResponse{}
+	err := m.do(ctx, http.MethodPost, "/_matrix/media/v3/upload?filename="+url.QueryEscape(name), bytes.NewReader(data), contentType, resp)
+	if err != nil {
+		return "", err
+	}
+	return resp.ContentURI, nil
+}
+
+func (m *Matrix) sendEvent(ctx context.Context, event interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	txnID := strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + strconv.FormatUint(atomic.AddUint64(&txnCounter, 1), 10)
+
+	resp := &sendResponse{}
+	err = m.do(ctx, http.MethodPut, "/_matrix/client/v3/rooms/"+url.PathEscape(m.roomID)+"/send/m.room.message/"+txnID,
+		bytes.NewReader(data), "application/json", resp)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Debug("send matrix message", zap.String("room", m.roomID), zap.String("event id", resp.EventID))
+
+	return nil
+}
+
+// getImage returns image content. The image may be an URL or raw image data
+func (m *Matrix) getImage(ctx context.Context, image string) ([]byte, error) {
+	if !strings.HasPrefix(image, "http") {
+		return []byte(image), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, image, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
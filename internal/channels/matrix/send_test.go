@@ -0,0 +1,174 @@
+package matrix
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSend(t *testing.T) {
+	var events []map[string]interface{}
+	var uploaded []byte
+
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == "/image.png":
+			_, _ = rw.Write([]byte("PNGDATA"))
+		case req.URL.Path == "/_matrix/media/v3/upload":
+			assert.Equal(t, "image/png", req.Header.Get("Content-Type"))
+			uploaded, _ = io.ReadAll(req.Body)
+			_, _ = rw.Write([]byte(`{"content_uri":"mxc://example.com/abc"}`))
+		case strings.HasPrefix(req.URL.Path, "/_matrix/client/v3/rooms/!room:example.com/send/m.room.message/"):
+			assert.Equal(t, http.MethodPut, req.Method)
+			e := map[string]interface{}{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&e))
+			events = append(events, e)
+			_, _ = rw.Write([]byte(`{"event_id":"$1"}`))
+		default:
+			rw.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer s.Close()
+
+	m := &Matrix{
+		url:     s.URL,
+		roomID:  "!room:example.com",
+		client:  &http.Client{},
+		timeout: time.Second,
+		logger:  zap.NewNop(),
+	}
+
+	err := m.Send(&message.Message{
+		Level:     "error",
+		AlertName: "alert1",
+		Text:      "text <1>",
+		Image:     s.URL + "/image.png",
+		Fields:    map[string]string{"a": "1"},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, "m.text", events[0]["msgtype"])
+	assert.Equal(t, "[ERROR] alert1\ntext <1>\na = 1", events[0]["body"])
+	assert.Equal(t, "org.matrix.custom.html", events[0]["format"])
+	assert.Equal(t, `<font color="#ff0000" data-mx-color="#ff0000"><b>ERROR</b></font> <b>alert1</b><br/>text &lt;1&gt;`+
+		`<table><tr><td><b>a</b></td><td>1</td></tr></table>`, events[0]["formatted_body"])
+
+	assert.Equal(t, "PNGDATA", string(uploaded))
+	assert.Equal(t, "m.image", events[1]["msgtype"])
+	assert.Equal(t, "mxc://example.com/abc", events[1]["url"])
+}
+
+func TestSend_raw_image(t *testing.T) {
+	var uploaded []byte
+
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/_matrix/media/v3/upload" {
+			uploaded, _ = io.ReadAll(req.Body)
+			_, _ = rw.Write([]byte(`{"content_uri":"mxc://example.com/abc"}`))
+			return
+		}
+		_, _ = rw.Write([]byte(`{"event_id":"$1"}`))
+	}))
+	defer s.Close()
+
+	m := &Matrix{
+		url:     s.URL,
+		roomID:  "!room:example.com",
+		client:  &http.Client{},
+		timeout: time.Second,
+		logger:  zap.NewNop(),
+	}
+
+	err := m.Send(&message.Message{Level: "success", Image: "RAWDATA"})
+	require.NoError(t, err)
+	assert.Equal(t, "RAWDATA", string(uploaded))
+}
+
+func TestSend_attachments(t *testing.T) {
+	var uploaded []byte
+	var uploadURI string
+	var file map[string]interface{}
+
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/_matrix/media/v3/upload" {
+			uploaded, _ = io.ReadAll(req.Body)
+			uploadURI = req.URL.RequestURI()
+			assert.Equal(t, "text/csv", req.Header.Get("Content-Type"))
+			_, _ = rw.Write([]byte(`{"content_uri":"mxc://example.com/abc"}`))
+			return
+		}
+		e := map[string]interface{}{}
+		_ = json.NewDecoder(req.Body).Decode(&e)
+		if e["msgtype"] == "m.file" {
+			file = e
+		}
+		_, _ = rw.Write([]byte(`{"event_id":"$1"}`))
+	}))
+	defer s.Close()
+
+	m := &Matrix{
+		url:     s.URL,
+		roomID:  "!room:example.com",
+		client:  &http.Client{},
+		timeout: time.Second,
+		logger:  zap.NewNop(),
+	}
+
+	err := m.Send(&message.Message{
+		Level:       "error",
+		Attachments: []alert.Attachment{{Name: "rows 1.csv", ContentType: "text/csv", Data: []byte("a,b")}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "a,b", string(uploaded))
+	assert.Equal(t, "/_matrix/media/v3/upload?filename=rows+1.csv", uploadURI)
+	require.NotNil(t, file)
+	assert.Equal(t, "rows 1.csv", file["body"])
+	assert.Equal(t, "mxc://example.com/abc", file["url"])
+}
+
+func TestSend_error(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusForbidden)
+		_, _ = rw.Write([]byte(`{"errcode":"M_FORBIDDEN","error":"not in room"}`))
+	}))
+	defer s.Close()
+
+	m := &Matrix{
+		url:     s.URL,
+		roomID:  "!room:example.com",
+		client:  &http.Client{},
+		timeout: time.Second,
+		logger:  zap.NewNop(),
+	}
+
+	err := m.Send(&message.Message{Level: "success"})
+	require.Error(t, err)
+	assert.Equal(t, "error send text message, matrix api error 403 M_FORBIDDEN: not in room", err.Error())
+}
+
+func Test_newTextEvent_links(t *testing.T) {
+	e := newTextEvent(&message.Message{
+		Level:     "error",
+		AlertName: "alert1",
+		Text:      "text",
+		Links: []alert.Link{
+			{Label: "Runbook", URL: "https://wiki.example.com/rb?a=1&b=2"},
+			{Label: "Logs", URL: "https://logs.example.com"},
+		},
+	})
+
+	assert.Equal(t, "[ERROR] alert1\ntext\nRunbook: https://wiki.example.com/rb?a=1&b=2\nLogs: https://logs.example.com", e.Body)
+	assert.True(t, strings.HasSuffix(e.FormattedBody,
+		`<p><a href="https://wiki.example.com/rb?a=1&amp;b=2">Runbook</a> | <a href="https://logs.example.com">Logs</a></p>`))
+}
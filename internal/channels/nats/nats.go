@@ -0,0 +1,139 @@
+package nats
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/channels/nats"
+	"github.com/balerter/balerter/internal/util"
+	natsgo "github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTimeout = time.Second * 5
+	clientName     = "balerter"
+)
+
+// Nats represents a channel of type NATS
+//
+// The channel keeps one connection, it is opened on the first message and reconnects by the client.
+// Every message waits for the server (or the JetStream stream) acknowledgement,
+// so the send error is returned to the caller
+type Nats struct {
+	name      string
+	url       string
+	tlsConfig *tls.Config
+	options   []natsgo.Option
+	subject   string
+	jetStream bool
+	ignore    bool
+	timeout   time.Duration
+
+	mu   sync.Mutex
+	conn *natsgo.Conn
+
+	logger *zap.Logger
+}
+
+// New creates new NATS channel
+func New(cfg nats.Nats, logger *zap.Logger) (*Nats, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("error parse url, %w", err)
+	}
+
+	n := &Nats{
+		name:      cfg.Name,
+		url:       cfg.URL,
+		subject:   cfg.Subject,
+		jetStream: cfg.JetStream,
+		ignore:    cfg.Ignore,
+		timeout:   time.Millisecond * time.Duration(cfg.Timeout),
+		logger:    logger,
+	}
+
+	if n.timeout == 0 {
+		n.timeout = defaultTimeout
+	}
+
+	n.options = []natsgo.Option{
+		natsgo.Name(clientName),
+		natsgo.Timeout(n.timeout),
+		// the connection lives until the channel is stopped
+		natsgo.MaxReconnects(-1),
+		natsgo.ErrorHandler(n.errorHandler),
+	}
+
+	if cfg.Token != "" {
+		n.options = append(n.options, natsgo.Token(cfg.Token))
+	}
+	if cfg.Username != "" {
+		n.options = append(n.options, natsgo.UserInfo(cfg.Username, cfg.Password))
+	}
+
+	if cfg.TLS != nil {
+		n.tlsConfig, err = util.TLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("error create tls config, %w", err)
+		}
+	} else if u.Scheme == "tls" {
+		n.tlsConfig = &tls.Config{} // nolint:gosec // MinVersion is set below
+	}
+	if n.tlsConfig != nil {
+		n.tlsConfig.MinVersion = tls.VersionTLS12
+		if n.tlsConfig.ServerName == "" {
+			n.tlsConfig.ServerName = u.Hostname()
+		}
+		n.options = append(n.options, natsgo.Secure(n.tlsConfig))
+	}
+
+	return n, nil
+}
+
+// errorHandler logs the asynchronous errors, e.g. the publish permissions violations
+func (n *Nats) errorHandler(_ *natsgo.Conn, _ *natsgo.Subscription, err error) {
+	n.logger.Error("nats error", zap.String("channel name", n.name), zap.Error(err))
+}
+
+// connection returns the channel connection, it connects to the server if the connection is not opened yet
+func (n *Nats) connection() (*natsgo.Conn, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.conn != nil && !n.conn.IsClosed() {
+		return n.conn, nil
+	}
+
+	conn, err := natsgo.Connect(n.url, n.options...)
+	if err != nil {
+		return nil, fmt.Errorf("error connect to %s, %w", n.url, err)
+	}
+	n.conn = conn
+
+	return conn, nil
+}
+
+// Name returns the channel name
+func (n *Nats) Name() string {
+	return n.name
+}
+
+func (n *Nats) Ignore() bool {
+	return n.ignore
+}
+
+// Stop closes the connection
+func (n *Nats) Stop() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.conn != nil {
+		n.conn.Close()
+	}
+
+	return nil
+}
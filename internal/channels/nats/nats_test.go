@@ -0,0 +1,46 @@
+package nats
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/channels/nats"
+	"github.com/balerter/balerter/internal/config/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNew(t *testing.T) {
+	n, err := New(nats.Nats{Name: "foo", URL: "nats://127.0.0.1", Subject: "alerts"}, zap.NewNop())
+	require.NoError(t, err)
+	assert.IsType(t, &Nats{}, n)
+	assert.Equal(t, "nats://127.0.0.1", n.url)
+	assert.Equal(t, defaultTimeout, n.timeout)
+	assert.Nil(t, n.tlsConfig)
+	assert.Len(t, n.options, 4)
+	assert.Nil(t, n.conn)
+
+	require.NoError(t, n.Stop())
+}
+
+func TestNew_tls(t *testing.T) {
+	n, err := New(nats.Nats{Name: "foo", URL: "tls://nats.example.com:4443", Subject: "alerts"}, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, "tls://nats.example.com:4443", n.url)
+	require.NotNil(t, n.tlsConfig)
+	assert.Equal(t, "nats.example.com", n.tlsConfig.ServerName)
+
+	_, err = New(nats.Nats{Name: "foo", URL: "nats://127.0.0.1", TLS: &common.TLS{CA: "/not/exists"}}, zap.NewNop())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error create tls config, error read ca file")
+}
+
+func TestName(t *testing.T) {
+	n := &Nats{name: "foo"}
+	assert.Equal(t, "foo", n.Name())
+}
+
+func TestNats_Ignore(t *testing.T) {
+	n := &Nats{ignore: true}
+	assert.True(t, n.Ignore())
+}
@@ -0,0 +1,70 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/balerter/balerter/internal/message"
+	natsgo "github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+var subjectReplacer = strings.NewReplacer(" ", "_", "\t", "_", "\r", "_", "\n", "_", ".", "_", "*", "_", ">", "_")
+
+// subjectToken converts the alert name to a valid subject token
+func subjectToken(s string) string {
+	if s == "" {
+		return "_"
+	}
+	return subjectReplacer.Replace(s)
+}
+
+// Send publishes the message to the '<subject>.<alert name>' subject
+func (n *Nats) Send(mes *message.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), n.timeout)
+	defer cancel()
+
+	data, err := json.Marshal(message.NewEvent(mes))
+	if err != nil {
+		return fmt.Errorf("error marshal message, %w", err)
+	}
+
+	conn, err := n.connection()
+	if err != nil {
+		return err
+	}
+
+	msg := &natsgo.Msg{
+		Subject: n.subject + "." + subjectToken(mes.AlertName),
+		Data:    data,
+	}
+	if conn.HeadersSupported() {
+		msg.Header = natsgo.Header{}
+		msg.Header.Set("Alert-Name", mes.AlertName)
+		msg.Header.Set("Alert-Level", mes.Level)
+	}
+
+	if n.jetStream {
+		js, errJS := conn.JetStream()
+		if errJS != nil {
+			return fmt.Errorf("error get jetstream context, %w", errJS)
+		}
+		if _, err := js.PublishMsg(msg, natsgo.Context(ctx)); err != nil {
+			n.logger.Error("error publish message to jetstream", zap.String("subject", msg.Subject), zap.Error(err))
+			return fmt.Errorf("error publish message to jetstream, %w", err)
+		}
+		return nil
+	}
+
+	if err := conn.PublishMsg(msg); err != nil {
+		return fmt.Errorf("error publish message, %w", err)
+	}
+	if err := conn.FlushWithContext(ctx); err != nil {
+		n.logger.Error("error publish message to nats", zap.String("subject", msg.Subject), zap.Error(err))
+		return fmt.Errorf("error flush message, %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+package nats
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/config/channels/nats"
+	"github.com/balerter/balerter/internal/message"
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type published struct {
+	subject string
+	reply   string
+	header  string
+	data    []byte
+}
+
+// fakeServer is a minimal NATS server, the options must be changed with the lock held
+type fakeServer struct {
+	ln      net.Listener
+	headers bool
+	// pubErr is sent on publish as -ERR
+	pubErr string
+	// ack is sent to the reply subject on publish, or the 503 status if empty
+	ack string
+
+	mu        sync.Mutex
+	connects  int
+	connect   map[string]interface{}
+	published []published
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeServer{ln: ln, headers: true}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(c)
+		}
+	}()
+
+	return s
+}
+
+func (s *fakeServer) url() string {
+	return "nats://" + s.ln.Addr().String()
+}
+
+func (s *fakeServer) set(f func()) {
+	s.mu.Lock()
+	f()
+	s.mu.Unlock()
+}
+
+func (s *fakeServer) serve(c net.Conn) {
+	defer c.Close()
+
+	s.mu.Lock()
+	info, _ := json.Marshal(map[string]interface{}{
+		"server_id":   "fake",
+		"proto":       1,
+		"headers":     s.headers,
+		"max_payload": 1 << 20,
+	})
+	s.mu.Unlock()
+
+	if _, err := c.Write([]byte("INFO " + string(info) + "\r\n")); err != nil {
+		return
+	}
+
+	// sid of the last subscription, the client subscribes to the replies inbox
+	sid := ""
+
+	r := bufio.NewReader(c)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		op, args, _ := strings.Cut(line, " ")
+		fields := strings.Fields(args)
+
+		switch op {
+		case "CONNECT":
+			s.mu.Lock()
+			s.connects++
+			_ = json.Unmarshal([]byte(args), &s.connect)
+			s.mu.Unlock()
+		case "PING":
+			_, _ = c.Write([]byte("PONG\r\n"))
+		case "PONG", "UNSUB":
+		case "SUB":
+			sid = fields[len(fields)-1]
+		case "PUB", "HPUB":
+			p := published{subject: fields[0]}
+			total, _ := strconv.Atoi(fields[len(fields)-1])
+			hdrLen := 0
+			if op == "HPUB" {
+				hdrLen, _ = strconv.Atoi(fields[len(fields)-2])
+			}
+			if len(fields) == 3 && op == "PUB" || len(fields) == 4 && op == "HPUB" {
+				p.reply = fields[1]
+			}
+			buf := make([]byte, total+2)
+			if _, err := io.ReadFull(r, buf); err != nil {
+				return
+			}
+			p.header = string(buf[:hdrLen])
+			p.data = buf[hdrLen:total]
+
+			s.mu.Lock()
+			s.published = append(s.published, p)
+			pubErr, ack := s.pubErr, s.ack
+			s.mu.Unlock()
+
+			if pubErr != "" {
+				_, _ = c.Write([]byte("-ERR '" + pubErr + "'\r\n"))
+				continue
+			}
+			if p.reply != "" {
+				if ack == "" {
+					h := "NATS/1.0 503\r\n\r\n"
+					_, _ = c.Write([]byte("HMSG " + p.reply + " " + sid + " " + strconv.Itoa(len(h)) + " " + strconv.Itoa(len(h)) + "\r\n" + h + "\r\n"))
+					continue
+				}
+				_, _ = c.Write([]byte("MSG " + p.reply + " " + sid + " " + strconv.Itoa(len(ack)) + "\r\n" + ack + "\r\n"))
+			}
+		default:
+			return
+		}
+	}
+}
+
+func newTestNats(t *testing.T, s *fakeServer, cfg nats.Nats) *Nats {
+	cfg.Name = "foo"
+	cfg.URL = s.url()
+	cfg.Subject = "balerter.alerts"
+	cfg.Timeout = 1000
+	n, err := New(cfg, zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { n.Stop() })
+	return n
+}
+
+func TestNats_Send(t *testing.T) {
+	s := newFakeServer(t)
+	n := newTestNats(t, s, nats.Nats{Token: "secret"})
+
+	start := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	mes := message.New("warning", "foo.bar baz", "text", "", nil)
+	mes.Alert = &alert.Alert{Name: "foo.bar baz", Level: alert.LevelWarn, Count: 1, Start: start}
+
+	require.NoError(t, n.Send(mes))
+	require.NoError(t, n.Send(mes))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	assert.Equal(t, 1, s.connects, "the connection must be reused")
+	assert.Equal(t, "secret", s.connect["auth_token"])
+	assert.Equal(t, clientName, s.connect["name"])
+	assert.Equal(t, true, s.connect["headers"])
+
+	require.Len(t, s.published, 2)
+	p := s.published[0]
+	assert.Equal(t, "balerter.alerts.foo_bar_baz", p.subject)
+	assert.Equal(t, "", p.reply)
+	assert.Contains(t, p.header, "Alert-Level: warning\r\n")
+	assert.Contains(t, p.header, "Alert-Name: foo.bar baz\r\n")
+
+	e := &message.Event{}
+	require.NoError(t, json.Unmarshal(p.data, e))
+	assert.Equal(t, "text", e.Message.Text)
+	assert.Equal(t, "foo.bar baz", e.Alert.Name)
+	assert.Equal(t, "warning", e.Alert.Level)
+	assert.Equal(t, 1, e.Alert.Count)
+	assert.Equal(t, start, e.Alert.Start)
+}
+
+func TestNats_Send_without_headers(t *testing.T) {
+	s := newFakeServer(t)
+	s.set(func() { s.headers = false })
+	n := newTestNats(t, s, nats.Nats{})
+
+	require.NoError(t, n.Send(message.New("error", "foo", "text", "", nil)))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	require.Len(t, s.published, 1)
+	assert.Equal(t, "", s.published[0].header)
+	assert.Equal(t, "balerter.alerts.foo", s.published[0].subject)
+}
+
+func TestNats_Send_server_error(t *testing.T) {
+	s := newFakeServer(t)
+	s.set(func() { s.pubErr = "Maximum Payload Violation" })
+	n := newTestNats(t, s, nats.Nats{})
+
+	err := n.Send(message.New("error", "foo", "text", "", nil))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error flush message")
+
+	// the closed connection is opened again
+	s.set(func() { s.pubErr = "" })
+	require.NoError(t, n.Send(message.New("error", "foo", "text", "", nil)))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	assert.Equal(t, 2, s.connects)
+}
+
+func TestNats_Send_permissions_violation(t *testing.T) {
+	s := newFakeServer(t)
+	s.set(func() { s.pubErr = "Permissions Violation for Publish to balerter.alerts.foo" })
+
+	core, logs := observer.New(zap.ErrorLevel)
+	n := newTestNats(t, s, nats.Nats{})
+	n.logger = zap.New(core)
+
+	// the server reports the permissions violations asynchronously, the error is logged
+	require.NoError(t, n.Send(message.New("error", "foo", "text", "", nil)))
+	assert.Eventually(t, func() bool { return logs.FilterMessage("nats error").Len() == 1 }, time.Second, time.Millisecond*10)
+}
+
+func TestNats_Send_jetstream(t *testing.T) {
+	s := newFakeServer(t)
+	s.set(func() { s.ack = `{"stream":"ALERTS","seq":1}` })
+	n := newTestNats(t, s, nats.Nats{JetStream: true})
+
+	require.NoError(t, n.Send(message.New("error", "foo", "text", "", nil)))
+
+	s.mu.Lock()
+	require.Len(t, s.published, 1)
+	assert.True(t, strings.HasPrefix(s.published[0].reply, "_INBOX."))
+	s.mu.Unlock()
+
+	s.set(func() { s.ack = `{"error":{"code":400,"description":"bad request"}}` })
+	err := n.Send(message.New("error", "foo", "text", "", nil))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error publish message to jetstream")
+	assert.Contains(t, err.Error(), "bad request")
+
+	s.set(func() { s.ack = "" })
+	err = n.Send(message.New("error", "foo", "text", "", nil))
+	require.ErrorIs(t, err, natsgo.ErrNoStreamResponse)
+}
+
+func TestNats_Send_connect_error(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	url := "nats://" + ln.Addr().String()
+	ln.Close()
+
+	n, err := New(nats.Nats{Name: "foo", URL: url, Subject: "alerts", Timeout: 1000}, zap.NewNop())
+	require.NoError(t, err)
+
+	err = n.Send(message.New("error", "foo", "text", "", nil))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error connect to "+url)
+}
+
+func TestNats_Stop(t *testing.T) {
+	s := newFakeServer(t)
+	n := newTestNats(t, s, nats.Nats{})
+
+	require.NoError(t, n.Send(message.New("error", "foo", "text", "", nil)))
+	require.NoError(t, n.Stop())
+	assert.True(t, n.conn.IsClosed())
+}
+
+func Test_subjectToken(t *testing.T) {
+	assert.Equal(t, "_", subjectToken(""))
+	assert.Equal(t, "a_b_c_d_e", subjectToken("a.b c*d>e"))
+}
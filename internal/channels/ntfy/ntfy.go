@@ -0,0 +1,77 @@
+package ntfy
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/channels/ntfy"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultURL     = "https://ntfy.sh"
+	defaultTimeout = time.Second * 5
+	defaultTitle   = "[{LEVEL}] {ALERT_NAME}"
+	defaultBody    = "{TEXT}\n\n{FIELDS}"
+)
+
+// Ntfy represents a channel of type ntfy
+type Ntfy struct {
+	name     string
+	url      string
+	topic    string
+	token    string
+	username string
+	password string
+	title    string
+	body     string
+	ignore   bool
+	timeout  time.Duration
+
+	client *http.Client
+	logger *zap.Logger
+}
+
+// New creates new ntfy channel
+func New(cfg ntfy.Ntfy, logger *zap.Logger) (*Ntfy, error) {
+	n := &Ntfy{
+		name:     cfg.Name,
+		url:      strings.TrimRight(cfg.URL, "/"),
+		topic:    cfg.Topic,
+		token:    cfg.Token,
+		username: cfg.Username,
+		password: cfg.Password,
+		title:    cfg.Title,
+		body:     cfg.Body,
+		ignore:   cfg.Ignore,
+		timeout:  time.Millisecond * time.Duration(cfg.Timeout),
+		logger:   logger,
+	}
+
+	if n.url == "" {
+		n.url = defaultURL
+	}
+	if n.title == "" {
+		n.title = defaultTitle
+	}
+	if n.body == "" {
+		n.body = defaultBody
+	}
+	if n.timeout == 0 {
+		n.timeout = defaultTimeout
+	}
+
+	n.client = &http.Client{}
+
+	return n, nil
+}
+
+// Name returns the channel name
+func (n *Ntfy) Name() string {
+	return n.name
+}
+
+func (n *Ntfy) Ignore() bool {
+	return n.ignore
+}
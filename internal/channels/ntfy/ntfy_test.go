@@ -0,0 +1,40 @@
+package ntfy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/channels/ntfy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNew(t *testing.T) {
+	n, err := New(ntfy.Ntfy{Name: "foo", Topic: "alerts"}, zap.NewNop())
+	require.NoError(t, err)
+	assert.IsType(t, &Ntfy{}, n)
+	assert.Equal(t, defaultURL, n.url)
+	assert.Equal(t, defaultTitle, n.title)
+	assert.Equal(t, defaultBody, n.body)
+	assert.Equal(t, defaultTimeout, n.timeout)
+}
+
+func TestNew_custom(t *testing.T) {
+	n, err := New(ntfy.Ntfy{URL: "https://ntfy.example.com/", Title: "t", Body: "b", Timeout: 100}, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, "https://ntfy.example.com", n.url)
+	assert.Equal(t, "t", n.title)
+	assert.Equal(t, "b", n.body)
+	assert.Equal(t, time.Millisecond*100, n.timeout)
+}
+
+func TestName(t *testing.T) {
+	n := &Ntfy{name: "foo"}
+	assert.Equal(t, "foo", n.Name())
+}
+
+func TestNtfy_Ignore(t *testing.T) {
+	n := &Ntfy{ignore: true}
+	assert.True(t, n.Ignore())
+}
@@ -0,0 +1,128 @@
+package ntfy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+type publishMessage struct {
+	Topic    string   `json:"topic"`
+	Title    string   `json:"title"`
+	Message  string   `json:"message"`
+	Priority int      `json:"priority"`
+	Tags     []string `json:"tags,omitempty"`
+	Attach   string   `json:"attach,omitempty"`
+	Filename string   `json:"filename,omitempty"`
+}
+
+// priority returns ntfy priority (1-5) for the alert level
+func priority(level string) int {
+	switch level {
+	case "success":
+		return 3
+	case "warning":
+		return 4
+	case "error":
+		return 5
+	}
+	return 3
+}
+
+func tag(level string) string {
+	switch level {
+	case "success":
+		return "white_check_mark"
+	case "warning":
+		return "warning"
+	case "error":
+		return "rotating_light"
+	}
+	return "bell"
+}
+
+// Send the message to the ntfy topic
+func (n *Ntfy) Send(mes *message.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), n.timeout)
+	defer cancel()
+
+	title := mes.Render(n.title)
+	body := strings.TrimSpace(mes.Render(n.body))
+
+	// raw image data are uploaded as an attachment with the message in headers
+	if mes.Image != "" && !strings.HasPrefix(mes.Image, "http") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, n.url+"/"+n.topic, strings.NewReader(mes.Image))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Title", title)
+		req.Header.Set("Message", strings.ReplaceAll(body, "\n", `\n`))
+		req.Header.Set("Priority", strconv.Itoa(priority(mes.Level)))
+		req.Header.Set("Tags", tag(mes.Level))
+		req.Header.Set("Filename", "chart.png")
+		return n.do(req)
+	}
+
+	m := &publishMessage{
+		Topic:    n.topic,
+		Title:    title,
+		Message:  body,
+		Priority: priority(mes.Level),
+		Tags:     []string{tag(mes.Level)},
+		Attach:   mes.Image,
+	}
+	if m.Attach != "" {
+		m.Filename = "chart.png"
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("error marshal message, %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return n.do(req)
+}
+
+func (n *Ntfy) do(req *http.Request) error {
+	switch {
+	case n.token != "":
+		req.Header.Set("Authorization", "Bearer "+n.token)
+	case n.username != "":
+		req.SetBasicAuth(n.username, n.password)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error read response body, %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		n.logger.Error("unexpected status code from ntfy request",
+			zap.Int("status", resp.StatusCode),
+			zap.ByteString("body", respBody),
+		)
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
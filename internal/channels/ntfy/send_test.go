@@ -0,0 +1,101 @@
+package ntfy
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestNtfy(url string) *Ntfy {
+	return &Ntfy{
+		url:     url,
+		topic:   "alerts",
+		title:   defaultTitle,
+		body:    defaultBody,
+		timeout: time.Second,
+		client:  &http.Client{},
+		logger:  zap.NewNop(),
+	}
+}
+
+func TestSend(t *testing.T) {
+	var m publishMessage
+
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/", req.URL.Path)
+		assert.Equal(t, "Bearer tk_1", req.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&m))
+	}))
+	defer s.Close()
+
+	n := newTestNtfy(s.URL + "/")
+	n.token = "tk_1"
+
+	err := n.Send(&message.Message{
+		Level:     "error",
+		AlertName: "foo",
+		Text:      "bar",
+		Image:     "https://domain.com/image.png",
+		Fields:    map[string]string{"a": "1"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "alerts", m.Topic)
+	assert.Equal(t, "[error] foo", m.Title)
+	assert.Equal(t, "bar\n\na = 1", m.Message)
+	assert.Equal(t, 5, m.Priority)
+	assert.Equal(t, []string{"rotating_light"}, m.Tags)
+	assert.Equal(t, "https://domain.com/image.png", m.Attach)
+}
+
+func TestSend_raw_image(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPut, req.Method)
+		assert.Equal(t, "/alerts", req.URL.Path)
+		user, pass, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", user)
+		assert.Equal(t, "pass", pass)
+		assert.Equal(t, "[warning] foo", req.Header.Get("Title"))
+		assert.Equal(t, `bar\nbaz`, req.Header.Get("Message"))
+		assert.Equal(t, "4", req.Header.Get("Priority"))
+		assert.Equal(t, "chart.png", req.Header.Get("Filename"))
+		b, _ := io.ReadAll(req.Body)
+		assert.Equal(t, "PNGDATA", string(b))
+	}))
+	defer s.Close()
+
+	n := newTestNtfy(s.URL)
+	n.username = "user"
+	n.password = "pass"
+
+	err := n.Send(&message.Message{Level: "warning", AlertName: "foo", Text: "bar\nbaz", Image: "PNGDATA"})
+	require.NoError(t, err)
+}
+
+func TestSend_error(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusForbidden)
+	}))
+	defer s.Close()
+
+	err := newTestNtfy(s.URL).Send(&message.Message{Level: "success"})
+	require.Error(t, err)
+	assert.Equal(t, "unexpected status code 403", err.Error())
+}
+
+func Test_priority(t *testing.T) {
+	assert.Equal(t, 3, priority("success"))
+	assert.Equal(t, 4, priority("warning"))
+	assert.Equal(t, 5, priority("error"))
+	assert.Equal(t, 3, priority("foo"))
+}
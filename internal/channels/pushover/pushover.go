@@ -0,0 +1,83 @@
+package pushover
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/channels/pushover"
+	"go.uber.org/zap"
+)
+
+const (
+	apiURL         = "https://api.pushover.net/1/messages.json"
+	defaultTimeout = time.Second * 5
+	defaultTitle   = "[{LEVEL}] {ALERT_NAME}"
+	defaultBody    = "{TEXT}\n\n{FIELDS}"
+	defaultRetry   = 60
+	defaultExpire  = 3600
+)
+
+// Pushover represents a channel of type Pushover
+type Pushover struct {
+	name    string
+	token   string
+	user    string
+	device  []string
+	title   string
+	body    string
+	retry   int
+	expire  int
+	ignore  bool
+	timeout time.Duration
+
+	apiURL string
+	client *http.Client
+	logger *zap.Logger
+}
+
+// New creates new Pushover channel
+func New(cfg pushover.Pushover, logger *zap.Logger) (*Pushover, error) {
+	p := &Pushover{
+		name:    cfg.Name,
+		token:   cfg.Token,
+		user:    cfg.User,
+		device:  cfg.Device,
+		title:   cfg.Title,
+		body:    cfg.Body,
+		retry:   cfg.Retry,
+		expire:  cfg.Expire,
+		ignore:  cfg.Ignore,
+		timeout: time.Millisecond * time.Duration(cfg.Timeout),
+		apiURL:  apiURL,
+		logger:  logger,
+	}
+
+	if p.title == "" {
+		p.title = defaultTitle
+	}
+	if p.body == "" {
+		p.body = defaultBody
+	}
+	if p.retry == 0 {
+		p.retry = defaultRetry
+	}
+	if p.expire == 0 {
+		p.expire = defaultExpire
+	}
+	if p.timeout == 0 {
+		p.timeout = defaultTimeout
+	}
+
+	p.client = &http.Client{}
+
+	return p, nil
+}
+
+// Name returns the channel name
+func (p *Pushover) Name() string {
+	return p.name
+}
+
+func (p *Pushover) Ignore() bool {
+	return p.ignore
+}
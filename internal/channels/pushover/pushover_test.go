@@ -0,0 +1,32 @@
+package pushover
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/channels/pushover"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNew(t *testing.T) {
+	p, err := New(pushover.Pushover{Name: "foo", Token: "t", User: "u"}, zap.NewNop())
+	require.NoError(t, err)
+	assert.IsType(t, &Pushover{}, p)
+	assert.Equal(t, apiURL, p.apiURL)
+	assert.Equal(t, defaultTitle, p.title)
+	assert.Equal(t, defaultBody, p.body)
+	assert.Equal(t, defaultRetry, p.retry)
+	assert.Equal(t, defaultExpire, p.expire)
+	assert.Equal(t, defaultTimeout, p.timeout)
+}
+
+func TestName(t *testing.T) {
+	p := &Pushover{name: "foo"}
+	assert.Equal(t, "foo", p.Name())
+}
+
+func TestPushover_Ignore(t *testing.T) {
+	p := &Pushover{ignore: true}
+	assert.True(t, p.Ignore())
+}
@@ -0,0 +1,144 @@
+package pushover
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+const (
+	priorityNormal    = 0
+	priorityHigh      = 1
+	priorityEmergency = 2
+)
+
+// priority returns Pushover priority for the alert level
+func priority(level string) int {
+	switch level {
+	case "warning":
+		return priorityHigh
+	case "error":
+		return priorityEmergency
+	}
+	return priorityNormal
+}
+
+// Send the message to the Pushover API
+func (p *Pushover) Send(mes *message.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	prio := priority(mes.Level)
+
+	buf := bytes.NewBuffer(nil)
+	w := multipart.NewWriter(buf)
+
+	fields := [][2]string{
+		{"token", p.token},
+		{"user", p.user},
+		{"title", mes.Render(p.title)},
+		{"message", strings.TrimSpace(mes.Render(p.body))},
+		{"priority", strconv.Itoa(prio)},
+	}
+	if len(p.device) > 0 {
+		fields = append(fields, [2]string{"device", strings.Join(p.device, ",")})
+	}
+	if prio == priorityEmergency {
+		fields = append(fields,
+			[2]string{"retry", strconv.Itoa(p.retry)},
+			[2]string{"expire", strconv.Itoa(p.expire)},
+		)
+	}
+
+	for _, f := range fields {
+		if err := w.WriteField(f[0], f[1]); err != nil {
+			return err
+		}
+	}
+
+	if mes.Image != "" {
+		img, err := p.getImage(ctx, mes.Image)
+		if err != nil {
+			p.logger.Warn("error get image for pushover attachment", zap.Error(err))
+		} else {
+			f, err := w.CreateFormFile("attachment", "chart.png")
+			if err != nil {
+				return err
+			}
+			if _, err := f.Write(img); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiURL, buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error read response body, %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		p.logger.Error("unexpected status code from pushover request",
+			zap.Int("status", resp.StatusCode),
+			zap.ByteString("body", respBody),
+		)
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// getImage returns image content. The image may be an URL or raw image data
+func (p *Pushover) getImage(ctx context.Context, image string) ([]byte, error) {
+	if !strings.HasPrefix(image, "http") {
+		return []byte(image), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, image, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// pushover limits the message text
+const maxMessageLength = 1024
+
+// TextLimit returns the limit of the message text. The long text is truncated
+func (p *Pushover) TextLimit(*message.Message) message.Limit {
+	return message.Limit{MaxLength: maxMessageLength, Mode: message.LimitTruncate}
+}
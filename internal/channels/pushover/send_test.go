@@ -0,0 +1,98 @@
+package pushover
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestPushover(url string) *Pushover {
+	return &Pushover{
+		token:   "token1",
+		user:    "user1",
+		title:   defaultTitle,
+		body:    defaultBody,
+		retry:   defaultRetry,
+		expire:  defaultExpire,
+		timeout: time.Second,
+		apiURL:  url,
+		client:  &http.Client{},
+		logger:  zap.NewNop(),
+	}
+}
+
+func TestSend_emergency(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/image.png" {
+			_, _ = rw.Write([]byte("PNGDATA"))
+			return
+		}
+
+		require.NoError(t, req.ParseMultipartForm(1024))
+		assert.Equal(t, "token1", req.FormValue("token"))
+		assert.Equal(t, "user1", req.FormValue("user"))
+		assert.Equal(t, "phone,tablet", req.FormValue("device"))
+		assert.Equal(t, "[error] foo", req.FormValue("title"))
+		assert.Equal(t, "bar\n\na = 1", req.FormValue("message"))
+		assert.Equal(t, "2", req.FormValue("priority"))
+		assert.Equal(t, "60", req.FormValue("retry"))
+		assert.Equal(t, "3600", req.FormValue("expire"))
+
+		f, _, err := req.FormFile("attachment")
+		require.NoError(t, err)
+		b, _ := io.ReadAll(f)
+		assert.Equal(t, "PNGDATA", string(b))
+	}))
+	defer s.Close()
+
+	p := newTestPushover(s.URL)
+	p.device = []string{"phone", "tablet"}
+
+	err := p.Send(&message.Message{
+		Level:     "error",
+		AlertName: "foo",
+		Text:      "bar",
+		Image:     s.URL + "/image.png",
+		Fields:    map[string]string{"a": "1"},
+	})
+	require.NoError(t, err)
+}
+
+func TestSend_normal(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.NoError(t, req.ParseMultipartForm(1024))
+		assert.Equal(t, "0", req.FormValue("priority"))
+		assert.Equal(t, "", req.FormValue("retry"))
+		assert.Equal(t, "", req.FormValue("expire"))
+		_, _, err := req.FormFile("attachment")
+		assert.Error(t, err)
+	}))
+	defer s.Close()
+
+	err := newTestPushover(s.URL).Send(&message.Message{Level: "success", Text: "bar"})
+	require.NoError(t, err)
+}
+
+func TestSend_error(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+	}))
+	defer s.Close()
+
+	err := newTestPushover(s.URL).Send(&message.Message{Level: "success"})
+	require.Error(t, err)
+	assert.Equal(t, "unexpected status code 400", err.Error())
+}
+
+func Test_priority(t *testing.T) {
+	assert.Equal(t, 0, priority("success"))
+	assert.Equal(t, 1, priority("warning"))
+	assert.Equal(t, 2, priority("error"))
+}
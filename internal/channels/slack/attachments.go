@@ -0,0 +1,37 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+	"github.com/slack-go/slack"
+)
+
+const uploadTimeout = time.Second * 30
+
+// SupportsAttachments returns true, the attachments are uploaded to the message thread
+func (m *Slack) SupportsAttachments() bool {
+	return true
+}
+
+// uploadAttachments uploads the message attachments to the thread of the message with the timestamp
+func (m *Slack) uploadAttachments(channel, ts string, mes *message.Message) error {
+	for _, a := range mes.Attachments {
+		ctx, cancel := context.WithTimeout(context.Background(), uploadTimeout)
+		_, err := m.api.UploadFileContext(ctx, slack.FileUploadParameters{
+			Reader:          bytes.NewReader(a.Data),
+			Filename:        a.Name,
+			Title:           a.Name,
+			Channels:        []string{channel},
+			ThreadTimestamp: ts,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("error upload attachment %s, %w", a.Name, err)
+		}
+	}
+	return nil
+}
@@ -2,11 +2,21 @@ package slack
 
 import (
 	"fmt"
+	"strconv"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
 
 	"github.com/slack-go/slack"
 )
 
-func createSlackMessageOptions(alertText, imageURL string, fields map[string]string, level string) []slack.MsgOption {
+// slack limits the count of the elements of the actions block and the button text length
+const (
+	maxActions          = 25
+	maxButtonTextLength = 75
+)
+
+func createSlackMessageOptions(alertText, imageURL string, fields map[string]string, links []alert.Link, level string) []slack.MsgOption {
 	opts := make([]slack.MsgOption, 0)
 	blocks := make([]slack.Block, 0)
 
@@ -47,6 +57,10 @@ func createSlackMessageOptions(alertText, imageURL string, fields map[string]str
 		blocks = append(blocks, slack.NewSectionBlock(nil, fieldBlock, nil))
 	}
 
+	if len(links) > 0 {
+		blocks = append(blocks, linksBlock(links))
+	}
+
 	attachment := slack.Attachment{
 		Color:    getColorByLevel(level),
 		Fallback: alertText,
@@ -58,6 +72,23 @@ func createSlackMessageOptions(alertText, imageURL string, fields map[string]str
 	return opts
 }
 
+// linksBlock returns the actions block with the URL buttons of the links
+func linksBlock(links []alert.Link) *slack.ActionBlock {
+	if len(links) > maxActions {
+		links = links[:maxActions]
+	}
+
+	elements := make([]slack.BlockElement, 0, len(links))
+	for idx, l := range links {
+		text := slack.NewTextBlockObject("plain_text", message.Truncate(l.Label, maxButtonTextLength, "…"), false, false)
+		button := slack.NewButtonBlockElement("link_"+strconv.Itoa(idx), "", text)
+		button.URL = l.URL
+		elements = append(elements, button)
+	}
+
+	return slack.NewActionBlock("", elements...)
+}
+
 func getColorByLevel(l string) string {
 	switch l {
 	case "success":
@@ -7,7 +7,11 @@ import (
 
 // Send message to the channel Slack
 func (m *Slack) Send(mes *message.Message) error {
-	opts := createSlackMessageOptions(mes.Text, mes.Image, mes.Fields, mes.Level)
+	if m.threads {
+		return m.sendThreaded(mes)
+	}
+
+	opts := createSlackMessageOptions(mes.Text, mes.Image, mes.Fields, mes.Links, mes.Level)
 
 	_channel, _timestamp, _text, err := m.api.SendMessage(m.channel, opts...)
 
@@ -18,5 +22,28 @@ func (m *Slack) Send(mes *message.Message) error {
 		zap.Error(err),
 	)
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	return m.uploadAttachments(_channel, _timestamp, mes)
+}
+
+// SupportsLinks returns true, the links are the URL buttons
+func (m *Slack) SupportsLinks() bool {
+	return true
+}
+
+// slack limits the text of the section block
+const maxSectionTextLength = 3000
+
+var splitLimit = message.Limit{MaxLength: maxSectionTextLength, Mode: message.LimitSplit}
+
+// TextLimit returns the limit of the message text. The long text is split into several messages.
+// In the threads mode the channel splits the text itself to send all the parts to the incident thread
+func (m *Slack) TextLimit(*message.Message) message.Limit {
+	if m.threads {
+		return message.Limit{}
+	}
+	return splitLimit
 }
@@ -1,11 +1,17 @@
 package slack
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/balerter/balerter/internal/alert"
 	"github.com/balerter/balerter/internal/message"
 	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"strings"
 	"testing"
 )
 
@@ -23,6 +29,31 @@ func (m *mockAPI) SendMessage(channel string, options ...slack.MsgOption) (ch, t
 	return
 }
 
+func (m *mockAPI) UpdateMessage(channel, ts string, options ...slack.MsgOption) (ch, timestamp, text string, err error) {
+	args := m.Called(channel, ts, options)
+	ch = args.String(0)
+	timestamp = args.String(1)
+	text = args.String(2)
+	err = args.Error(3)
+	return
+}
+
+func (m *mockAPI) AuthTestContext(ctx context.Context) (*slack.AuthTestResponse, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*slack.AuthTestResponse), args.Error(1)
+}
+
+func (m *mockAPI) UploadFileContext(ctx context.Context, params slack.FileUploadParameters) (*slack.File, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*slack.File), args.Error(1)
+}
+
 func TestSend(t *testing.T) {
 	api := &mockAPI{}
 	api.On("SendMessage", mock.Anything, mock.Anything).Return("1", "2", "3", nil)
@@ -43,3 +74,62 @@ func TestSend(t *testing.T) {
 	err := m.Send(mes)
 	require.NoError(t, err)
 }
+
+func TestSend_attachments(t *testing.T) {
+	api := &mockAPI{}
+	api.On("SendMessage", mock.Anything, mock.Anything).Return("C1", "123.456", "", nil)
+	api.On("UploadFileContext", mock.Anything, mock.Anything).Return(&slack.File{}, nil)
+
+	m := &Slack{
+		api:    api,
+		logger: zap.NewNop(),
+	}
+
+	err := m.Send(&message.Message{
+		Level:       "error",
+		Text:        "alert text",
+		Attachments: []alert.Attachment{{Name: "rows.csv", Data: []byte("a,b")}},
+	})
+	require.NoError(t, err)
+
+	api.AssertNumberOfCalls(t, "UploadFileContext", 1)
+	params := api.Calls[1].Arguments.Get(1).(slack.FileUploadParameters)
+	assert.Equal(t, "rows.csv", params.Filename)
+	assert.Equal(t, []string{"C1"}, params.Channels)
+	assert.Equal(t, "123.456", params.ThreadTimestamp)
+
+	api = &mockAPI{}
+	api.On("SendMessage", mock.Anything, mock.Anything).Return("C1", "123.456", "", nil)
+	api.On("UploadFileContext", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("err1"))
+	m.api = api
+
+	err = m.Send(&message.Message{
+		Level:       "error",
+		Text:        "alert text",
+		Attachments: []alert.Attachment{{Name: "rows.csv", Data: []byte("a,b")}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "error upload attachment rows.csv, err1", err.Error())
+}
+
+func Test_linksBlock(t *testing.T) {
+	block := linksBlock([]alert.Link{
+		{Label: "Runbook", URL: "https://wiki.example.com/rb"},
+		{Label: "Dashboard", URL: "https://grafana.example.com/d/1"},
+	})
+
+	require.Equal(t, 2, len(block.Elements.ElementSet))
+	button, ok := block.Elements.ElementSet[0].(*slack.ButtonBlockElement)
+	require.True(t, ok)
+	assert.Equal(t, "Runbook", button.Text.Text)
+	assert.Equal(t, "https://wiki.example.com/rb", button.URL)
+	assert.Equal(t, "link_0", button.ActionID)
+
+	links := make([]alert.Link, 30)
+	for idx := range links {
+		links[idx] = alert.Link{Label: strings.Repeat("a", 100), URL: "https://example.com"}
+	}
+	block = linksBlock(links)
+	require.Equal(t, 25, len(block.Elements.ElementSet))
+	assert.Equal(t, 75, len([]rune(block.Elements.ElementSet[0].(*slack.ButtonBlockElement).Text.Text)))
+}
@@ -1,7 +1,11 @@
 package slack
 
 import (
+	"context"
+	"fmt"
+
 	slackCfg "github.com/balerter/balerter/internal/config/channels/slack"
+	"github.com/balerter/balerter/internal/corestorage"
 	"github.com/slack-go/slack"
 	"go.uber.org/zap"
 )
@@ -9,6 +13,9 @@ import (
 // API is an interface of Slack API
 type API interface {
 	SendMessage(channel string, options ...slack.MsgOption) (string, string, string, error)
+	UpdateMessage(channelID, timestamp string, options ...slack.MsgOption) (string, string, string, error)
+	AuthTestContext(ctx context.Context) (*slack.AuthTestResponse, error)
+	UploadFileContext(ctx context.Context, params slack.FileUploadParameters) (*slack.File, error)
 }
 
 // Slack represents a channel of type Slack
@@ -18,15 +25,19 @@ type Slack struct {
 	channel string
 	api     API
 	ignore  bool
+	threads bool
+	kv      corestorage.KV
 }
 
 // New creates new Slack channel
-func New(cfg slackCfg.Slack, logger *zap.Logger) (*Slack, error) {
+func New(cfg slackCfg.Slack, kv corestorage.KV, logger *zap.Logger) (*Slack, error) {
 	m := &Slack{
 		logger:  logger,
 		name:    cfg.Name,
 		channel: cfg.Channel,
 		ignore:  cfg.Ignore,
+		threads: cfg.Threads,
+		kv:      kv,
 	}
 
 	m.api = slack.New(cfg.Token)
@@ -42,3 +53,11 @@ func (m *Slack) Name() string {
 func (m *Slack) Ignore() bool {
 	return m.ignore
 }
+
+// Check validates the API token
+func (m *Slack) Check(ctx context.Context) error {
+	if _, err := m.api.AuthTestContext(ctx); err != nil {
+		return fmt.Errorf("error auth test, %w", err)
+	}
+	return nil
+}
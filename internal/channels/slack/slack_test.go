@@ -1,17 +1,23 @@
 package slack
 
 import (
+	"context"
+	"fmt"
+
 	slackCfg "github.com/balerter/balerter/internal/config/channels/slack"
+	"github.com/slack-go/slack"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"testing"
 )
 
 func TestNew(t *testing.T) {
-	s, err := New(slackCfg.Slack{}, zap.NewNop())
+	s, err := New(slackCfg.Slack{Threads: true}, nil, zap.NewNop())
 	require.NoError(t, err)
 	assert.IsType(t, &Slack{}, s)
+	assert.True(t, s.threads)
 }
 
 func TestName(t *testing.T) {
@@ -23,3 +29,21 @@ func TestSlack_Ignore(t *testing.T) {
 	s := &Slack{ignore: true}
 	assert.True(t, s.Ignore())
 }
+
+func TestSlack_Check(t *testing.T) {
+	api := &mockAPI{}
+	api.On("AuthTestContext", mock.Anything).Return(&slack.AuthTestResponse{}, nil)
+
+	s := &Slack{api: api}
+	require.NoError(t, s.Check(context.Background()))
+}
+
+func TestSlack_Check_Error(t *testing.T) {
+	api := &mockAPI{}
+	api.On("AuthTestContext", mock.Anything).Return(nil, fmt.Errorf("invalid_auth"))
+
+	s := &Slack{api: api}
+	err := s.Check(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, "error auth test, invalid_auth", err.Error())
+}
@@ -0,0 +1,165 @@
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/corestorage"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// incident is the first message of the alert incident, stored in the core KV storage
+type incident struct {
+	Channel string            `json:"channel"`
+	TS      string            `json:"ts"`
+	Start   time.Time         `json:"start"`
+	Level   string            `json:"level"`
+	Text    string            `json:"text"`
+	Image   string            `json:"image,omitempty"`
+	Fields  map[string]string `json:"fields,omitempty"`
+	Links   []alert.Link      `json:"links,omitempty"`
+}
+
+func (m *Slack) incidentKey(alertName string) string {
+	return "slack:" + m.name + ":" + alertName
+}
+
+// loadIncident returns the incident of the alert or nil
+func (m *Slack) loadIncident(alertName string) (*incident, error) {
+	v, ok, err := corestorage.Lookup(m.kv, m.incidentKey(alertName))
+	if err != nil {
+		return nil, fmt.Errorf("error get kv value, %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	inc := &incident{}
+	if err := json.Unmarshal([]byte(v), inc); err != nil {
+		return nil, fmt.Errorf("error decode incident, %w", err)
+	}
+
+	return inc, nil
+}
+
+func (m *Slack) saveIncident(alertName string, inc *incident) error {
+	data, err := json.Marshal(inc)
+	if err != nil {
+		return fmt.Errorf("error encode incident, %w", err)
+	}
+	if err := m.kv.Upsert(m.incidentKey(alertName), string(data)); err != nil {
+		return fmt.Errorf("error save incident, %w", err)
+	}
+	return nil
+}
+
+// sendThreaded posts the first message of the incident, replies to it on updates
+// and marks it as resolved on success. The parts of the long text are replies to the same thread,
+// the incident is resolved after the last one
+func (m *Slack) sendThreaded(mes *message.Message) error {
+	inc, err := m.loadIncident(mes.AlertName)
+	if err != nil {
+		return err
+	}
+
+	parts := message.ApplyLimit(mes, splitLimit, message.FirstLink(mes))
+
+	if inc == nil {
+		first := parts[0]
+		opts := createSlackMessageOptions(first.Text, first.Image, first.Fields, first.Links, first.Level)
+		channel, ts, _, err := m.api.SendMessage(m.channel, opts...)
+		if err != nil {
+			return err
+		}
+		if err := m.uploadAttachments(channel, ts, first); err != nil {
+			return err
+		}
+		if err := m.reply(channel, ts, parts[1:]); err != nil {
+			return err
+		}
+		if mes.Level == "success" {
+			return nil
+		}
+		return m.saveIncident(mes.AlertName, &incident{
+			Channel: channel,
+			TS:      ts,
+			Start:   time.Now(),
+			Level:   mes.Level,
+			Text:    first.Text,
+			Image:   first.Image,
+			Fields:  first.Fields,
+			Links:   first.Links,
+		})
+	}
+
+	if err := m.reply(inc.Channel, inc.TS, parts); err != nil {
+		return err
+	}
+
+	if mes.Level == "success" {
+		text := fmt.Sprintf("%s\n\n*Resolved after %s*", inc.Text, formatDuration(time.Since(inc.Start)))
+		if err := m.updateIncident(inc, text, mes.Level); err != nil {
+			return err
+		}
+		if err := m.kv.Delete(m.incidentKey(mes.AlertName)); err != nil {
+			return fmt.Errorf("error delete incident, %w", err)
+		}
+		return nil
+	}
+
+	if mes.Level != inc.Level {
+		if err := m.updateIncident(inc, inc.Text, mes.Level); err != nil {
+			return err
+		}
+		inc.Level = mes.Level
+		return m.saveIncident(mes.AlertName, inc)
+	}
+
+	return nil
+}
+
+// reply posts the messages to the thread
+func (m *Slack) reply(channel, ts string, parts []*message.Message) error {
+	for _, part := range parts {
+		opts := createSlackMessageOptions(part.Text, part.Image, part.Fields, part.Links, part.Level)
+		if _, _, _, err := m.api.SendMessage(channel, append(opts, slack.MsgOptionTS(ts))...); err != nil {
+			return err
+		}
+		if err := m.uploadAttachments(channel, ts, part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateIncident updates the first message of the incident with the text and the level color
+func (m *Slack) updateIncident(inc *incident, text, level string) error {
+	opts := createSlackMessageOptions(text, inc.Image, inc.Fields, inc.Links, level)
+	_, _, _, err := m.api.UpdateMessage(inc.Channel, inc.TS, opts...)
+	if err != nil {
+		m.logger.Error("error update slack message", zap.String("ts", inc.TS), zap.Error(err))
+		return fmt.Errorf("error update message, %w", err)
+	}
+	return nil
+}
+
+// formatDuration formats the duration as 1h5m, 42m or 30s
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+
+	d = d.Round(time.Minute)
+	h, min := int(d.Hours()), int(d.Minutes())%60
+	if h == 0 {
+		return fmt.Sprintf("%dm", min)
+	}
+	if min == 0 {
+		return fmt.Sprintf("%dh", h)
+	}
+	return fmt.Sprintf("%dh%dm", h, min)
+}
@@ -0,0 +1,182 @@
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/corestorage"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newKV returns KV mock backed by the map
+func newKV(m map[string]string) *corestorage.KVMock {
+	return &corestorage.KVMock{
+		GetFunc: func(k string) (string, error) {
+			v, ok := m[k]
+			if !ok {
+				return "", corestorage.ErrKVNotFound
+			}
+			return v, nil
+		},
+		UpsertFunc: func(k, v string) error {
+			m[k] = v
+			return nil
+		},
+		DeleteFunc: func(k string) error {
+			delete(m, k)
+			return nil
+		},
+	}
+}
+
+// msgValues returns request values of the message options
+func msgValues(t *testing.T, options interface{}) url.Values {
+	_, values, err := slack.UnsafeApplyMsgOptions("token", "channel", "", options.([]slack.MsgOption)...)
+	require.NoError(t, err)
+	return values
+}
+
+func attachmentColor(t *testing.T, values url.Values) string {
+	var attachments []slack.Attachment
+	require.NoError(t, json.Unmarshal([]byte(values.Get("attachments")), &attachments))
+	require.Len(t, attachments, 1)
+	return attachments[0].Color
+}
+
+func newThreadedSlack(api API, store map[string]string) *Slack {
+	return &Slack{
+		name:    "sl",
+		channel: "#alerts",
+		api:     api,
+		threads: true,
+		kv:      newKV(store),
+		logger:  zap.NewNop(),
+	}
+}
+
+func TestSlack_sendThreaded(t *testing.T) {
+	api := &mockAPI{}
+	store := map[string]string{}
+	s := newThreadedSlack(api, store)
+
+	// first message
+	api.On("SendMessage", "#alerts", mock.Anything).Return("C1", "100.1", "", nil).Once()
+	require.NoError(t, s.Send(message.New("warning", "foo", "disk 80%", "", nil)))
+
+	inc, err := s.loadIncident("foo")
+	require.NoError(t, err)
+	require.NotNil(t, inc)
+	assert.Equal(t, "C1", inc.Channel)
+	assert.Equal(t, "100.1", inc.TS)
+	assert.Equal(t, "warning", inc.Level)
+	assert.Empty(t, msgValues(t, api.Calls[0].Arguments.Get(1)).Get("thread_ts"))
+
+	// repeat is a reply
+	api.On("SendMessage", "C1", mock.Anything).Return("C1", "100.2", "", nil).Once()
+	require.NoError(t, s.Send(message.New("warning", "foo", "disk 85%", "", nil)))
+	assert.Equal(t, "100.1", msgValues(t, api.Calls[1].Arguments.Get(1)).Get("thread_ts"))
+	require.Len(t, api.Calls, 2)
+
+	// escalation is a reply and updates the color of the first message
+	api.On("SendMessage", "C1", mock.Anything).Return("C1", "100.3", "", nil).Once()
+	api.On("UpdateMessage", "C1", "100.1", mock.Anything).Return("C1", "100.1", "", nil).Once()
+	require.NoError(t, s.Send(message.New("error", "foo", "disk 95%", "", nil)))
+	require.Len(t, api.Calls, 4)
+	assert.Equal(t, "#ff0000", attachmentColor(t, msgValues(t, api.Calls[3].Arguments.Get(2))))
+	inc, err = s.loadIncident("foo")
+	require.NoError(t, err)
+	assert.Equal(t, "error", inc.Level)
+
+	// resolve
+	inc.Start = time.Now().Add(-42 * time.Minute)
+	require.NoError(t, s.saveIncident("foo", inc))
+
+	api.On("SendMessage", "C1", mock.Anything).Return("C1", "100.4", "", nil).Once()
+	api.On("UpdateMessage", "C1", "100.1", mock.Anything).Return("C1", "100.1", "", nil).Once()
+	require.NoError(t, s.Send(message.New("success", "foo", "disk 50%", "", nil)))
+	require.Len(t, api.Calls, 6)
+	assert.Equal(t, "100.1", msgValues(t, api.Calls[4].Arguments.Get(1)).Get("thread_ts"))
+
+	update := msgValues(t, api.Calls[5].Arguments.Get(2))
+	assert.Equal(t, "#00aa00", attachmentColor(t, update))
+	assert.Contains(t, update.Get("attachments"), "disk 80%\\n\\n*Resolved after 42m*")
+	assert.Empty(t, store)
+}
+
+func TestSlack_sendThreaded_success_without_incident(t *testing.T) {
+	api := &mockAPI{}
+	store := map[string]string{}
+	s := newThreadedSlack(api, store)
+
+	api.On("SendMessage", "#alerts", mock.Anything).Return("C1", "100.1", "", nil).Once()
+	require.NoError(t, s.Send(message.New("success", "foo", "ok", "", nil)))
+	assert.Empty(t, store)
+}
+
+func TestSlack_sendThreaded_split(t *testing.T) {
+	api := &mockAPI{}
+	store := map[string]string{}
+	s := newThreadedSlack(api, store)
+
+	assert.Equal(t, message.Limit{}, s.TextLimit(&message.Message{}))
+
+	long := strings.Repeat("line\n", maxSectionTextLength/5) + "the end"
+
+	// the first part starts the thread, the next one is a reply
+	api.On("SendMessage", "#alerts", mock.Anything).Return("C1", "100.1", "", nil).Once()
+	api.On("SendMessage", "C1", mock.Anything).Return("C1", "100.2", "", nil).Once()
+	require.NoError(t, s.Send(message.New("error", "foo", long, "", nil)))
+	require.Len(t, api.Calls, 2)
+	assert.Equal(t, "100.1", msgValues(t, api.Calls[1].Arguments.Get(1)).Get("thread_ts"))
+	inc, err := s.loadIncident("foo")
+	require.NoError(t, err)
+	require.NotNil(t, inc)
+	assert.Equal(t, "100.1", inc.TS)
+
+	// the incident is resolved after all the parts are in the thread
+	api.On("SendMessage", "C1", mock.Anything).Return("C1", "100.3", "", nil).Twice()
+	api.On("UpdateMessage", "C1", "100.1", mock.Anything).Return("C1", "100.1", "", nil).Once()
+	require.NoError(t, s.Send(message.New("success", "foo", long, "", nil)))
+	require.Len(t, api.Calls, 5)
+	assert.Equal(t, "100.1", msgValues(t, api.Calls[2].Arguments.Get(1)).Get("thread_ts"))
+	assert.Equal(t, "100.1", msgValues(t, api.Calls[3].Arguments.Get(1)).Get("thread_ts"))
+	assert.Equal(t, "UpdateMessage", api.Calls[4].Method)
+	assert.Empty(t, store)
+}
+
+func TestSlack_sendThreaded_errors(t *testing.T) {
+	api := &mockAPI{}
+	store := map[string]string{}
+	s := newThreadedSlack(api, store)
+
+	api.On("SendMessage", "#alerts", mock.Anything).Return("", "", "", fmt.Errorf("err1")).Once()
+	err := s.Send(message.New("error", "foo", "bar", "", nil))
+	require.Error(t, err)
+	assert.Equal(t, "err1", err.Error())
+	assert.Empty(t, store)
+
+	s.kv = &corestorage.KVMock{
+		GetFunc: func(string) (string, error) {
+			return "", fmt.Errorf("err2")
+		},
+	}
+	err = s.Send(message.New("error", "foo", "bar", "", nil))
+	require.Error(t, err)
+	assert.Equal(t, "error get kv value, err2", err.Error())
+}
+
+func Test_formatDuration(t *testing.T) {
+	assert.Equal(t, "30s", formatDuration(30*time.Second))
+	assert.Equal(t, "42m", formatDuration(42*time.Minute+10*time.Second))
+	assert.Equal(t, "2h", formatDuration(2*time.Hour))
+	assert.Equal(t, "1h5m", formatDuration(65*time.Minute))
+}
@@ -0,0 +1,89 @@
+package syslog
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// conn is a writer to the remote syslog server. Each Write call sends one syslog message.
+// The connection is established lazily and re-established once on a write error.
+// For stream transports (tcp, tls) the messages are framed with octet-counting (RFC 6587, RFC 5425)
+type conn struct {
+	network   string
+	address   string
+	tlsConfig *tls.Config
+	timeout   time.Duration
+
+	mu sync.Mutex
+	c  net.Conn
+}
+
+func (c *conn) dial() (net.Conn, error) {
+	d := &net.Dialer{Timeout: c.timeout}
+	if c.network == "tls" {
+		return tls.DialWithDialer(d, "tcp", c.address, c.tlsConfig)
+	}
+	return d.Dial(c.network, c.address)
+}
+
+func (c *conn) frame(msg []byte) []byte {
+	if c.network == "udp" {
+		return msg
+	}
+	data := make([]byte, 0, len(msg)+8) //nolint:gomnd // space for the length prefix
+	data = strconv.AppendInt(data, int64(len(msg)), 10)
+	data = append(data, ' ')
+	return append(data, msg...)
+}
+
+// Write sends the message to the server
+func (c *conn) Write(msg []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data := c.frame(msg)
+
+	err := c.write(data)
+	if err == nil {
+		return len(msg), nil
+	}
+
+	c.close()
+
+	if err = c.write(data); err != nil {
+		c.close()
+		return 0, err
+	}
+
+	return len(msg), nil
+}
+
+func (c *conn) write(data []byte) error {
+	if c.c == nil {
+		nc, err := c.dial()
+		if err != nil {
+			return fmt.Errorf("error connect to %s, %w", c.address, err)
+		}
+		c.c = nc
+	}
+
+	if c.timeout > 0 {
+		if err := c.c.SetWriteDeadline(time.Now().Add(c.timeout)); err != nil {
+			return err
+		}
+	}
+
+	_, err := c.c.Write(data)
+	return err
+}
+
+func (c *conn) close() {
+	if c.c != nil {
+		c.c.Close()
+		c.c = nil
+	}
+}
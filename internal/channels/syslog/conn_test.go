@@ -0,0 +1,74 @@
+package syslog
+
+import (
+	"bufio"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConn_frame(t *testing.T) {
+	c := &conn{network: "tcp"}
+	assert.Equal(t, "3 foo", string(c.frame([]byte("foo"))))
+
+	c = &conn{network: "udp"}
+	assert.Equal(t, "foo", string(c.frame([]byte("foo"))))
+}
+
+func TestConn_Write_Reconnect(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	received := make(chan string, 10)
+	go func() {
+		for {
+			nc, errAccept := ln.Accept()
+			if errAccept != nil {
+				return
+			}
+			go func(nc net.Conn) {
+				defer nc.Close()
+				r := bufio.NewReader(nc)
+				for {
+					s, errRead := r.ReadString('\n')
+					if errRead != nil {
+						return
+					}
+					received <- s
+				}
+			}(nc)
+		}
+	}()
+
+	c := &conn{network: "tcp", address: ln.Addr().String(), timeout: time.Second}
+
+	n, err := c.Write([]byte("foo\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, "4 foo\n", <-received)
+
+	// break the connection, the next write must reconnect
+	c.c.Close()
+
+	_, err = c.Write([]byte("bar\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "4 bar\n", <-received)
+}
+
+func TestConn_Write_ErrConnect(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+
+	c := &conn{network: "tcp", address: addr, timeout: time.Second}
+
+	_, err = c.Write([]byte("foo"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error connect to "+addr)
+	assert.Nil(t, c.c)
+}
@@ -0,0 +1,147 @@
+package syslog
+
+import (
+	"log/syslog"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+)
+
+const (
+	nilValue       = "-"
+	maxSDNameLen   = 32
+	maxHostnameLen = 255
+)
+
+var levelSeverities = map[string]syslog.Priority{
+	"success": syslog.LOG_NOTICE,
+	"warning": syslog.LOG_WARNING,
+	"error":   syslog.LOG_ERR,
+}
+
+// rfc5424 formats messages according to RFC 5424
+type rfc5424 struct {
+	facility syslog.Priority
+	hostname string
+	appName  string
+	procID   string
+	msgID    string
+	// enterpriseNumber is used in the SD-IDs of the structured data, zero means no structured data
+	enterpriseNumber int
+}
+
+func newRFC5424(facility syslog.Priority, appName, msgID string, enterpriseNumber int) *rfc5424 {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = nilValue
+	}
+	if len(hostname) > maxHostnameLen {
+		hostname = hostname[:maxHostnameLen]
+	}
+
+	return &rfc5424{
+		facility:         facility,
+		hostname:         hostname,
+		appName:          appName,
+		procID:           strconv.Itoa(os.Getpid()),
+		msgID:            msgID,
+		enterpriseNumber: enterpriseNumber,
+	}
+}
+
+func severity(level string) syslog.Priority {
+	s, ok := levelSeverities[level]
+	if !ok {
+		return syslog.LOG_INFO
+	}
+	return s
+}
+
+// format returns the message: <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+func (f *rfc5424) format(mes *message.Message, ts time.Time) []byte {
+	var b strings.Builder
+
+	b.WriteString("<")
+	b.WriteString(strconv.Itoa(int(f.facility | severity(mes.Level))))
+	b.WriteString(">1 ")
+	b.WriteString(ts.Format("2006-01-02T15:04:05.000000Z07:00"))
+	b.WriteString(" ")
+	b.WriteString(f.hostname)
+	b.WriteString(" ")
+	b.WriteString(f.appName)
+	b.WriteString(" ")
+	b.WriteString(f.procID)
+	b.WriteString(" ")
+	b.WriteString(f.msgID)
+	b.WriteString(" ")
+
+	f.writeStructuredData(&b, mes)
+
+	if mes.Text != "" {
+		b.WriteString(" ")
+		b.WriteString(mes.Text)
+	}
+
+	return []byte(b.String())
+}
+
+// writeStructuredData writes the alert and the fields SD-ELEMENTs. The custom SD-IDs use
+// the enterprise number, it is required by the config validation
+func (f *rfc5424) writeStructuredData(b *strings.Builder, mes *message.Message) {
+	en := strconv.Itoa(f.enterpriseNumber)
+
+	b.WriteString("[alert@" + en)
+	writeParam(b, "name", mes.AlertName)
+	writeParam(b, "level", mes.Level)
+	if mes.Script != "" {
+		writeParam(b, "script", mes.Script)
+	}
+	b.WriteString("]")
+
+	if len(mes.Fields) > 0 {
+		keys := make([]string, 0, len(mes.Fields))
+		for k := range mes.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteString("[fields@" + en)
+		for _, k := range keys {
+			writeParam(b, sdName(k), mes.Fields[k])
+		}
+		b.WriteString("]")
+	}
+}
+
+func writeParam(b *strings.Builder, name, value string) {
+	b.WriteString(" ")
+	b.WriteString(name)
+	b.WriteString(`="`)
+	b.WriteString(sdValueReplacer.Replace(value))
+	b.WriteString(`"`)
+}
+
+var sdValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// sdName returns a valid SD-NAME: up to 32 printable ascii characters except '=', ']', '"' and space
+func sdName(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if b.Len() == maxSDNameLen {
+			break
+		}
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+			b.WriteByte('_')
+			continue
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package syslog
+
+import (
+	"log/syslog"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_severity(t *testing.T) {
+	assert.Equal(t, syslog.LOG_NOTICE, severity("success"))
+	assert.Equal(t, syslog.LOG_WARNING, severity("warning"))
+	assert.Equal(t, syslog.LOG_ERR, severity("error"))
+	assert.Equal(t, syslog.LOG_INFO, severity("foo"))
+}
+
+func Test_sdName(t *testing.T) {
+	assert.Equal(t, "foo", sdName("foo"))
+	assert.Equal(t, "a_b_c_d_", sdName(`a b=c]d"`))
+	assert.Equal(t, "_", sdName(""))
+	assert.Equal(t, "01234567890123456789012345678901", sdName("0123456789012345678901234567890123456789"))
+}
+
+func TestRFC5424_format(t *testing.T) {
+	f := &rfc5424{
+		facility:         syslog.LOG_LOCAL0,
+		hostname:         "host",
+		appName:          "balerter",
+		procID:           "42",
+		msgID:            "alert",
+		enterpriseNumber: 55555,
+	}
+
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 6000, time.UTC)
+
+	mes := &message.Message{
+		Level:     "error",
+		AlertName: "foo",
+		Text:      "bar baz",
+		Script:    "script1",
+		Fields:    map[string]string{"b": `va"l\ue]`, "a key": "1"},
+	}
+
+	assert.Equal(t, `<131>1 2021-01-02T03:04:05.000006Z host balerter 42 alert `+
+		`[alert@55555 name="foo" level="error" script="script1"][fields@55555 a_key="1" b="va\"l\\ue\]"] bar baz`,
+		string(f.format(mes, ts)))
+
+	mes = &message.Message{Level: "success", AlertName: "foo"}
+
+	assert.Equal(t, `<133>1 2021-01-02T03:04:05.000006Z host balerter 42 alert [alert@55555 name="foo" level="success"]`,
+		string(f.format(mes, ts)))
+}
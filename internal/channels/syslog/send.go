@@ -3,15 +3,23 @@ package syslog
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/balerter/balerter/internal/message"
 )
 
 // Send the message to the channel
 func (sl *Syslog) Send(mes *message.Message) error {
-	data, err := json.Marshal(mes)
-	if err != nil {
-		return fmt.Errorf("error marshaling message, %w", err)
+	var data []byte
+
+	if sl.rfc5424 != nil {
+		data = sl.rfc5424.format(mes, time.Now())
+	} else {
+		var err error
+		data, err = json.Marshal(mes)
+		if err != nil {
+			return fmt.Errorf("error marshaling message, %w", err)
+		}
 	}
 
 	n, err := sl.w.Write(data)
@@ -29,3 +29,24 @@ func TestSend(t *testing.T) {
 
 	assert.Equal(t, `{"level":"foo","alert_name":"bar","text":"baz","image":"img"}`, buf.String())
 }
+
+func TestSend_RFC5424(t *testing.T) {
+	buf := bytes.NewBuffer([]byte{})
+
+	s := &Syslog{
+		w: buf,
+		rfc5424: &rfc5424{
+			facility:         facilities["USER"],
+			hostname:         "host",
+			appName:          "app",
+			procID:           "1",
+			msgID:            "id",
+			enterpriseNumber: 55555,
+		},
+	}
+
+	err := s.Send(&message.Message{Level: "warning", AlertName: "bar", Text: "baz"})
+	require.NoError(t, err)
+
+	assert.Regexp(t, `^<12>1 \S+ host app 1 id \[alert@55555 name="bar" level="warning"\] baz$`, buf.String())
+}
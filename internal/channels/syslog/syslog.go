@@ -1,12 +1,15 @@
 package syslog
 
 import (
+	"crypto/tls"
 	"fmt"
 	"io"
 	"log/syslog"
 	"strings"
+	"time"
 
 	syslogCfg "github.com/balerter/balerter/internal/config/channels/syslog"
+	"github.com/balerter/balerter/internal/util"
 
 	"go.uber.org/zap"
 )
@@ -17,10 +20,17 @@ type Syslog struct {
 	logger *zap.Logger
 	w      io.Writer
 	ignore bool
+
+	// rfc5424 is not nil for the rfc5424 format
+	rfc5424 *rfc5424
 }
 
 var (
 	defaultPriority = "EMERG"
+	defaultAppName  = "balerter"
+	defaultMsgID    = "alert"
+	defaultFacility = "USER"
+	defaultTimeout  = time.Second * 5
 )
 
 // New creates new Syslog channel
@@ -31,6 +41,10 @@ func New(cfg syslogCfg.Syslog, logger *zap.Logger) (*Syslog, error) {
 		ignore: cfg.Ignore,
 	}
 
+	if cfg.Format == syslogCfg.FormatRFC5424 {
+		return newRFC5424Syslog(sl, cfg)
+	}
+
 	var err error
 
 	if cfg.Priority == "" {
@@ -50,6 +64,48 @@ func New(cfg syslogCfg.Syslog, logger *zap.Logger) (*Syslog, error) {
 	return sl, nil
 }
 
+func newRFC5424Syslog(sl *Syslog, cfg syslogCfg.Syslog) (*Syslog, error) {
+	if cfg.AppName == "" {
+		cfg.AppName = defaultAppName
+	}
+	if cfg.MsgID == "" {
+		cfg.MsgID = defaultMsgID
+	}
+	if cfg.Facility == "" {
+		cfg.Facility = defaultFacility
+	}
+
+	facility, err := getFacility(cfg.Facility)
+	if err != nil {
+		return nil, fmt.Errorf("error parse facility, %w", err)
+	}
+
+	c := &conn{
+		network: strings.ToLower(cfg.Network),
+		address: cfg.Address,
+		timeout: time.Millisecond * time.Duration(cfg.Timeout),
+	}
+	if c.timeout == 0 {
+		c.timeout = defaultTimeout
+	}
+
+	if c.network == "tls" {
+		c.tlsConfig = &tls.Config{} // nolint:gosec // MinVersion is set below
+		if cfg.TLS != nil {
+			c.tlsConfig, err = util.TLSConfig(cfg.TLS)
+			if err != nil {
+				return nil, fmt.Errorf("error create tls config, %w", err)
+			}
+		}
+		c.tlsConfig.MinVersion = tls.VersionTLS12
+	}
+
+	sl.w = c
+	sl.rfc5424 = newRFC5424(facility, cfg.AppName, cfg.MsgID, cfg.EnterpriseNumber)
+
+	return sl, nil
+}
+
 // Name returns the channel name
 func (sl *Syslog) Name() string {
 	return sl.name
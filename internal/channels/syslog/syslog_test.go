@@ -110,3 +110,39 @@ func TestNew_ErrParse(t *testing.T) {
 	require.Error(t, err)
 	assert.Equal(t, "error parse priority, unexpected severity value foo", err.Error())
 }
+
+func TestNew_RFC5424(t *testing.T) {
+	p, err := New(syslogCfg.Syslog{
+		Name:             "foo",
+		Format:           syslogCfg.FormatRFC5424,
+		Network:          "TLS",
+		Address:          "127.0.0.1:6514",
+		Facility:         "LOCAL0",
+		EnterpriseNumber: 55555,
+	}, zap.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, p.rfc5424)
+	assert.Equal(t, syslog.LOG_LOCAL0, p.rfc5424.facility)
+	assert.Equal(t, "balerter", p.rfc5424.appName)
+	assert.Equal(t, "alert", p.rfc5424.msgID)
+	assert.Equal(t, 55555, p.rfc5424.enterpriseNumber)
+
+	c, ok := p.w.(*conn)
+	require.True(t, ok)
+	assert.Equal(t, "tls", c.network)
+	assert.Equal(t, defaultTimeout, c.timeout)
+	require.NotNil(t, c.tlsConfig)
+	assert.Nil(t, c.c)
+}
+
+func TestNew_RFC5424_ErrFacility(t *testing.T) {
+	_, err := New(syslogCfg.Syslog{
+		Name:     "foo",
+		Format:   syslogCfg.FormatRFC5424,
+		Network:  "tcp",
+		Address:  "127.0.0.1:514",
+		Facility: "foo",
+	}, zap.NewNop())
+	require.Error(t, err)
+	assert.Equal(t, "error parse facility, unexpected facility value foo", err.Error())
+}
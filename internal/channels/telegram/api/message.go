@@ -2,15 +2,79 @@ package api
 
 // TextMessage represents TextMessage from Telegram API
 type TextMessage struct {
-	ChatID int64  `json:"chat_id"`
-	Text   string `json:"text"`
+	ChatID           int64                 `json:"chat_id"`
+	Text             string                `json:"text"`
+	ReplyToMessageID int64                 `json:"reply_to_message_id,omitempty"`
+	ReplyMarkup      *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
 // PhotoMessage represents PhotoMessage from Telegram API
 type PhotoMessage struct {
-	ChatID  int64  `json:"chat_id"`
-	Photo   string `json:"photo"`
-	Caption string `json:"caption,omitempty"`
+	ChatID      int64                 `json:"chat_id"`
+	Photo       string                `json:"photo"`
+	Caption     string                `json:"caption,omitempty"`
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// DocumentMessage represents the file upload to Telegram API
+type DocumentMessage struct {
+	ChatID           int64
+	FileName         string
+	Data             []byte
+	Caption          string
+	ReplyToMessageID int64
+}
+
+// EditMessage represents the edit of the text or the photo caption of a sent message
+type EditMessage struct {
+	ChatID    int64
+	MessageID int64
+	Text      string
+	// Caption is true if the message is a photo
+	Caption     bool
+	ReplyMarkup *InlineKeyboardMarkup
+}
+
+// InlineKeyboardMarkup represents an inline keyboard attached to the message
+type InlineKeyboardMarkup struct {
+	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"`
+}
+
+// InlineKeyboardButton represents a button of the inline keyboard
+type InlineKeyboardButton struct {
+	Text         string `json:"text"`
+	CallbackData string `json:"callback_data,omitempty"`
+	// URL is opened by the button instead of the callback query
+	URL string `json:"url,omitempty"`
+}
+
+// Update represents an incoming update from Telegram API
+type Update struct {
+	UpdateID      int64          `json:"update_id"`
+	CallbackQuery *CallbackQuery `json:"callback_query,omitempty"`
+}
+
+// CallbackQuery represents a callback of the inline keyboard button
+type CallbackQuery struct {
+	ID      string   `json:"id"`
+	From    User     `json:"from"`
+	Message *Message `json:"message,omitempty"`
+	Data    string   `json:"data"`
+}
+
+// User represents a Telegram user
+type User struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username,omitempty"`
+	FirstName string `json:"first_name"`
+}
+
+// Message represents a sent message
+type Message struct {
+	MessageID int64 `json:"message_id"`
+	Chat      struct {
+		ID int64 `json:"id"`
+	} `json:"chat"`
 }
 
 // NewPhotoMessage creates new PhotoMessage
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,37 +10,177 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
-	methodSendMessage = "sendMessage"
-	methodSendPhoto   = "sendPhoto"
+	methodSendMessage         = "sendMessage"
+	methodSendPhoto           = "sendPhoto"
+	methodSendDocument        = "sendDocument"
+	methodEditMessageText     = "editMessageText"
+	methodEditMessageCaption  = "editMessageCaption"
+	methodAnswerCallbackQuery = "answerCallbackQuery"
+	methodGetUpdates          = "getUpdates"
+	methodSetWebhook          = "setWebhook"
+	methodDeleteWebhook       = "deleteWebhook"
+	methodGetMe               = "getMe"
+
+	parseMode = "MarkdownV2"
 )
 
-// SendPhotoMessage send PhotoMessage to the Telegram API
-func (api *API) SendPhotoMessage(mes *PhotoMessage) error {
+// SendPhotoMessage send PhotoMessage to the Telegram API and returns the message id
+func (api *API) SendPhotoMessage(mes *PhotoMessage) (int64, error) {
 	fields := map[string]string{
-		"chat_id":    strconv.Itoa(int(mes.ChatID)),
+		"chat_id":    strconv.FormatInt(mes.ChatID, 10),
 		"photo":      mes.Photo,
 		"caption":    mes.Caption,
-		"parse_mode": "MarkdownV2",
+		"parse_mode": parseMode,
+	}
+	if err := addReplyMarkup(fields, mes.ReplyMarkup); err != nil {
+		return 0, err
 	}
 
 	return api.sendMessage(fields, methodSendPhoto)
 }
 
-// SendTextMessage send TextMessage to the Telegram API
-func (api *API) SendTextMessage(mes *TextMessage) error {
+// SendTextMessage send TextMessage to the Telegram API and returns the message id
+func (api *API) SendTextMessage(mes *TextMessage) (int64, error) {
 	fields := map[string]string{
-		"chat_id":    strconv.Itoa(int(mes.ChatID)),
+		"chat_id":    strconv.FormatInt(mes.ChatID, 10),
 		"text":       mes.Text,
-		"parse_mode": "MarkdownV2",
+		"parse_mode": parseMode,
+	}
+	if mes.ReplyToMessageID != 0 {
+		fields["reply_to_message_id"] = strconv.FormatInt(mes.ReplyToMessageID, 10)
+	}
+	if err := addReplyMarkup(fields, mes.ReplyMarkup); err != nil {
+		return 0, err
 	}
 
 	return api.sendMessage(fields, methodSendMessage)
 }
 
-func (api *API) buildMultipartBody(fields map[string]string) (string, *bytes.Buffer, error) {
+// SendDocumentMessage uploads the file to the chat and returns the message id
+func (api *API) SendDocumentMessage(mes *DocumentMessage) (int64, error) {
+	fields := map[string]string{
+		"chat_id": strconv.FormatInt(mes.ChatID, 10),
+	}
+	if mes.Caption != "" {
+		fields["caption"] = mes.Caption
+		fields["parse_mode"] = parseMode
+	}
+	if mes.ReplyToMessageID != 0 {
+		fields["reply_to_message_id"] = strconv.FormatInt(mes.ReplyToMessageID, 10)
+	}
+
+	files := []formFile{{field: "document", name: mes.FileName, data: mes.Data}}
+
+	m := &Message{}
+	if err := api.callFiles(context.Background(), api.httpClient, methodSendDocument, fields, files, m); err != nil {
+		return 0, err
+	}
+	return m.MessageID, nil
+}
+
+// EditMessage edits the text or the caption of the sent message.
+// The inline keyboard is removed if ReplyMarkup is nil
+func (api *API) EditMessage(mes *EditMessage) error {
+	fields := map[string]string{
+		"chat_id":    strconv.FormatInt(mes.ChatID, 10),
+		"message_id": strconv.FormatInt(mes.MessageID, 10),
+		"parse_mode": parseMode,
+	}
+	method := methodEditMessageText
+	if mes.Caption {
+		method = methodEditMessageCaption
+		fields["caption"] = mes.Text
+	} else {
+		fields["text"] = mes.Text
+	}
+	if err := addReplyMarkup(fields, mes.ReplyMarkup); err != nil {
+		return err
+	}
+
+	return api.call(context.Background(), api.httpClient, method, fields, nil)
+}
+
+// AnswerCallbackQuery sends the answer to the callback query, the text is shown as a notification
+func (api *API) AnswerCallbackQuery(id, text string) error {
+	fields := map[string]string{
+		"callback_query_id": id,
+		"text":              text,
+	}
+
+	return api.call(context.Background(), api.httpClient, methodAnswerCallbackQuery, fields, nil)
+}
+
+// GetUpdates receives callback queries updates with the long polling
+func (api *API) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]Update, error) {
+	fields := map[string]string{
+		"offset":          strconv.FormatInt(offset, 10),
+		"timeout":         strconv.Itoa(int(timeout / time.Second)),
+		"allowed_updates": `["callback_query"]`,
+	}
+
+	// the request lasts up to the polling timeout, so the client timeout is extended
+	client := *api.httpClient
+	client.Timeout += timeout
+
+	var updates []Update
+	if err := api.call(ctx, &client, methodGetUpdates, fields, &updates); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
+
+// SetWebhook registers the webhook URL for callback queries updates
+func (api *API) SetWebhook(url, secret string) error {
+	fields := map[string]string{
+		"url":             url,
+		"allowed_updates": `["callback_query"]`,
+	}
+	if secret != "" {
+		fields["secret_token"] = secret
+	}
+
+	return api.call(context.Background(), api.httpClient, methodSetWebhook, fields, nil)
+}
+
+// DeleteWebhook removes the webhook, it is required for getUpdates
+func (api *API) DeleteWebhook() error {
+	return api.call(context.Background(), api.httpClient, methodDeleteWebhook, map[string]string{}, nil)
+}
+
+// GetMe returns the bot user, it is used to check the token
+func (api *API) GetMe(ctx context.Context) (*User, error) {
+	u := &User{}
+	if err := api.call(ctx, api.httpClient, methodGetMe, map[string]string{}, u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func addReplyMarkup(fields map[string]string, markup *InlineKeyboardMarkup) error {
+	if markup == nil {
+		return nil
+	}
+	data, err := json.Marshal(markup)
+	if err != nil {
+		return fmt.Errorf("error marshal reply markup, %w", err)
+	}
+	fields["reply_markup"] = string(data)
+	return nil
+}
+
+// formFile is the file field of the multipart body
+type formFile struct {
+	field string
+	name  string
+	data  []byte
+}
+
+func (api *API) buildMultipartBody(fields map[string]string, files []formFile) (string, *bytes.Buffer, error) {
 	buf := bytes.NewBuffer(nil)
 
 	w := multipart.NewWriter(buf)
@@ -67,6 +208,16 @@ func (api *API) buildMultipartBody(fields map[string]string) (string, *bytes.Buf
 		}
 	}
 
+	for _, file := range files {
+		f, err := w.CreateFormFile(file.field, file.name)
+		if err != nil {
+			return "", nil, fmt.Errorf("error create file field %s, %w", file.field, err)
+		}
+		if _, err := f.Write(file.data); err != nil {
+			return "", nil, fmt.Errorf("error write file field %s, %w", file.field, err)
+		}
+	}
+
 	errClose := w.Close()
 	if errClose != nil {
 		return "", nil, fmt.Errorf("error close writer, %w", errClose)
@@ -76,24 +227,40 @@ func (api *API) buildMultipartBody(fields map[string]string) (string, *bytes.Buf
 }
 
 type tgResponse struct {
-	OK          bool   `json:"ok"`
-	ErrorCode   int    `json:"error_code,omitempty"`
-	Description string `json:"description,omitempty"`
+	OK          bool            `json:"ok"`
+	ErrorCode   int             `json:"error_code,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Result      json.RawMessage `json:"result,omitempty"`
 }
 
-func (api *API) sendMessage(fields map[string]string, method string) error {
-	contentType, body, errBuildBody := api.buildMultipartBody(fields)
+func (api *API) sendMessage(fields map[string]string, method string) (int64, error) {
+	mes := &Message{}
+	if err := api.call(context.Background(), api.httpClient, method, fields, mes); err != nil {
+		return 0, err
+	}
+	return mes.MessageID, nil
+}
+
+// call calls the API method and decodes the response result to the result, if it is not nil
+func (api *API) call(ctx context.Context, client *http.Client, method string, fields map[string]string, result interface{}) error {
+	return api.callFiles(ctx, client, method, fields, nil, result)
+}
+
+// callFiles calls the API method with the files in the request body
+func (api *API) callFiles(ctx context.Context, client *http.Client, method string, fields map[string]string, files []formFile,
+	result interface{}) error {
+	contentType, body, errBuildBody := api.buildMultipartBody(fields, files)
 	if errBuildBody != nil {
 		return fmt.Errorf("error build body, %w", errBuildBody)
 	}
 
-	req, errCreateRequest := http.NewRequest(http.MethodPost, api.endpoint+method, body)
+	req, errCreateRequest := http.NewRequestWithContext(ctx, http.MethodPost, api.endpoint+method, body)
 	if errCreateRequest != nil {
 		return fmt.Errorf("error generate request to telegram, %w", errCreateRequest)
 	}
 	req.Header.Add("Content-type", contentType)
 
-	res, errDo := api.httpClient.Do(req)
+	res, errDo := client.Do(req)
 	if errDo != nil {
 		return fmt.Errorf("error send request, %w", errDo)
 	}
@@ -112,7 +279,13 @@ func (api *API) sendMessage(fields map[string]string, method string) error {
 	}
 
 	if !tgResp.OK {
-		return fmt.Errorf("error send message %d: %s", tgResp.ErrorCode, tgResp.Description)
+		return fmt.Errorf("error %s %d: %s", method, tgResp.ErrorCode, tgResp.Description)
+	}
+
+	if result != nil && len(tgResp.Result) > 0 {
+		if err := json.Unmarshal(tgResp.Result, result); err != nil {
+			return fmt.Errorf("error unmarshal response result, %w", err)
+		}
 	}
 
 	return nil
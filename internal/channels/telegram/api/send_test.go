@@ -1,10 +1,13 @@
 package api
 
 import (
+	"context"
 	"github.com/stretchr/testify/assert"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -18,7 +21,7 @@ func TestAPI_SendTextMessage(t *testing.T) {
 		assert.Equal(t, "a", req.Form.Get("text"))
 		assert.Equal(t, "MarkdownV2", req.Form.Get("parse_mode"))
 
-		resp := `{"ok":true}`
+		resp := `{"ok":true,"result":{"message_id":7,"chat":{"id":1}}}`
 		rw.Write([]byte(resp))
 	}))
 	defer srv.Close()
@@ -28,12 +31,13 @@ func TestAPI_SendTextMessage(t *testing.T) {
 		httpClient: &http.Client{},
 	}
 
-	err := a.SendTextMessage(&TextMessage{
+	id, err := a.SendTextMessage(&TextMessage{
 		ChatID: 1,
 		Text:   "a",
 	})
 
 	require.NoError(t, err)
+	assert.Equal(t, int64(7), id)
 }
 
 func TestAPI_SendPhotoMessage(t *testing.T) {
@@ -49,7 +53,7 @@ func TestAPI_SendPhotoMessage(t *testing.T) {
 		require.Equal(t, 1, len(f))
 		assert.Equal(t, "image.png", f[0].Filename)
 
-		resp := `{"ok":true}`
+		resp := `{"ok":true,"result":{"message_id":7,"chat":{"id":1}}}`
 		rw.Write([]byte(resp))
 	}))
 	defer srv.Close()
@@ -59,11 +63,180 @@ func TestAPI_SendPhotoMessage(t *testing.T) {
 		httpClient: &http.Client{},
 	}
 
-	err := a.SendPhotoMessage(&PhotoMessage{
+	id, err := a.SendPhotoMessage(&PhotoMessage{
 		ChatID:  1,
 		Photo:   "a",
 		Caption: "b",
 	})
 
 	require.NoError(t, err)
+	assert.Equal(t, int64(7), id)
+}
+
+// newTestAPI returns API for the test server handler, the handler receives the method name
+func newTestAPI(t *testing.T, h func(method string, req *http.Request) string) *API {
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		errParseForm := req.ParseMultipartForm(1024)
+		require.NoError(t, errParseForm)
+
+		rw.Write([]byte(h(req.URL.Path[1:], req)))
+	}))
+	t.Cleanup(srv.Close)
+
+	return &API{
+		endpoint:   srv.URL + "/",
+		httpClient: &http.Client{},
+	}
+}
+
+func TestAPI_SendTextMessage_replyMarkup(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		assert.Equal(t, "sendMessage", method)
+		assert.Equal(t, "3", req.Form.Get("reply_to_message_id"))
+		assert.Equal(t, `{"inline_keyboard":[[{"text":"Ack","callback_data":"ack:foo"}]]}`, req.Form.Get("reply_markup"))
+		return `{"ok":true,"result":{"message_id":7}}`
+	})
+
+	_, err := a.SendTextMessage(&TextMessage{
+		ChatID:           1,
+		Text:             "a",
+		ReplyToMessageID: 3,
+		ReplyMarkup: &InlineKeyboardMarkup{
+			InlineKeyboard: [][]InlineKeyboardButton{{{Text: "Ack", CallbackData: "ack:foo"}}},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestAPI_SendTextMessage_error(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		return `{"ok":false,"error_code":400,"description":"Bad Request"}`
+	})
+
+	_, err := a.SendTextMessage(&TextMessage{ChatID: 1, Text: "a"})
+	require.Error(t, err)
+	assert.Equal(t, "error sendMessage 400: Bad Request", err.Error())
+}
+
+func TestAPI_EditMessage(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		assert.Equal(t, "editMessageText", method)
+		assert.Equal(t, "1", req.Form.Get("chat_id"))
+		assert.Equal(t, "7", req.Form.Get("message_id"))
+		assert.Equal(t, "a", req.Form.Get("text"))
+		assert.Equal(t, "", req.Form.Get("reply_markup"))
+		return `{"ok":true,"result":true}`
+	})
+
+	err := a.EditMessage(&EditMessage{ChatID: 1, MessageID: 7, Text: "a"})
+	require.NoError(t, err)
+}
+
+func TestAPI_EditMessage_caption(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		assert.Equal(t, "editMessageCaption", method)
+		assert.Equal(t, "a", req.Form.Get("caption"))
+		return `{"ok":true,"result":true}`
+	})
+
+	err := a.EditMessage(&EditMessage{ChatID: 1, MessageID: 7, Text: "a", Caption: true})
+	require.NoError(t, err)
+}
+
+func TestAPI_AnswerCallbackQuery(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		assert.Equal(t, "answerCallbackQuery", method)
+		assert.Equal(t, "42", req.Form.Get("callback_query_id"))
+		assert.Equal(t, "done", req.Form.Get("text"))
+		return `{"ok":true,"result":true}`
+	})
+
+	err := a.AnswerCallbackQuery("42", "done")
+	require.NoError(t, err)
+}
+
+func TestAPI_GetUpdates(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		assert.Equal(t, "getUpdates", method)
+		assert.Equal(t, "5", req.Form.Get("offset"))
+		assert.Equal(t, "30", req.Form.Get("timeout"))
+		assert.Equal(t, `["callback_query"]`, req.Form.Get("allowed_updates"))
+		return `{"ok":true,"result":[{"update_id":5,"callback_query":{"id":"1","from":{"id":2,"username":"bob","first_name":"Bob"},` +
+			`"message":{"message_id":7,"chat":{"id":1}},"data":"ack:foo"}}]}`
+	})
+
+	updates, err := a.GetUpdates(context.Background(), 5, time.Second*30)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(updates))
+	assert.Equal(t, int64(5), updates[0].UpdateID)
+	require.NotNil(t, updates[0].CallbackQuery)
+	assert.Equal(t, "ack:foo", updates[0].CallbackQuery.Data)
+	assert.Equal(t, "bob", updates[0].CallbackQuery.From.Username)
+	assert.Equal(t, int64(7), updates[0].CallbackQuery.Message.MessageID)
+	assert.Equal(t, int64(1), updates[0].CallbackQuery.Message.Chat.ID)
+}
+
+func TestAPI_SetWebhook(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		assert.Equal(t, "setWebhook", method)
+		assert.Equal(t, "https://example.com/tg", req.Form.Get("url"))
+		assert.Equal(t, "secret", req.Form.Get("secret_token"))
+		return `{"ok":true,"result":true}`
+	})
+
+	err := a.SetWebhook("https://example.com/tg", "secret")
+	require.NoError(t, err)
+}
+
+func TestAPI_DeleteWebhook(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		assert.Equal(t, "deleteWebhook", method)
+		return `{"ok":true,"result":true}`
+	})
+
+	err := a.DeleteWebhook()
+	require.NoError(t, err)
+}
+
+func TestAPI_GetMe(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		assert.Equal(t, "getMe", method)
+		return `{"ok":true,"result":{"id":42,"username":"balerter_bot","first_name":"Balerter"}}`
+	})
+
+	u, err := a.GetMe(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), u.ID)
+	assert.Equal(t, "balerter_bot", u.Username)
+}
+
+func TestAPI_SendDocumentMessage(t *testing.T) {
+	a := newTestAPI(t, func(method string, req *http.Request) string {
+		assert.Equal(t, "sendDocument", method)
+		assert.Equal(t, "1", req.Form.Get("chat_id"))
+		assert.Equal(t, "7", req.Form.Get("reply_to_message_id"))
+		assert.Equal(t, "", req.Form.Get("caption"))
+
+		f, ok := req.MultipartForm.File["document"]
+		require.True(t, ok)
+		require.Equal(t, 1, len(f))
+		assert.Equal(t, "message.txt", f[0].Filename)
+		r, err := f[0].Open()
+		require.NoError(t, err)
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "full text", string(data))
+
+		return `{"ok":true,"result":{"message_id":8,"chat":{"id":1}}}`
+	})
+
+	id, err := a.SendDocumentMessage(&DocumentMessage{
+		ChatID:           1,
+		FileName:         "message.txt",
+		Data:             []byte("full text"),
+		ReplyToMessageID: 7,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), id)
 }
@@ -4,7 +4,9 @@
 package telegram
 
 import (
+	"context"
 	"sync"
+	"time"
 
 	"github.com/balerter/balerter/internal/channels/telegram/api"
 )
@@ -15,12 +17,33 @@ import (
 //
 // 		// make and configure a mocked APIer
 // 		mockedAPIer := &APIerMock{
-// 			SendPhotoMessageFunc: func(photoMessage *api.PhotoMessage) error {
+// 			AnswerCallbackQueryFunc: func(id string, text string) error {
+// 				panic("mock out the AnswerCallbackQuery method")
+// 			},
+// 			DeleteWebhookFunc: func() error {
+// 				panic("mock out the DeleteWebhook method")
+// 			},
+// 			EditMessageFunc: func(editMessage *api.EditMessage) error {
+// 				panic("mock out the EditMessage method")
+// 			},
+// 			GetMeFunc: func(ctx context.Context) (*api.User, error) {
+// 				panic("mock out the GetMe method")
+// 			},
+// 			GetUpdatesFunc: func(ctx context.Context, offset int64, timeout time.Duration) ([]api.Update, error) {
+// 				panic("mock out the GetUpdates method")
+// 			},
+// 			SendDocumentMessageFunc: func(documentMessage *api.DocumentMessage) (int64, error) {
+// 				panic("mock out the SendDocumentMessage method")
+// 			},
+// 			SendPhotoMessageFunc: func(photoMessage *api.PhotoMessage) (int64, error) {
 // 				panic("mock out the SendPhotoMessage method")
 // 			},
-// 			SendTextMessageFunc: func(textMessage *api.TextMessage) error {
+// 			SendTextMessageFunc: func(textMessage *api.TextMessage) (int64, error) {
 // 				panic("mock out the SendTextMessage method")
 // 			},
+// 			SetWebhookFunc: func(url string, secret string) error {
+// 				panic("mock out the SetWebhook method")
+// 			},
 // 		}
 //
 // 		// use mockedAPIer in code that requires APIer
@@ -28,14 +51,69 @@ import (
 //
 // 	}
 type APIerMock struct {
+	// AnswerCallbackQueryFunc mocks the AnswerCallbackQuery method.
+	AnswerCallbackQueryFunc func(id string, text string) error
+
+	// DeleteWebhookFunc mocks the DeleteWebhook method.
+	DeleteWebhookFunc func() error
+
+	// EditMessageFunc mocks the EditMessage method.
+	EditMessageFunc func(editMessage *api.EditMessage) error
+
+	// GetMeFunc mocks the GetMe method.
+	GetMeFunc func(ctx context.Context) (*api.User, error)
+
+	// GetUpdatesFunc mocks the GetUpdates method.
+	GetUpdatesFunc func(ctx context.Context, offset int64, timeout time.Duration) ([]api.Update, error)
+
+	// SendDocumentMessageFunc mocks the SendDocumentMessage method.
+	SendDocumentMessageFunc func(documentMessage *api.DocumentMessage) (int64, error)
+
 	// SendPhotoMessageFunc mocks the SendPhotoMessage method.
-	SendPhotoMessageFunc func(photoMessage *api.PhotoMessage) error
+	SendPhotoMessageFunc func(photoMessage *api.PhotoMessage) (int64, error)
 
 	// SendTextMessageFunc mocks the SendTextMessage method.
-	SendTextMessageFunc func(textMessage *api.TextMessage) error
+	SendTextMessageFunc func(textMessage *api.TextMessage) (int64, error)
+
+	// SetWebhookFunc mocks the SetWebhook method.
+	SetWebhookFunc func(url string, secret string) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AnswerCallbackQuery holds details about calls to the AnswerCallbackQuery method.
+		AnswerCallbackQuery []struct {
+			// Id is the id argument value.
+			Id string
+			// Text is the text argument value.
+			Text string
+		}
+		// DeleteWebhook holds details about calls to the DeleteWebhook method.
+		DeleteWebhook []struct {
+		}
+		// EditMessage holds details about calls to the EditMessage method.
+		EditMessage []struct {
+			// EditMessage is the editMessage argument value.
+			EditMessage *api.EditMessage
+		}
+		// GetMe holds details about calls to the GetMe method.
+		GetMe []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetUpdates holds details about calls to the GetUpdates method.
+		GetUpdates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Offset is the offset argument value.
+			Offset int64
+			// Timeout is the timeout argument value.
+			Timeout time.Duration
+		}
+		// SendDocumentMessage holds details about calls to the SendDocumentMessage method.
+		SendDocumentMessage []struct {
+			// DocumentMessage is the documentMessage argument value.
+			DocumentMessage *api.DocumentMessage
+		}
 		// SendPhotoMessage holds details about calls to the SendPhotoMessage method.
 		SendPhotoMessage []struct {
 			// PhotoMessage is the photoMessage argument value.
@@ -46,13 +124,226 @@ type APIerMock struct {
 			// TextMessage is the textMessage argument value.
 			TextMessage *api.TextMessage
 		}
+		// SetWebhook holds details about calls to the SetWebhook method.
+		SetWebhook []struct {
+			// Url is the url argument value.
+			Url string
+			// Secret is the secret argument value.
+			Secret string
+		}
+	}
+	lockAnswerCallbackQuery sync.RWMutex
+	lockDeleteWebhook       sync.RWMutex
+	lockEditMessage         sync.RWMutex
+	lockGetMe               sync.RWMutex
+	lockGetUpdates          sync.RWMutex
+	lockSendDocumentMessage sync.RWMutex
+	lockSendPhotoMessage    sync.RWMutex
+	lockSendTextMessage     sync.RWMutex
+	lockSetWebhook          sync.RWMutex
+}
+
+// AnswerCallbackQuery calls AnswerCallbackQueryFunc.
+func (mock *APIerMock) AnswerCallbackQuery(id string, text string) error {
+	if mock.AnswerCallbackQueryFunc == nil {
+		panic("APIerMock.AnswerCallbackQueryFunc: method is nil but APIer.AnswerCallbackQuery was just called")
+	}
+	callInfo := struct {
+		Id   string
+		Text string
+	}{
+		Id:   id,
+		Text: text,
+	}
+	mock.lockAnswerCallbackQuery.Lock()
+	mock.calls.AnswerCallbackQuery = append(mock.calls.AnswerCallbackQuery, callInfo)
+	mock.lockAnswerCallbackQuery.Unlock()
+	return mock.AnswerCallbackQueryFunc(id, text)
+}
+
+// AnswerCallbackQueryCalls gets all the calls that were made to AnswerCallbackQuery.
+// Check the length with:
+//
+//     len(mockedAPIer.AnswerCallbackQueryCalls())
+func (mock *APIerMock) AnswerCallbackQueryCalls() []struct {
+	Id   string
+	Text string
+} {
+	var calls []struct {
+		Id   string
+		Text string
+	}
+	mock.lockAnswerCallbackQuery.RLock()
+	calls = mock.calls.AnswerCallbackQuery
+	mock.lockAnswerCallbackQuery.RUnlock()
+	return calls
+}
+
+// DeleteWebhook calls DeleteWebhookFunc.
+func (mock *APIerMock) DeleteWebhook() error {
+	if mock.DeleteWebhookFunc == nil {
+		panic("APIerMock.DeleteWebhookFunc: method is nil but APIer.DeleteWebhook was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDeleteWebhook.Lock()
+	mock.calls.DeleteWebhook = append(mock.calls.DeleteWebhook, callInfo)
+	mock.lockDeleteWebhook.Unlock()
+	return mock.DeleteWebhookFunc()
+}
+
+// DeleteWebhookCalls gets all the calls that were made to DeleteWebhook.
+// Check the length with:
+//
+//     len(mockedAPIer.DeleteWebhookCalls())
+func (mock *APIerMock) DeleteWebhookCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDeleteWebhook.RLock()
+	calls = mock.calls.DeleteWebhook
+	mock.lockDeleteWebhook.RUnlock()
+	return calls
+}
+
+// EditMessage calls EditMessageFunc.
+func (mock *APIerMock) EditMessage(editMessage *api.EditMessage) error {
+	if mock.EditMessageFunc == nil {
+		panic("APIerMock.EditMessageFunc: method is nil but APIer.EditMessage was just called")
+	}
+	callInfo := struct {
+		EditMessage *api.EditMessage
+	}{
+		EditMessage: editMessage,
 	}
-	lockSendPhotoMessage sync.RWMutex
-	lockSendTextMessage  sync.RWMutex
+	mock.lockEditMessage.Lock()
+	mock.calls.EditMessage = append(mock.calls.EditMessage, callInfo)
+	mock.lockEditMessage.Unlock()
+	return mock.EditMessageFunc(editMessage)
+}
+
+// EditMessageCalls gets all the calls that were made to EditMessage.
+// Check the length with:
+//
+//     len(mockedAPIer.EditMessageCalls())
+func (mock *APIerMock) EditMessageCalls() []struct {
+	EditMessage *api.EditMessage
+} {
+	var calls []struct {
+		EditMessage *api.EditMessage
+	}
+	mock.lockEditMessage.RLock()
+	calls = mock.calls.EditMessage
+	mock.lockEditMessage.RUnlock()
+	return calls
+}
+
+// GetMe calls GetMeFunc.
+func (mock *APIerMock) GetMe(ctx context.Context) (*api.User, error) {
+	if mock.GetMeFunc == nil {
+		panic("APIerMock.GetMeFunc: method is nil but APIer.GetMe was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetMe.Lock()
+	mock.calls.GetMe = append(mock.calls.GetMe, callInfo)
+	mock.lockGetMe.Unlock()
+	return mock.GetMeFunc(ctx)
+}
+
+// GetMeCalls gets all the calls that were made to GetMe.
+// Check the length with:
+//
+//     len(mockedAPIer.GetMeCalls())
+func (mock *APIerMock) GetMeCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetMe.RLock()
+	calls = mock.calls.GetMe
+	mock.lockGetMe.RUnlock()
+	return calls
+}
+
+// GetUpdates calls GetUpdatesFunc.
+func (mock *APIerMock) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]api.Update, error) {
+	if mock.GetUpdatesFunc == nil {
+		panic("APIerMock.GetUpdatesFunc: method is nil but APIer.GetUpdates was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Offset  int64
+		Timeout time.Duration
+	}{
+		Ctx:     ctx,
+		Offset:  offset,
+		Timeout: timeout,
+	}
+	mock.lockGetUpdates.Lock()
+	mock.calls.GetUpdates = append(mock.calls.GetUpdates, callInfo)
+	mock.lockGetUpdates.Unlock()
+	return mock.GetUpdatesFunc(ctx, offset, timeout)
+}
+
+// GetUpdatesCalls gets all the calls that were made to GetUpdates.
+// Check the length with:
+//
+//     len(mockedAPIer.GetUpdatesCalls())
+func (mock *APIerMock) GetUpdatesCalls() []struct {
+	Ctx     context.Context
+	Offset  int64
+	Timeout time.Duration
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Offset  int64
+		Timeout time.Duration
+	}
+	mock.lockGetUpdates.RLock()
+	calls = mock.calls.GetUpdates
+	mock.lockGetUpdates.RUnlock()
+	return calls
+}
+
+// SendDocumentMessage calls SendDocumentMessageFunc.
+func (mock *APIerMock) SendDocumentMessage(documentMessage *api.DocumentMessage) (int64, error) {
+	if mock.SendDocumentMessageFunc == nil {
+		panic("APIerMock.SendDocumentMessageFunc: method is nil but APIer.SendDocumentMessage was just called")
+	}
+	callInfo := struct {
+		DocumentMessage *api.DocumentMessage
+	}{
+		DocumentMessage: documentMessage,
+	}
+	mock.lockSendDocumentMessage.Lock()
+	mock.calls.SendDocumentMessage = append(mock.calls.SendDocumentMessage, callInfo)
+	mock.lockSendDocumentMessage.Unlock()
+	return mock.SendDocumentMessageFunc(documentMessage)
+}
+
+// SendDocumentMessageCalls gets all the calls that were made to SendDocumentMessage.
+// Check the length with:
+//
+//     len(mockedAPIer.SendDocumentMessageCalls())
+func (mock *APIerMock) SendDocumentMessageCalls() []struct {
+	DocumentMessage *api.DocumentMessage
+} {
+	var calls []struct {
+		DocumentMessage *api.DocumentMessage
+	}
+	mock.lockSendDocumentMessage.RLock()
+	calls = mock.calls.SendDocumentMessage
+	mock.lockSendDocumentMessage.RUnlock()
+	return calls
 }
 
 // SendPhotoMessage calls SendPhotoMessageFunc.
-func (mock *APIerMock) SendPhotoMessage(photoMessage *api.PhotoMessage) error {
+func (mock *APIerMock) SendPhotoMessage(photoMessage *api.PhotoMessage) (int64, error) {
 	if mock.SendPhotoMessageFunc == nil {
 		panic("APIerMock.SendPhotoMessageFunc: method is nil but APIer.SendPhotoMessage was just called")
 	}
@@ -69,6 +360,7 @@ func (mock *APIerMock) SendPhotoMessage(photoMessage *api.PhotoMessage) error {
 
 // SendPhotoMessageCalls gets all the calls that were made to SendPhotoMessage.
 // Check the length with:
+//
 //     len(mockedAPIer.SendPhotoMessageCalls())
 func (mock *APIerMock) SendPhotoMessageCalls() []struct {
 	PhotoMessage *api.PhotoMessage
@@ -83,7 +375,7 @@ func (mock *APIerMock) SendPhotoMessageCalls() []struct {
 }
 
 // SendTextMessage calls SendTextMessageFunc.
-func (mock *APIerMock) SendTextMessage(textMessage *api.TextMessage) error {
+func (mock *APIerMock) SendTextMessage(textMessage *api.TextMessage) (int64, error) {
 	if mock.SendTextMessageFunc == nil {
 		panic("APIerMock.SendTextMessageFunc: method is nil but APIer.SendTextMessage was just called")
 	}
@@ -100,6 +392,7 @@ func (mock *APIerMock) SendTextMessage(textMessage *api.TextMessage) error {
 
 // SendTextMessageCalls gets all the calls that were made to SendTextMessage.
 // Check the length with:
+//
 //     len(mockedAPIer.SendTextMessageCalls())
 func (mock *APIerMock) SendTextMessageCalls() []struct {
 	TextMessage *api.TextMessage
@@ -112,3 +405,39 @@ func (mock *APIerMock) SendTextMessageCalls() []struct {
 	mock.lockSendTextMessage.RUnlock()
 	return calls
 }
+
+// SetWebhook calls SetWebhookFunc.
+func (mock *APIerMock) SetWebhook(url string, secret string) error {
+	if mock.SetWebhookFunc == nil {
+		panic("APIerMock.SetWebhookFunc: method is nil but APIer.SetWebhook was just called")
+	}
+	callInfo := struct {
+		Url    string
+		Secret string
+	}{
+		Url:    url,
+		Secret: secret,
+	}
+	mock.lockSetWebhook.Lock()
+	mock.calls.SetWebhook = append(mock.calls.SetWebhook, callInfo)
+	mock.lockSetWebhook.Unlock()
+	return mock.SetWebhookFunc(url, secret)
+}
+
+// SetWebhookCalls gets all the calls that were made to SetWebhook.
+// Check the length with:
+//
+//     len(mockedAPIer.SetWebhookCalls())
+func (mock *APIerMock) SetWebhookCalls() []struct {
+	Url    string
+	Secret string
+} {
+	var calls []struct {
+		Url    string
+		Secret string
+	}
+	mock.lockSetWebhook.RLock()
+	calls = mock.calls.SetWebhook
+	mock.lockSetWebhook.RUnlock()
+	return calls
+}
@@ -0,0 +1,84 @@
+package telegram
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/channels/telegram/api"
+	"github.com/balerter/balerter/internal/chmanager/action"
+	"github.com/balerter/balerter/internal/config/channels/telegram"
+	"go.uber.org/zap"
+)
+
+const silenceDuration = time.Hour
+
+// handleCallback executes the action of the pressed button, answers the callback
+// and replies to the alert message with the result
+func (tg *Telegram) handleCallback(q *api.CallbackQuery, actions action.Executor) {
+	if q.Message == nil || q.Message.Chat.ID != tg.chatID {
+		tg.logger.Warn("telegram callback from the unknown chat", zap.String("data", q.Data))
+		tg.answer(q.ID, "Unknown chat")
+		return
+	}
+
+	action, arg, _ := strings.Cut(q.Data, ":")
+
+	var result string
+	var err error
+
+	switch action {
+	case telegram.ButtonAck:
+		level, alertName, _ := strings.Cut(arg, ":")
+		err = actions.Ack(alertName, level)
+		result = "Acknowledged"
+	case telegram.ButtonSilence:
+		err = actions.Silence(arg, silenceDuration)
+		result = "Silenced for 1h"
+	case telegram.ButtonRerun:
+		err = actions.RunScript(arg, nil)
+		result = "Script " + arg + " started"
+	default:
+		err = fmt.Errorf("unknown action %s", action)
+	}
+
+	if err != nil {
+		tg.logger.Error("error execute telegram callback", zap.String("data", q.Data), zap.Error(err))
+		tg.answer(q.ID, "Error: "+err.Error())
+		return
+	}
+
+	tg.logger.Info("telegram callback executed", zap.String("data", q.Data), zap.Int64("user id", q.From.ID))
+	tg.answer(q.ID, result)
+
+	reply := api.NewTextMessage(tg.chatID, escapeMarkdown(result+" by "+userName(q.From)))
+	reply.ReplyToMessageID = q.Message.MessageID
+	if _, err := tg.api.SendTextMessage(reply); err != nil {
+		tg.logger.Error("error send telegram callback reply", zap.Error(err))
+	}
+}
+
+func (tg *Telegram) answer(id, text string) {
+	if err := tg.api.AnswerCallbackQuery(id, text); err != nil {
+		tg.logger.Error("error answer telegram callback", zap.Error(err))
+	}
+}
+
+func userName(u api.User) string {
+	if u.Username != "" {
+		return "@" + u.Username
+	}
+	return u.FirstName
+}
+
+// escapeMarkdown escapes MarkdownV2 special characters
+func escapeMarkdown(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune("_*[]()~`>#+-=|{}.!\\", r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
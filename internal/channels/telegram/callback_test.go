@@ -0,0 +1,123 @@
+package telegram
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/channels/telegram/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type actionsMock struct {
+	acks     [][2]string
+	silences map[string]time.Duration
+	scripts  []string
+	err      error
+}
+
+func (a *actionsMock) Ack(alertName, level string) error {
+	a.acks = append(a.acks, [2]string{alertName, level})
+	return a.err
+}
+
+func (a *actionsMock) Silence(alertName string, d time.Duration) error {
+	if a.silences == nil {
+		a.silences = map[string]time.Duration{}
+	}
+	a.silences[alertName] = d
+	return a.err
+}
+
+func (a *actionsMock) RunScript(name string, _ *http.Request) error {
+	a.scripts = append(a.scripts, name)
+	return a.err
+}
+
+func newCallbackAPI() *APIerMock {
+	return &APIerMock{
+		AnswerCallbackQueryFunc: func(id string, text string) error {
+			return nil
+		},
+		SendTextMessageFunc: func(textMessage *api.TextMessage) (int64, error) {
+			return 100, nil
+		},
+	}
+}
+
+func newCallbackQuery(data string) *api.CallbackQuery {
+	q := &api.CallbackQuery{
+		ID:      "q1",
+		From:    api.User{ID: 1, Username: "bob_1"},
+		Message: &api.Message{MessageID: 7},
+		Data:    data,
+	}
+	q.Message.Chat.ID = 42
+	return q
+}
+
+func TestTelegram_handleCallback(t *testing.T) {
+	m := newCallbackAPI()
+	tg := &Telegram{api: m, logger: zap.NewNop(), chatID: 42}
+	actions := &actionsMock{}
+
+	tg.handleCallback(newCallbackQuery("ack:error:foo"), actions)
+	tg.handleCallback(newCallbackQuery("silence:foo"), actions)
+	tg.handleCallback(newCallbackQuery("rerun:s1.lua"), actions)
+
+	assert.Equal(t, [][2]string{{"foo", "error"}}, actions.acks)
+	assert.Equal(t, map[string]time.Duration{"foo": time.Hour}, actions.silences)
+	assert.Equal(t, []string{"s1.lua"}, actions.scripts)
+
+	answers := m.AnswerCallbackQueryCalls()
+	require.Equal(t, 3, len(answers))
+	assert.Equal(t, "q1", answers[0].Id)
+	assert.Equal(t, "Acknowledged", answers[0].Text)
+	assert.Equal(t, "Silenced for 1h", answers[1].Text)
+	assert.Equal(t, "Script s1.lua started", answers[2].Text)
+
+	replies := m.SendTextMessageCalls()
+	require.Equal(t, 3, len(replies))
+	assert.Equal(t, int64(42), replies[0].TextMessage.ChatID)
+	assert.Equal(t, int64(7), replies[0].TextMessage.ReplyToMessageID)
+	assert.Equal(t, "Acknowledged by @bob\\_1", replies[0].TextMessage.Text)
+	assert.Equal(t, "Script s1\\.lua started by @bob\\_1", replies[2].TextMessage.Text)
+}
+
+func TestTelegram_handleCallback_error(t *testing.T) {
+	m := newCallbackAPI()
+	tg := &Telegram{api: m, logger: zap.NewNop(), chatID: 42}
+
+	tg.handleCallback(newCallbackQuery("rerun:s1"), &actionsMock{err: fmt.Errorf("script s1 not found")})
+	tg.handleCallback(newCallbackQuery("foo:bar"), &actionsMock{})
+
+	answers := m.AnswerCallbackQueryCalls()
+	require.Equal(t, 2, len(answers))
+	assert.Equal(t, "Error: script s1 not found", answers[0].Text)
+	assert.Equal(t, "Error: unknown action foo", answers[1].Text)
+	assert.Equal(t, 0, len(m.SendTextMessageCalls()))
+}
+
+func TestTelegram_handleCallback_unknownChat(t *testing.T) {
+	m := newCallbackAPI()
+	tg := &Telegram{api: m, logger: zap.NewNop(), chatID: 43}
+	actions := &actionsMock{}
+
+	tg.handleCallback(newCallbackQuery("silence:foo"), actions)
+
+	assert.Empty(t, actions.silences)
+	require.Equal(t, 1, len(m.AnswerCallbackQueryCalls()))
+	assert.Equal(t, "Unknown chat", m.AnswerCallbackQueryCalls()[0].Text)
+}
+
+func Test_userName(t *testing.T) {
+	assert.Equal(t, "@bob", userName(api.User{Username: "bob", FirstName: "Bob"}))
+	assert.Equal(t, "Bob", userName(api.User{FirstName: "Bob"}))
+}
+
+func Test_escapeMarkdown(t *testing.T) {
+	assert.Equal(t, "a\\_b\\*c\\.d\\!", escapeMarkdown("a_b*c.d!"))
+}
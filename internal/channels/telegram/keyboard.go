@@ -0,0 +1,68 @@
+package telegram
+
+import (
+	"github.com/balerter/balerter/internal/channels/telegram/api"
+	"github.com/balerter/balerter/internal/config/channels/telegram"
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+// maxCallbackDataLen is the Telegram API limit of the button callback data
+const maxCallbackDataLen = 64
+
+// keyboard returns the inline keyboard with the configured buttons and the message links or nil.
+// The links are the URL buttons, one per row
+func (tg *Telegram) keyboard(mes *message.Message) *api.InlineKeyboardMarkup {
+	var rows [][]api.InlineKeyboardButton
+
+	if row := tg.actionButtons(mes); len(row) > 0 {
+		rows = append(rows, row)
+	}
+
+	for _, l := range mes.Links {
+		rows = append(rows, []api.InlineKeyboardButton{{Text: l.Label, URL: l.URL}})
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return &api.InlineKeyboardMarkup{InlineKeyboard: rows}
+}
+
+// actionButtons returns the configured buttons.
+// Callback data is '<action>:<argument>', see handleCallback
+func (tg *Telegram) actionButtons(mes *message.Message) []api.InlineKeyboardButton {
+	if len(tg.buttons) == 0 || mes.Level == "success" {
+		return nil
+	}
+
+	var row []api.InlineKeyboardButton
+
+	for _, b := range tg.buttons {
+		var text, data string
+
+		switch b {
+		case telegram.ButtonAck:
+			text, data = "Ack", b+":"+mes.Level+":"+mes.AlertName
+		case telegram.ButtonSilence:
+			text, data = "Silence 1h", b+":"+mes.AlertName
+		case telegram.ButtonRerun:
+			if mes.Script == "" {
+				continue
+			}
+			text, data = "Run again", b+":"+mes.Script
+		default:
+			continue
+		}
+
+		if len(data) > maxCallbackDataLen {
+			tg.logger.Debug("skip telegram button, the callback data is too long", zap.String("button", b), zap.String("data", data))
+			continue
+		}
+
+		row = append(row, api.InlineKeyboardButton{Text: text, CallbackData: data})
+	}
+
+	return row
+}
@@ -0,0 +1,146 @@
+package telegram
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/balerter/balerter/internal/channels/telegram/api"
+	"github.com/balerter/balerter/internal/chmanager/action"
+	"github.com/balerter/balerter/internal/config/channels/telegram"
+	"go.uber.org/zap"
+)
+
+const (
+	pollTimeout = time.Second * 30
+
+	secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+)
+
+var pollRetryInterval = time.Second * 5
+
+// Listening returns true if the messages have the inline buttons
+func (tg *Telegram) Listening() bool {
+	return len(tg.buttons) > 0
+}
+
+// Listen receives the inline buttons callbacks until the ctx is done
+func (tg *Telegram) Listen(ctx context.Context, actions action.Executor) {
+	if !tg.Listening() {
+		return
+	}
+
+	if tg.callbacks != nil && tg.callbacks.Mode == telegram.CallbacksModeWebhook {
+		tg.listenWebhook(ctx, actions)
+		return
+	}
+
+	tg.poll(ctx, actions)
+}
+
+// poll receives the callbacks with getUpdates long polling
+func (tg *Telegram) poll(ctx context.Context, actions action.Executor) {
+	// getUpdates does not work while the webhook is set
+	if err := tg.api.DeleteWebhook(); err != nil {
+		tg.logger.Error("error delete telegram webhook", zap.Error(err))
+	}
+
+	var offset int64
+
+	for {
+		updates, err := tg.api.GetUpdates(ctx, offset, pollTimeout)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			tg.logger.Error("error get telegram updates", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(pollRetryInterval):
+			}
+			continue
+		}
+
+		for _, u := range updates {
+			offset = u.UpdateID + 1
+			if u.CallbackQuery != nil {
+				tg.handleCallback(u.CallbackQuery, actions)
+			}
+		}
+	}
+}
+
+// listenWebhook registers the webhook and serves its requests
+func (tg *Telegram) listenWebhook(ctx context.Context, actions action.Executor) {
+	u, err := url.Parse(tg.callbacks.URL)
+	if err != nil {
+		tg.logger.Error("error parse telegram webhook url", zap.Error(err))
+		return
+	}
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+
+	ln, err := net.Listen("tcp", tg.callbacks.Address)
+	if err != nil {
+		tg.logger.Error("error listen telegram webhook address", zap.String("address", tg.callbacks.Address), zap.Error(err))
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, tg.webhookHandler(actions))
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: time.Second * 10,
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := srv.Shutdown(context.Background()); err != nil {
+			tg.logger.Error("error shutdown telegram webhook server", zap.Error(err))
+		}
+	}()
+
+	if err := tg.api.SetWebhook(tg.callbacks.URL, tg.callbacks.Secret); err != nil {
+		tg.logger.Error("error set telegram webhook", zap.Error(err))
+	}
+
+	tg.logger.Info("serve telegram webhook", zap.String("address", ln.Addr().String()), zap.String("path", path))
+
+	if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		tg.logger.Error("error serve telegram webhook", zap.Error(err))
+	}
+}
+
+func (tg *Telegram) webhookHandler(actions action.Executor) http.HandlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		if tg.callbacks.Secret != "" &&
+			subtle.ConstantTimeCompare([]byte(req.Header.Get(secretTokenHeader)), []byte(tg.callbacks.Secret)) != 1 {
+			rw.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		u := &api.Update{}
+		if err := json.NewDecoder(req.Body).Decode(u); err != nil {
+			tg.logger.Error("error decode telegram update", zap.Error(err))
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if u.CallbackQuery != nil {
+			tg.handleCallback(u.CallbackQuery, actions)
+		}
+	}
+}
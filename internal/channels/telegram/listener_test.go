@@ -0,0 +1,138 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/channels/telegram/api"
+	"github.com/balerter/balerter/internal/config/channels/telegram"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTelegram_Listen_noButtons(t *testing.T) {
+	tg := &Telegram{api: &APIerMock{}, logger: zap.NewNop()}
+	assert.False(t, tg.Listening())
+	// returns immediately without API calls
+	tg.Listen(context.Background(), &actionsMock{})
+
+	tg.buttons = []string{"ack"}
+	assert.True(t, tg.Listening())
+}
+
+func TestTelegram_Listen_polling(t *testing.T) {
+	pollRetryInterval = time.Millisecond
+	defer func() { pollRetryInterval = time.Second * 5 }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var offsets []int64
+	m := newCallbackAPI()
+	m.DeleteWebhookFunc = func() error {
+		return nil
+	}
+	m.GetUpdatesFunc = func(_ context.Context, offset int64, timeout time.Duration) ([]api.Update, error) {
+		offsets = append(offsets, offset)
+		switch len(offsets) {
+		case 1:
+			return nil, fmt.Errorf("err1")
+		case 2:
+			return []api.Update{
+				{UpdateID: 5, CallbackQuery: newCallbackQuery("silence:foo")},
+				{UpdateID: 6},
+			}, nil
+		default:
+			cancel()
+			return nil, ctx.Err()
+		}
+	}
+
+	tg := &Telegram{api: m, logger: zap.NewNop(), chatID: 42, buttons: []string{"silence"}}
+	actions := &actionsMock{}
+
+	tg.Listen(ctx, actions)
+
+	assert.Equal(t, 1, len(m.DeleteWebhookCalls()))
+	assert.Equal(t, []int64{0, 0, 7}, offsets)
+	assert.Equal(t, map[string]time.Duration{"foo": time.Hour}, actions.silences)
+}
+
+func TestTelegram_webhookHandler(t *testing.T) {
+	m := newCallbackAPI()
+	tg := &Telegram{
+		api:       m,
+		logger:    zap.NewNop(),
+		chatID:    42,
+		callbacks: &telegram.CallbacksConfig{Secret: "secret"},
+	}
+	actions := &actionsMock{}
+	h := tg.webhookHandler(actions)
+
+	body := `{"update_id":5,"callback_query":{"id":"q1","from":{"id":1},"message":{"message_id":7,"chat":{"id":42}},"data":"silence:foo"}}`
+
+	rw := httptest.NewRecorder()
+	h(rw, httptest.NewRequest(http.MethodGet, "/tg", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rw.Code)
+
+	rw = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/tg", bytes.NewBufferString(body))
+	req.Header.Set(secretTokenHeader, "wrong")
+	h(rw, req)
+	assert.Equal(t, http.StatusForbidden, rw.Code)
+	assert.Empty(t, actions.silences)
+
+	rw = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/tg", bytes.NewBufferString("{"))
+	req.Header.Set(secretTokenHeader, "secret")
+	h(rw, req)
+	assert.Equal(t, http.StatusBadRequest, rw.Code)
+
+	rw = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/tg", bytes.NewBufferString(body))
+	req.Header.Set(secretTokenHeader, "secret")
+	h(rw, req)
+	assert.Equal(t, http.StatusOK, rw.Code)
+	assert.Equal(t, map[string]time.Duration{"foo": time.Hour}, actions.silences)
+	require.Equal(t, 1, len(m.AnswerCallbackQueryCalls()))
+}
+
+func TestTelegram_Listen_webhook(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m := newCallbackAPI()
+	m.SetWebhookFunc = func(url string, secret string) error {
+		cancel()
+		return nil
+	}
+
+	tg := &Telegram{
+		api:       m,
+		logger:    zap.NewNop(),
+		chatID:    42,
+		buttons:   []string{"ack"},
+		callbacks: &telegram.CallbacksConfig{Mode: "webhook", Address: "127.0.0.1:0", URL: "https://example.com/tg", Secret: "s"},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		tg.Listen(ctx, &actionsMock{})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("listener is not stopped")
+	}
+
+	require.Equal(t, 1, len(m.SetWebhookCalls()))
+	assert.Equal(t, "https://example.com/tg", m.SetWebhookCalls()[0].Url)
+	assert.Equal(t, "s", m.SetWebhookCalls()[0].Secret)
+}
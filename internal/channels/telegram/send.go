@@ -3,6 +3,7 @@ package telegram
 import (
 	"fmt"
 	"strconv"
+	"unicode/utf8"
 
 	"github.com/balerter/balerter/internal/channels/telegram/api"
 	"github.com/balerter/balerter/internal/message"
@@ -10,6 +11,13 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	maxTextLength    = 4096
+	maxCaptionLength = 1024
+	// minTextLength is the text length limit if the fields take almost all the message
+	minTextLength = 100
+)
+
 // Send the message to the channel
 func (tg *Telegram) Send(mes *message.Message) error {
 	tg.logger.Debug("tg send message")
@@ -18,17 +26,143 @@ func (tg *Telegram) Send(mes *message.Message) error {
 		mes.Text += addFields(mes.Fields)
 	}
 
+	if tg.editOnResolve && mes.Level == "success" {
+		resolved, err := tg.resolve(mes)
+		if err != nil {
+			return err
+		}
+		if resolved {
+			return nil
+		}
+	}
+
+	markup := tg.keyboard(mes)
+
 	if mes.Image != "" {
 		tgMessage := api.NewPhotoMessage(tg.chatID, mes.Image, mes.Text)
-		err := tg.api.SendPhotoMessage(tgMessage)
+		tgMessage.ReplyMarkup = markup
+		id, err := tg.api.SendPhotoMessage(tgMessage)
 		if err != nil {
 			tg.logger.Error("error send photo", zap.Error(err))
+			return nil
 		}
-		return nil
+		if err := tg.sendAttachments(mes, id); err != nil {
+			return err
+		}
+		return tg.remember(mes, id, true)
 	}
 
 	tgMessage := api.NewTextMessage(tg.chatID, mes.Text)
-	return tg.api.SendTextMessage(tgMessage)
+	tgMessage.ReplyMarkup = markup
+	id, err := tg.api.SendTextMessage(tgMessage)
+	if err != nil {
+		return err
+	}
+	if err := tg.sendAttachments(mes, id); err != nil {
+		return err
+	}
+	return tg.remember(mes, id, false)
+}
+
+// SupportsAttachments returns true, the attachments are sent as the documents
+func (tg *Telegram) SupportsAttachments() bool {
+	return true
+}
+
+// SupportsLinks returns true, the links are the inline URL buttons
+func (tg *Telegram) SupportsLinks() bool {
+	return true
+}
+
+// sendAttachments uploads the message attachments as the replies to the sent message
+func (tg *Telegram) sendAttachments(mes *message.Message, replyTo int64) error {
+	for _, a := range mes.Attachments {
+		_, err := tg.api.SendDocumentMessage(&api.DocumentMessage{
+			ChatID:           tg.chatID,
+			FileName:         a.Name,
+			Data:             a.Data,
+			ReplyToMessageID: replyTo,
+		})
+		if err != nil {
+			return fmt.Errorf("error send attachment %s, %w", a.Name, err)
+		}
+	}
+	return nil
+}
+
+// TextLimit returns the limit of the message text. The fields are added to the text, so they are subtracted from the limit.
+// The text over the limit is truncated and the full text is sent as a file
+func (tg *Telegram) TextLimit(mes *message.Message) message.Limit {
+	max := maxTextLength
+	if mes.Image != "" {
+		max = maxCaptionLength
+	}
+	if len(mes.Fields) > 0 {
+		max -= utf8.RuneCountInString(addFields(mes.Fields))
+	}
+	if max < minTextLength {
+		max = minTextLength
+	}
+
+	return message.Limit{MaxLength: max, Mode: message.LimitAttach}
+}
+
+// remember saves the first message of the alert to edit it on resolve
+func (tg *Telegram) remember(mes *message.Message, id int64, photo bool) error {
+	if !tg.editOnResolve || mes.Level == "success" {
+		return nil
+	}
+
+	m, err := tg.loadMessage(mes.AlertName)
+	if err != nil {
+		return err
+	}
+	if m != nil {
+		return nil
+	}
+
+	return tg.saveMessage(mes.AlertName, &alertMessage{
+		MessageID: id,
+		Photo:     photo,
+		Text:      mes.Text,
+	})
+}
+
+// resolve edits the first message of the alert with the success text.
+// Returns false if there is no message to edit
+func (tg *Telegram) resolve(mes *message.Message) (bool, error) {
+	m, err := tg.loadMessage(mes.AlertName)
+	if err != nil {
+		return false, err
+	}
+	if m == nil {
+		return false, nil
+	}
+
+	if err := tg.kv.Delete(tg.messageKey(mes.AlertName)); err != nil {
+		return false, fmt.Errorf("error delete alert message, %w", err)
+	}
+
+	// the text of the first message already takes up to the limit, the appended success text is truncated
+	max := maxTextLength
+	if m.Photo {
+		max = maxCaptionLength
+	}
+
+	// the edit removes the inline keyboard too
+	err = tg.api.EditMessage(&api.EditMessage{
+		ChatID:    tg.chatID,
+		MessageID: m.MessageID,
+		Text:      message.Truncate(m.Text+"\n\n✅ "+mes.Text, max, message.TruncateMark),
+		Caption:   m.Photo,
+	})
+	if err != nil {
+		// the message may be deleted or too old to edit, send the new one
+		tg.logger.Error("error edit telegram message", zap.Int64("message id", m.MessageID), zap.Error(err))
+		return false, nil
+	}
+
+	return true, nil
 }
 
 func addFields(fields map[string]string) string {
@@ -1,9 +1,14 @@
 package telegram
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
+	"github.com/balerter/balerter/internal/alert"
 	"github.com/balerter/balerter/internal/channels/telegram/api"
+	"github.com/balerter/balerter/internal/corestorage"
 	"github.com/balerter/balerter/internal/message"
 
 	"github.com/stretchr/testify/assert"
@@ -15,9 +20,9 @@ func TestSend_WithoutImage(t *testing.T) {
 	var tgMessage *api.TextMessage
 
 	m := &APIerMock{
-		SendTextMessageFunc: func(textMessage *api.TextMessage) error {
+		SendTextMessageFunc: func(textMessage *api.TextMessage) (int64, error) {
 			tgMessage = textMessage
-			return nil
+			return 1, nil
 		},
 	}
 
@@ -48,9 +53,9 @@ func TestSend_WithImage(t *testing.T) {
 	var tgMessage *api.PhotoMessage
 
 	m := &APIerMock{
-		SendPhotoMessageFunc: func(photoMessage *api.PhotoMessage) error {
+		SendPhotoMessageFunc: func(photoMessage *api.PhotoMessage) (int64, error) {
 			tgMessage = photoMessage
-			return nil
+			return 1, nil
 		},
 	}
 	tg := &Telegram{
@@ -77,6 +82,266 @@ func TestSend_WithImage(t *testing.T) {
 	assert.Equal(t, "img1", tgMessage.Photo)
 }
 
+// newKV returns KV mock backed by the map
+func newKV(m map[string]string) *corestorage.KVMock {
+	return &corestorage.KVMock{
+		GetFunc: func(k string) (string, error) {
+			v, ok := m[k]
+			if !ok {
+				return "", corestorage.ErrKVNotFound
+			}
+			return v, nil
+		},
+		UpsertFunc: func(k, v string) error {
+			m[k] = v
+			return nil
+		},
+		DeleteFunc: func(k string) error {
+			delete(m, k)
+			return nil
+		},
+	}
+}
+
+func TestSend_EditOnResolve(t *testing.T) {
+	store := map[string]string{}
+	var edit *api.EditMessage
+
+	m := &APIerMock{
+		SendTextMessageFunc: func(textMessage *api.TextMessage) (int64, error) {
+			return int64(len(store) + 10), nil
+		},
+		EditMessageFunc: func(editMessage *api.EditMessage) error {
+			edit = editMessage
+			return nil
+		},
+	}
+
+	tg := &Telegram{
+		name:          "tg1",
+		api:           m,
+		logger:        zap.NewNop(),
+		chatID:        42,
+		kv:            newKV(store),
+		editOnResolve: true,
+	}
+
+	require.NoError(t, tg.Send(&message.Message{Level: "error", AlertName: "foo", Text: "down"}))
+	assert.Equal(t, `{"message_id":10,"text":"down"}`, store["telegram:tg1:foo"])
+
+	// repeat does not change the first message
+	require.NoError(t, tg.Send(&message.Message{Level: "error", AlertName: "foo", Text: "still down"}))
+	assert.Equal(t, `{"message_id":10,"text":"down"}`, store["telegram:tg1:foo"])
+	assert.Equal(t, 2, len(m.SendTextMessageCalls()))
+
+	require.NoError(t, tg.Send(&message.Message{Level: "success", AlertName: "foo", Text: "up"}))
+	assert.Equal(t, 2, len(m.SendTextMessageCalls()))
+	require.NotNil(t, edit)
+	assert.Equal(t, int64(42), edit.ChatID)
+	assert.Equal(t, int64(10), edit.MessageID)
+	assert.Equal(t, "down\n\n✅ up", edit.Text)
+	assert.False(t, edit.Caption)
+	assert.Nil(t, edit.ReplyMarkup)
+	assert.Empty(t, store)
+}
+
+func TestSend_EditOnResolve_photo(t *testing.T) {
+	store := map[string]string{"telegram:tg1:foo": `{"message_id":10,"photo":true,"text":"down"}`}
+	var edit *api.EditMessage
+
+	m := &APIerMock{
+		EditMessageFunc: func(editMessage *api.EditMessage) error {
+			edit = editMessage
+			return nil
+		},
+	}
+
+	tg := &Telegram{name: "tg1", api: m, logger: zap.NewNop(), kv: newKV(store), editOnResolve: true}
+
+	require.NoError(t, tg.Send(&message.Message{Level: "success", AlertName: "foo", Text: "up", Image: "img"}))
+	require.NotNil(t, edit)
+	assert.True(t, edit.Caption)
+}
+
+func TestSend_EditOnResolve_truncate(t *testing.T) {
+	store := map[string]string{"telegram:tg1:foo": `{"message_id":10,"text":"` + strings.Repeat("a", maxTextLength-10) + `"}`}
+	var edit *api.EditMessage
+
+	m := &APIerMock{
+		EditMessageFunc: func(editMessage *api.EditMessage) error {
+			edit = editMessage
+			return nil
+		},
+	}
+
+	tg := &Telegram{name: "tg1", api: m, logger: zap.NewNop(), kv: newKV(store), editOnResolve: true}
+
+	require.NoError(t, tg.Send(&message.Message{Level: "success", AlertName: "foo", Text: "up and running"}))
+	require.NotNil(t, edit)
+	assert.Equal(t, maxTextLength, utf8.RuneCountInString(edit.Text))
+	assert.True(t, strings.HasSuffix(edit.Text, message.TruncateMark))
+
+	store["telegram:tg1:foo"] = `{"message_id":10,"photo":true,"text":"` + strings.Repeat("a", maxCaptionLength) + `"}`
+
+	require.NoError(t, tg.Send(&message.Message{Level: "success", AlertName: "foo", Text: "up"}))
+	assert.Equal(t, maxCaptionLength, utf8.RuneCountInString(edit.Text))
+	assert.True(t, strings.HasSuffix(edit.Text, message.TruncateMark))
+}
+
+func TestSend_EditOnResolve_editError(t *testing.T) {
+	store := map[string]string{"telegram:tg1:foo": `{"message_id":10,"text":"down"}`}
+
+	m := &APIerMock{
+		EditMessageFunc: func(editMessage *api.EditMessage) error {
+			return fmt.Errorf("message to edit not found")
+		},
+		SendTextMessageFunc: func(textMessage *api.TextMessage) (int64, error) {
+			return 11, nil
+		},
+	}
+
+	tg := &Telegram{name: "tg1", api: m, logger: zap.NewNop(), kv: newKV(store), editOnResolve: true}
+
+	require.NoError(t, tg.Send(&message.Message{Level: "success", AlertName: "foo", Text: "up"}))
+	require.Equal(t, 1, len(m.SendTextMessageCalls()))
+	assert.Equal(t, "up", m.SendTextMessageCalls()[0].TextMessage.Text)
+	assert.Empty(t, store)
+}
+
+func TestSend_EditOnResolve_noMessage(t *testing.T) {
+	store := map[string]string{}
+
+	m := &APIerMock{
+		SendTextMessageFunc: func(textMessage *api.TextMessage) (int64, error) {
+			return 11, nil
+		},
+	}
+
+	tg := &Telegram{name: "tg1", api: m, logger: zap.NewNop(), kv: newKV(store), editOnResolve: true}
+
+	require.NoError(t, tg.Send(&message.Message{Level: "success", AlertName: "foo", Text: "up"}))
+	assert.Equal(t, 1, len(m.SendTextMessageCalls()))
+	assert.Empty(t, store)
+}
+
+func TestSend_EditOnResolve_kvError(t *testing.T) {
+	tg := &Telegram{
+		name:   "tg1",
+		logger: zap.NewNop(),
+		kv: &corestorage.KVMock{
+			GetFunc: func(string) (string, error) {
+				return "", fmt.Errorf("err1")
+			},
+		},
+		editOnResolve: true,
+	}
+
+	err := tg.Send(&message.Message{Level: "success", AlertName: "foo", Text: "up"})
+	require.Error(t, err)
+	assert.Equal(t, "error get kv value, err1", err.Error())
+}
+
+func TestSend_Buttons(t *testing.T) {
+	m := &APIerMock{
+		SendTextMessageFunc: func(textMessage *api.TextMessage) (int64, error) {
+			return 1, nil
+		},
+	}
+
+	tg := &Telegram{api: m, logger: zap.NewNop(), buttons: []string{"ack", "silence", "rerun"}}
+
+	require.NoError(t, tg.Send(&message.Message{Level: "error", AlertName: "foo", Text: "down", Script: "s1"}))
+	require.Equal(t, 1, len(m.SendTextMessageCalls()))
+	markup := m.SendTextMessageCalls()[0].TextMessage.ReplyMarkup
+	require.NotNil(t, markup)
+	assert.Equal(t, [][]api.InlineKeyboardButton{{
+		{Text: "Ack", CallbackData: "ack:error:foo"},
+		{Text: "Silence 1h", CallbackData: "silence:foo"},
+		{Text: "Run again", CallbackData: "rerun:s1"},
+	}}, markup.InlineKeyboard)
+}
+
+func TestSend_Links(t *testing.T) {
+	m := &APIerMock{
+		SendTextMessageFunc: func(textMessage *api.TextMessage) (int64, error) {
+			return 1, nil
+		},
+	}
+
+	tg := &Telegram{api: m, logger: zap.NewNop(), buttons: []string{"silence"}}
+
+	links := []alert.Link{
+		{Label: "Runbook", URL: "https://wiki.example.com/rb"},
+		{Label: "Dashboard", URL: "https://grafana.example.com/d/1"},
+	}
+
+	require.NoError(t, tg.Send(&message.Message{Level: "error", AlertName: "foo", Text: "down", Links: links}))
+	markup := m.SendTextMessageCalls()[0].TextMessage.ReplyMarkup
+	require.NotNil(t, markup)
+	assert.Equal(t, [][]api.InlineKeyboardButton{
+		{{Text: "Silence 1h", CallbackData: "silence:foo"}},
+		{{Text: "Runbook", URL: "https://wiki.example.com/rb"}},
+		{{Text: "Dashboard", URL: "https://grafana.example.com/d/1"}},
+	}, markup.InlineKeyboard)
+
+	// the links are shown with the success message
+	require.NoError(t, tg.Send(&message.Message{Level: "success", AlertName: "foo", Text: "up", Links: links[:1]}))
+	markup = m.SendTextMessageCalls()[1].TextMessage.ReplyMarkup
+	require.NotNil(t, markup)
+	assert.Equal(t, [][]api.InlineKeyboardButton{
+		{{Text: "Runbook", URL: "https://wiki.example.com/rb"}},
+	}, markup.InlineKeyboard)
+}
+
+func TestSend_Attachments(t *testing.T) {
+	m := &APIerMock{
+		SendTextMessageFunc: func(textMessage *api.TextMessage) (int64, error) {
+			return 7, nil
+		},
+		SendDocumentMessageFunc: func(documentMessage *api.DocumentMessage) (int64, error) {
+			return 8, nil
+		},
+	}
+
+	tg := &Telegram{api: m, logger: zap.NewNop(), chatID: 42}
+
+	err := tg.Send(&message.Message{
+		Text:        "text",
+		Attachments: []alert.Attachment{{Name: "message.txt", Data: []byte("full text")}},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, len(m.SendDocumentMessageCalls()))
+	doc := m.SendDocumentMessageCalls()[0].DocumentMessage
+	assert.Equal(t, int64(42), doc.ChatID)
+	assert.Equal(t, int64(7), doc.ReplyToMessageID)
+	assert.Equal(t, "message.txt", doc.FileName)
+	assert.Equal(t, []byte("full text"), doc.Data)
+
+	m.SendDocumentMessageFunc = func(documentMessage *api.DocumentMessage) (int64, error) {
+		return 0, fmt.Errorf("err1")
+	}
+	err = tg.Send(&message.Message{
+		Text:        "text",
+		Attachments: []alert.Attachment{{Name: "message.txt"}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "error send attachment message.txt, err1", err.Error())
+}
+
+func TestTelegram_TextLimit(t *testing.T) {
+	tg := &Telegram{}
+
+	l := tg.TextLimit(&message.Message{})
+	assert.Equal(t, message.Limit{MaxLength: 4096, Mode: message.LimitAttach}, l)
+
+	l = tg.TextLimit(&message.Message{Image: "image"})
+	assert.Equal(t, 1024, l.MaxLength)
+
+	l = tg.TextLimit(&message.Message{Fields: map[string]string{"a": "b"}})
+	assert.Equal(t, 4096-len(addFields(map[string]string{"a": "b"})), l.MaxLength)
+}
+
 func Test_addFields(t *testing.T) {
 	s := addFields(map[string]string{"a": "1", "b": "2"})
 
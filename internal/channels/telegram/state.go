@@ -0,0 +1,48 @@
+package telegram
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/balerter/balerter/internal/corestorage"
+)
+
+// alertMessage is the first message of the alert, stored in the core KV storage
+type alertMessage struct {
+	MessageID int64  `json:"message_id"`
+	Photo     bool   `json:"photo,omitempty"`
+	Text      string `json:"text"`
+}
+
+func (tg *Telegram) messageKey(alertName string) string {
+	return "telegram:" + tg.name + ":" + alertName
+}
+
+// loadMessage returns the first message of the alert or nil
+func (tg *Telegram) loadMessage(alertName string) (*alertMessage, error) {
+	v, ok, err := corestorage.Lookup(tg.kv, tg.messageKey(alertName))
+	if err != nil {
+		return nil, fmt.Errorf("error get kv value, %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	m := &alertMessage{}
+	if err := json.Unmarshal([]byte(v), m); err != nil {
+		return nil, fmt.Errorf("error decode alert message, %w", err)
+	}
+
+	return m, nil
+}
+
+func (tg *Telegram) saveMessage(alertName string, m *alertMessage) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("error encode alert message, %w", err)
+	}
+	if err := tg.kv.Upsert(tg.messageKey(alertName), string(data)); err != nil {
+		return fmt.Errorf("error save alert message, %w", err)
+	}
+	return nil
+}
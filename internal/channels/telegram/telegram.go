@@ -1,9 +1,13 @@
 package telegram
 
 import (
+	"context"
 	"fmt"
+	"time"
+
 	"github.com/balerter/balerter/internal/channels/telegram/api"
 	"github.com/balerter/balerter/internal/config/channels/telegram"
+	"github.com/balerter/balerter/internal/corestorage"
 	"go.uber.org/zap"
 )
 
@@ -11,8 +15,15 @@ import (
 
 // APIer is an interface for Telegram API
 type APIer interface {
-	SendTextMessage(*api.TextMessage) error
-	SendPhotoMessage(*api.PhotoMessage) error
+	SendTextMessage(*api.TextMessage) (int64, error)
+	SendPhotoMessage(*api.PhotoMessage) (int64, error)
+	SendDocumentMessage(*api.DocumentMessage) (int64, error)
+	EditMessage(*api.EditMessage) error
+	AnswerCallbackQuery(id, text string) error
+	GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]api.Update, error)
+	SetWebhook(url, secret string) error
+	DeleteWebhook() error
+	GetMe(ctx context.Context) (*api.User, error)
 }
 
 // Telegram represent the channel of the type Telegram
@@ -22,15 +33,29 @@ type Telegram struct {
 	logger *zap.Logger
 	api    APIer
 	ignore bool
+
+	// kv stores the message ids of the alerts, used with editOnResolve
+	kv            corestorage.KV
+	editOnResolve bool
+	buttons       []string
+	callbacks     *telegram.CallbacksConfig
 }
 
 // New creates new Telegram channel
-func New(cfg telegram.Telegram, logger *zap.Logger) (*Telegram, error) {
+func New(cfg telegram.Telegram, kv corestorage.KV, logger *zap.Logger) (*Telegram, error) {
 	tg := &Telegram{
-		name:   cfg.Name,
-		chatID: cfg.ChatID,
-		logger: logger,
-		ignore: cfg.Ignore,
+		name:          cfg.Name,
+		chatID:        cfg.ChatID,
+		logger:        logger,
+		ignore:        cfg.Ignore,
+		kv:            kv,
+		editOnResolve: cfg.EditOnResolve,
+		buttons:       cfg.Buttons,
+		callbacks:     cfg.Callbacks,
+	}
+
+	if tg.editOnResolve && tg.kv == nil {
+		return nil, fmt.Errorf("core storage is required for editOnResolve")
 	}
 
 	var err error
@@ -52,3 +77,11 @@ func (tg *Telegram) Name() string {
 func (tg *Telegram) Ignore() bool {
 	return tg.ignore
 }
+
+// Check validates the bot token
+func (tg *Telegram) Check(ctx context.Context) error {
+	if _, err := tg.api.GetMe(ctx); err != nil {
+		return fmt.Errorf("error get bot info, %w", err)
+	}
+	return nil
+}
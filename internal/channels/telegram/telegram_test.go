@@ -1,6 +1,10 @@
 package telegram
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/balerter/balerter/internal/channels/telegram/api"
 	"github.com/balerter/balerter/internal/config/channels/telegram"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -8,13 +12,19 @@ import (
 )
 
 func TestNew(t *testing.T) {
-	tg, err := New(telegram.Telegram{Name: "foo", ChatID: 42}, nil)
+	tg, err := New(telegram.Telegram{Name: "foo", ChatID: 42}, nil, nil)
 	require.NoError(t, err)
 	assert.IsType(t, &Telegram{}, tg)
 	assert.Equal(t, "foo", tg.name)
 	assert.Equal(t, int64(42), tg.chatID)
 }
 
+func TestNew_EditOnResolveWithoutKV(t *testing.T) {
+	_, err := New(telegram.Telegram{Name: "foo", ChatID: 42, EditOnResolve: true}, nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, "core storage is required for editOnResolve", err.Error())
+}
+
 func TestName(t *testing.T) {
 	tg := &Telegram{name: "foo"}
 	assert.Equal(t, "foo", tg.Name())
@@ -24,3 +34,23 @@ func TestTelegram_Ignore(t *testing.T) {
 	tg := &Telegram{ignore: true}
 	assert.True(t, tg.Ignore())
 }
+
+func TestTelegram_Check(t *testing.T) {
+	tg := &Telegram{api: &APIerMock{
+		GetMeFunc: func(ctx context.Context) (*api.User, error) {
+			return &api.User{ID: 1}, nil
+		},
+	}}
+	require.NoError(t, tg.Check(context.Background()))
+}
+
+func TestTelegram_Check_Error(t *testing.T) {
+	tg := &Telegram{api: &APIerMock{
+		GetMeFunc: func(ctx context.Context) (*api.User, error) {
+			return nil, fmt.Errorf("error getMe 401: Unauthorized")
+		},
+	}}
+	err := tg.Check(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, "error get bot info, error getMe 401: Unauthorized", err.Error())
+}
@@ -0,0 +1,77 @@
+package ticket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const maxErrorBodySize = 512
+
+// apiClient is a JSON REST API client shared by the trackers
+type apiClient struct {
+	baseURL string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newAPIClient(baseURL string, headers map[string]string) *apiClient {
+	return &apiClient{
+		baseURL: baseURL,
+		headers: headers,
+		client:  &http.Client{},
+	}
+}
+
+// do sends the request with the JSON encoded in and decodes the response to out, if not nil
+func (c *apiClient) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("error marshal request, %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error read response body, %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if len(data) > maxErrorBodySize {
+			data = data[:maxErrorBodySize]
+		}
+		return fmt.Errorf("unexpected status code %d, %s", resp.StatusCode, data)
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("error decode response, %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package ticket
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/balerter/balerter/internal/config/channels/github"
+	"github.com/balerter/balerter/internal/corestorage"
+	"go.uber.org/zap"
+)
+
+const defaultGitHubURL = "https://api.github.com"
+
+// gitHub is a GitHub Issues tracker
+type gitHub struct {
+	api       *apiClient
+	repoPath  string
+	labels    []string
+	assignees []string
+}
+
+// NewGitHub creates new GitHub Issues channel
+func NewGitHub(cfg github.GitHub, kv corestorage.KV, logger *zap.Logger) (*Ticket, error) {
+	baseURL := strings.TrimRight(cfg.URL, "/")
+	if baseURL == "" {
+		baseURL = defaultGitHubURL
+	}
+
+	tr := &gitHub{
+		api: newAPIClient(baseURL, map[string]string{
+			"Authorization":        "Bearer " + cfg.Token,
+			"Accept":               "application/vnd.github+json",
+			"X-GitHub-Api-Version": "2022-11-28",
+		}),
+		repoPath:  "/repos/" + url.PathEscape(cfg.Owner) + "/" + url.PathEscape(cfg.Repo),
+		labels:    cfg.Labels,
+		assignees: cfg.Assignees,
+	}
+
+	return newTicket(cfg.Name, cfg.Title, cfg.Timeout, cfg.Ignore, tr, kv, logger), nil
+}
+
+func (g *gitHub) create(ctx context.Context, title, body string) (string, error) {
+	req := map[string]interface{}{
+		"title": title,
+		"body":  body,
+	}
+	if len(g.labels) > 0 {
+		req["labels"] = g.labels
+	}
+	if len(g.assignees) > 0 {
+		req["assignees"] = g.assignees
+	}
+
+	resp := struct {
+		Number int `json:"number"`
+	}{}
+	if err := g.api.do(ctx, http.MethodPost, g.repoPath+"/issues", req, &resp); err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(resp.Number), nil
+}
+
+func (g *gitHub) comment(ctx context.Context, id, body string) error {
+	return g.api.do(ctx, http.MethodPost, g.repoPath+"/issues/"+id+"/comments", map[string]string{"body": body}, nil)
+}
+
+func (g *gitHub) close(ctx context.Context, id string) error {
+	req := map[string]string{
+		"state":        "closed",
+		"state_reason": "completed",
+	}
+	return g.api.do(ctx, http.MethodPatch, g.repoPath+"/issues/"+id, req, nil)
+}
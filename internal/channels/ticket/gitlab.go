@@ -0,0 +1,67 @@
+package ticket
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/balerter/balerter/internal/config/channels/gitlab"
+	"github.com/balerter/balerter/internal/corestorage"
+	"go.uber.org/zap"
+)
+
+const defaultGitLabURL = "https://gitlab.com"
+
+// gitLab is a GitLab Issues tracker
+type gitLab struct {
+	api         *apiClient
+	projectPath string
+	labels      string
+}
+
+// NewGitLab creates new GitLab Issues channel
+func NewGitLab(cfg gitlab.GitLab, kv corestorage.KV, logger *zap.Logger) (*Ticket, error) {
+	baseURL := strings.TrimRight(cfg.URL, "/")
+	if baseURL == "" {
+		baseURL = defaultGitLabURL
+	}
+
+	tr := &gitLab{
+		api: newAPIClient(baseURL+"/api/v4", map[string]string{
+			"PRIVATE-TOKEN": cfg.Token,
+		}),
+		projectPath: "/projects/" + url.PathEscape(cfg.Project),
+		labels:      strings.Join(cfg.Labels, ","),
+	}
+
+	return newTicket(cfg.Name, cfg.Title, cfg.Timeout, cfg.Ignore, tr, kv, logger), nil
+}
+
+func (g *gitLab) create(ctx context.Context, title, body string) (string, error) {
+	req := map[string]string{
+		"title":       title,
+		"description": body,
+	}
+	if g.labels != "" {
+		req["labels"] = g.labels
+	}
+
+	resp := struct {
+		IID int `json:"iid"`
+	}{}
+	if err := g.api.do(ctx, http.MethodPost, g.projectPath+"/issues", req, &resp); err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(resp.IID), nil
+}
+
+func (g *gitLab) comment(ctx context.Context, id, body string) error {
+	return g.api.do(ctx, http.MethodPost, g.projectPath+"/issues/"+id+"/notes", map[string]string{"body": body}, nil)
+}
+
+func (g *gitLab) close(ctx context.Context, id string) error {
+	return g.api.do(ctx, http.MethodPut, g.projectPath+"/issues/"+id, map[string]string{"state_event": "close"}, nil)
+}
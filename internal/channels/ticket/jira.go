@@ -0,0 +1,107 @@
+package ticket
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/balerter/balerter/internal/config/channels/jira"
+	"github.com/balerter/balerter/internal/corestorage"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultJiraIssueType       = "Task"
+	defaultJiraCloseTransition = "Done"
+)
+
+// jiraTracker is a Jira tracker, uses REST API v2 which accepts plain text descriptions
+type jiraTracker struct {
+	api             *apiClient
+	project         string
+	issueType       string
+	labels          []string
+	closeTransition string
+}
+
+// NewJira creates new Jira channel
+func NewJira(cfg jira.Jira, kv corestorage.KV, logger *zap.Logger) (*Ticket, error) {
+	auth := "Bearer " + cfg.Token
+	if cfg.Username != "" {
+		auth = "Basic " + base64.StdEncoding.EncodeToString([]byte(cfg.Username+":"+cfg.Token))
+	}
+
+	tr := &jiraTracker{
+		api: newAPIClient(strings.TrimRight(cfg.URL, "/")+"/rest/api/2", map[string]string{
+			"Authorization": auth,
+		}),
+		project:         cfg.Project,
+		issueType:       cfg.IssueType,
+		labels:          cfg.Labels,
+		closeTransition: cfg.CloseTransition,
+	}
+
+	if tr.issueType == "" {
+		tr.issueType = defaultJiraIssueType
+	}
+	if tr.closeTransition == "" {
+		tr.closeTransition = defaultJiraCloseTransition
+	}
+
+	return newTicket(cfg.Name, cfg.Title, cfg.Timeout, cfg.Ignore, tr, kv, logger), nil
+}
+
+func (j *jiraTracker) create(ctx context.Context, title, body string) (string, error) {
+	fields := map[string]interface{}{
+		"project":     map[string]string{"key": j.project},
+		"summary":     title,
+		"description": body,
+		"issuetype":   map[string]string{"name": j.issueType},
+	}
+	if len(j.labels) > 0 {
+		fields["labels"] = j.labels
+	}
+
+	resp := struct {
+		Key string `json:"key"`
+	}{}
+	if err := j.api.do(ctx, http.MethodPost, "/issue", map[string]interface{}{"fields": fields}, &resp); err != nil {
+		return "", err
+	}
+	if resp.Key == "" {
+		return "", fmt.Errorf("empty issue key in the response")
+	}
+
+	return resp.Key, nil
+}
+
+func (j *jiraTracker) comment(ctx context.Context, id, body string) error {
+	return j.api.do(ctx, http.MethodPost, "/issue/"+url.PathEscape(id)+"/comment", map[string]string{"body": body}, nil)
+}
+
+// close applies the close transition, found by the name
+func (j *jiraTracker) close(ctx context.Context, id string) error {
+	path := "/issue/" + url.PathEscape(id) + "/transitions"
+
+	resp := struct {
+		Transitions []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"transitions"`
+	}{}
+	if err := j.api.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return fmt.Errorf("error get transitions, %w", err)
+	}
+
+	for _, tr := range resp.Transitions {
+		if strings.EqualFold(tr.Name, j.closeTransition) {
+			req := map[string]interface{}{"transition": map[string]string{"id": tr.ID}}
+			return j.api.do(ctx, http.MethodPost, path, req, nil)
+		}
+	}
+
+	return fmt.Errorf("transition '%s' is not available", j.closeTransition)
+}
@@ -0,0 +1,77 @@
+package ticket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+const (
+	bodyTemplate    = "Level: {LEVEL}\n\n{TEXT}\n\n{FIELDS}"
+	resolveTemplate = "Resolved\n\n{TEXT}\n\n{FIELDS}"
+)
+
+// body renders the issue or comment text
+func body(mes *message.Message, template string) string {
+	s := strings.TrimSpace(mes.Render(template))
+	// trackers support only images by URL
+	if strings.HasPrefix(mes.Image, "http") {
+		s += "\n\n" + mes.Image
+	}
+	return s
+}
+
+// Send opens, comments or closes the issue of the alert
+func (t *Ticket) Send(mes *message.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), t.timeout)
+	defer cancel()
+
+	st, err := t.loadState(mes.AlertName)
+	if err != nil {
+		return err
+	}
+
+	if mes.Level == "success" {
+		if st == nil {
+			return nil
+		}
+		return t.resolve(ctx, mes, st)
+	}
+
+	if st == nil {
+		id, err := t.tracker.create(ctx, mes.Render(t.title), body(mes, bodyTemplate))
+		if err != nil {
+			return fmt.Errorf("error create issue, %w", err)
+		}
+		t.logger.Debug("issue created", zap.String("channel", t.name), zap.String("alert", mes.AlertName), zap.String("id", id))
+		return t.saveState(mes.AlertName, &state{ID: id, Level: mes.Level})
+	}
+
+	text := body(mes, bodyTemplate)
+	if st.Level != mes.Level {
+		text = fmt.Sprintf("Level changed from %s to %s\n\n%s", st.Level, mes.Level, text)
+	}
+	if err := t.tracker.comment(ctx, st.ID, text); err != nil {
+		return fmt.Errorf("error comment issue %s, %w", st.ID, err)
+	}
+
+	if st.Level != mes.Level {
+		st.Level = mes.Level
+		return t.saveState(mes.AlertName, st)
+	}
+
+	return nil
+}
+
+func (t *Ticket) resolve(ctx context.Context, mes *message.Message, st *state) error {
+	if err := t.tracker.comment(ctx, st.ID, body(mes, resolveTemplate)); err != nil {
+		return fmt.Errorf("error comment issue %s, %w", st.ID, err)
+	}
+	if err := t.tracker.close(ctx, st.ID); err != nil {
+		return fmt.Errorf("error close issue %s, %w", st.ID, err)
+	}
+	return t.deleteState(mes.AlertName)
+}
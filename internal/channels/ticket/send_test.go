@@ -0,0 +1,141 @@
+package ticket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/balerter/balerter/internal/corestorage"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type trackerCall struct {
+	method string
+	id     string
+	text   string
+}
+
+type fakeTracker struct {
+	calls []trackerCall
+	err   error
+	last  int
+}
+
+func (f *fakeTracker) create(_ context.Context, title, body string) (string, error) {
+	f.calls = append(f.calls, trackerCall{method: "create", text: title + "|" + body})
+	if f.err != nil {
+		return "", f.err
+	}
+	f.last++
+	return strconv.Itoa(f.last), nil
+}
+
+func (f *fakeTracker) comment(_ context.Context, id, body string) error {
+	f.calls = append(f.calls, trackerCall{method: "comment", id: id, text: body})
+	return f.err
+}
+
+func (f *fakeTracker) close(_ context.Context, id string) error {
+	f.calls = append(f.calls, trackerCall{method: "close", id: id})
+	return f.err
+}
+
+// newKV returns KV mock backed by the map
+func newKV(m map[string]string) *corestorage.KVMock {
+	return &corestorage.KVMock{
+		GetFunc: func(k string) (string, error) {
+			v, ok := m[k]
+			if !ok {
+				return "", corestorage.ErrKVNotFound
+			}
+			return v, nil
+		},
+		UpsertFunc: func(k, v string) error {
+			m[k] = v
+			return nil
+		},
+		DeleteFunc: func(k string) error {
+			delete(m, k)
+			return nil
+		},
+	}
+}
+
+func newTestTicket(tr tracker, kv corestorage.KV) *Ticket {
+	return newTicket("tickets", "", 1000, false, tr, kv, zap.NewNop())
+}
+
+func TestTicket_Send_lifecycle(t *testing.T) {
+	tr := &fakeTracker{}
+	store := map[string]string{}
+	tc := newTestTicket(tr, newKV(store))
+
+	require.NoError(t, tc.Send(message.New("warning", "foo", "disk 80%", "", map[string]string{"host": "h1"})))
+	require.Len(t, tr.calls, 1)
+	assert.Equal(t, trackerCall{method: "create", text: "[warning] foo|Level: warning\n\ndisk 80%\n\nhost = h1"}, tr.calls[0])
+	assert.Equal(t, `{"id":"1","level":"warning"}`, store["ticket:tickets:foo"])
+
+	require.NoError(t, tc.Send(message.New("warning", "foo", "disk 85%", "", nil)))
+	require.Len(t, tr.calls, 2)
+	assert.Equal(t, trackerCall{method: "comment", id: "1", text: "Level: warning\n\ndisk 85%"}, tr.calls[1])
+
+	require.NoError(t, tc.Send(message.New("error", "foo", "disk 95%", "https://example.com/chart.png", nil)))
+	require.Len(t, tr.calls, 3)
+	assert.Equal(t, trackerCall{method: "comment", id: "1",
+		text: "Level changed from warning to error\n\nLevel: error\n\ndisk 95%\n\nhttps://example.com/chart.png"}, tr.calls[2])
+	assert.Equal(t, `{"id":"1","level":"error"}`, store["ticket:tickets:foo"])
+
+	require.NoError(t, tc.Send(message.New("success", "foo", "disk 50%", "", nil)))
+	require.Len(t, tr.calls, 5)
+	assert.Equal(t, trackerCall{method: "comment", id: "1", text: "Resolved\n\ndisk 50%"}, tr.calls[3])
+	assert.Equal(t, trackerCall{method: "close", id: "1"}, tr.calls[4])
+	assert.Empty(t, store)
+
+	// the next activation opens new issue
+	require.NoError(t, tc.Send(message.New("error", "foo", "disk 99%", "", nil)))
+	assert.Equal(t, "create", tr.calls[5].method)
+	assert.Equal(t, `{"id":"2","level":"error"}`, store["ticket:tickets:foo"])
+}
+
+func TestTicket_Send_success_without_issue(t *testing.T) {
+	tr := &fakeTracker{}
+	tc := newTestTicket(tr, newKV(map[string]string{}))
+
+	require.NoError(t, tc.Send(message.New("success", "foo", "ok", "", nil)))
+	assert.Empty(t, tr.calls)
+}
+
+func TestTicket_Send_tracker_error(t *testing.T) {
+	tr := &fakeTracker{err: fmt.Errorf("err1")}
+	store := map[string]string{}
+	tc := newTestTicket(tr, newKV(store))
+
+	err := tc.Send(message.New("error", "foo", "bar", "", nil))
+	require.Error(t, err)
+	assert.Equal(t, "error create issue, err1", err.Error())
+	assert.Empty(t, store)
+
+	store["ticket:tickets:foo"] = `{"id":"7","level":"error"}`
+	err = tc.Send(message.New("success", "foo", "bar", "", nil))
+	require.Error(t, err)
+	assert.Equal(t, "error comment issue 7, err1", err.Error())
+	// keep the state to close the issue on the next success
+	assert.Contains(t, store, "ticket:tickets:foo")
+}
+
+func TestTicket_Send_kv_error(t *testing.T) {
+	kv := &corestorage.KVMock{
+		GetFunc: func(string) (string, error) {
+			return "", fmt.Errorf("err1")
+		},
+	}
+	tc := newTestTicket(&fakeTracker{}, kv)
+
+	err := tc.Send(message.New("error", "foo", "bar", "", nil))
+	require.Error(t, err)
+	assert.Equal(t, "error get kv value, err1", err.Error())
+}
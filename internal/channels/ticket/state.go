@@ -0,0 +1,56 @@
+package ticket
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/balerter/balerter/internal/corestorage"
+)
+
+// state is the issue of the alert, stored in the core KV storage
+type state struct {
+	ID    string `json:"id"`
+	Level string `json:"level"`
+}
+
+func (t *Ticket) stateKey(alertName string) string {
+	return "ticket:" + t.name + ":" + alertName
+}
+
+// loadState returns the stored state or nil if the alert has no issue
+func (t *Ticket) loadState(alertName string) (*state, error) {
+	v, ok, err := corestorage.Lookup(t.kv, t.stateKey(alertName))
+	if err != nil {
+		return nil, fmt.Errorf("error get kv value, %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	st := &state{}
+	if err := json.Unmarshal([]byte(v), st); err != nil {
+		return nil, fmt.Errorf("error decode ticket state, %w", err)
+	}
+
+	return st, nil
+}
+
+func (t *Ticket) saveState(alertName string, st *state) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return fmt.Errorf("error encode ticket state, %w", err)
+	}
+
+	if err := t.kv.Upsert(t.stateKey(alertName), string(data)); err != nil {
+		return fmt.Errorf("error save ticket state, %w", err)
+	}
+
+	return nil
+}
+
+func (t *Ticket) deleteState(alertName string) error {
+	if err := t.kv.Delete(t.stateKey(alertName)); err != nil {
+		return fmt.Errorf("error delete ticket state, %w", err)
+	}
+	return nil
+}
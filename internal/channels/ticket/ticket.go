@@ -0,0 +1,68 @@
+package ticket
+
+import (
+	"context"
+	"time"
+
+	"github.com/balerter/balerter/internal/corestorage"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTimeout = time.Second * 10
+	defaultTitle   = "[{LEVEL}] {ALERT_NAME}"
+)
+
+// tracker is an issue tracker backend
+type tracker interface {
+	// create opens new issue and returns its ID
+	create(ctx context.Context, title, body string) (string, error)
+	// comment adds the comment to the issue
+	comment(ctx context.Context, id, body string) error
+	// close closes the issue
+	close(ctx context.Context, id string) error
+}
+
+// Ticket represents an issue tracker channel.
+// It opens an issue when the alert becomes warning or error, comments it while the alert is active
+// and closes it when the alert returns to success. The issue ID is stored in the core KV storage
+type Ticket struct {
+	name    string
+	title   string
+	ignore  bool
+	timeout time.Duration
+
+	tracker tracker
+	kv      corestorage.KV
+	logger  *zap.Logger
+}
+
+func newTicket(name, title string, timeout int, ignore bool, tr tracker, kv corestorage.KV, logger *zap.Logger) *Ticket {
+	t := &Ticket{
+		name:    name,
+		title:   title,
+		ignore:  ignore,
+		timeout: time.Millisecond * time.Duration(timeout),
+		tracker: tr,
+		kv:      kv,
+		logger:  logger,
+	}
+
+	if t.title == "" {
+		t.title = defaultTitle
+	}
+	if t.timeout == 0 {
+		t.timeout = defaultTimeout
+	}
+
+	return t
+}
+
+// Name returns the channel name
+func (t *Ticket) Name() string {
+	return t.name
+}
+
+func (t *Ticket) Ignore() bool {
+	return t.ignore
+}
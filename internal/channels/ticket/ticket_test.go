@@ -0,0 +1,56 @@
+package ticket
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/channels/github"
+	"github.com/balerter/balerter/internal/config/channels/gitlab"
+	"github.com/balerter/balerter/internal/config/channels/jira"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewGitHub(t *testing.T) {
+	tc, err := NewGitHub(github.GitHub{Name: "foo", Token: "t", Owner: "o", Repo: "r"}, nil, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, defaultTitle, tc.title)
+	assert.Equal(t, defaultTimeout, tc.timeout)
+
+	tr, ok := tc.tracker.(*gitHub)
+	require.True(t, ok)
+	assert.Equal(t, defaultGitHubURL, tr.api.baseURL)
+	assert.Equal(t, "/repos/o/r", tr.repoPath)
+}
+
+func TestNewGitLab(t *testing.T) {
+	tc, err := NewGitLab(gitlab.GitLab{Name: "foo", URL: "https://git.example.com/", Token: "t", Project: "g/p"}, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	tr, ok := tc.tracker.(*gitLab)
+	require.True(t, ok)
+	assert.Equal(t, "https://git.example.com/api/v4", tr.api.baseURL)
+	assert.Equal(t, "/projects/g%2Fp", tr.projectPath)
+}
+
+func TestNewJira(t *testing.T) {
+	tc, err := NewJira(jira.Jira{Name: "foo", URL: "https://jira.example.com", Username: "u", Token: "t", Project: "OPS"}, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	tr, ok := tc.tracker.(*jiraTracker)
+	require.True(t, ok)
+	assert.Equal(t, "https://jira.example.com/rest/api/2", tr.api.baseURL)
+	assert.Equal(t, "Basic dTp0", tr.api.headers["Authorization"])
+	assert.Equal(t, defaultJiraIssueType, tr.issueType)
+	assert.Equal(t, defaultJiraCloseTransition, tr.closeTransition)
+}
+
+func TestName(t *testing.T) {
+	tc := &Ticket{name: "foo"}
+	assert.Equal(t, "foo", tc.Name())
+}
+
+func TestTicket_Ignore(t *testing.T) {
+	tc := &Ticket{ignore: true}
+	assert.True(t, tc.Ignore())
+}
@@ -0,0 +1,165 @@
+package ticket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/channels/github"
+	"github.com/balerter/balerter/internal/config/channels/gitlab"
+	"github.com/balerter/balerter/internal/config/channels/jira"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type apiRequest struct {
+	method string
+	path   string
+	body   map[string]interface{}
+}
+
+// newAPIServer returns the server recording requests and responding with the responses by 'METHOD path'
+func newAPIServer(t *testing.T, responses map[string]string) (*httptest.Server, *[]apiRequest) {
+	var reqs []apiRequest
+
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		r := apiRequest{method: req.Method, path: req.URL.EscapedPath()}
+		if req.Method != http.MethodGet {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&r.body))
+		}
+		reqs = append(reqs, r)
+
+		resp, ok := responses[req.Method+" "+r.path]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			_, _ = rw.Write([]byte(`{"message":"Not Found"}`))
+			return
+		}
+		rw.WriteHeader(http.StatusCreated)
+		_, _ = rw.Write([]byte(resp))
+	}))
+	t.Cleanup(s.Close)
+
+	return s, &reqs
+}
+
+func TestGitHub(t *testing.T) {
+	s, reqs := newAPIServer(t, map[string]string{
+		"POST /repos/o/r/issues":             `{"number":42}`,
+		"POST /repos/o/r/issues/42/comments": `{}`,
+		"PATCH /repos/o/r/issues/42":         `{}`,
+	})
+
+	tc, err := NewGitHub(githubConfig(s.URL), nil, nil)
+	require.NoError(t, err)
+	tr := tc.tracker
+	ctx := context.Background()
+
+	id, err := tr.create(ctx, "title", "body")
+	require.NoError(t, err)
+	assert.Equal(t, "42", id)
+	require.NoError(t, tr.comment(ctx, id, "comment"))
+	require.NoError(t, tr.close(ctx, id))
+
+	assert.Equal(t, []apiRequest{
+		{method: "POST", path: "/repos/o/r/issues", body: map[string]interface{}{
+			"title": "title", "body": "body", "labels": []interface{}{"alert"},
+		}},
+		{method: "POST", path: "/repos/o/r/issues/42/comments", body: map[string]interface{}{"body": "comment"}},
+		{method: "PATCH", path: "/repos/o/r/issues/42", body: map[string]interface{}{"state": "closed", "state_reason": "completed"}},
+	}, *reqs)
+
+	err = tr.comment(ctx, "1", "comment")
+	require.Error(t, err)
+	assert.Equal(t, `unexpected status code 404, {"message":"Not Found"}`, err.Error())
+}
+
+func TestGitLab(t *testing.T) {
+	s, reqs := newAPIServer(t, map[string]string{
+		"POST /api/v4/projects/g%2Fp/issues":         `{"id":1000,"iid":7}`,
+		"POST /api/v4/projects/g%2Fp/issues/7/notes": `{}`,
+		"PUT /api/v4/projects/g%2Fp/issues/7":        `{}`,
+	})
+
+	tc, err := NewGitLab(gitlabConfig(s.URL), nil, nil)
+	require.NoError(t, err)
+	tr := tc.tracker
+	ctx := context.Background()
+
+	id, err := tr.create(ctx, "title", "body")
+	require.NoError(t, err)
+	assert.Equal(t, "7", id)
+	require.NoError(t, tr.comment(ctx, id, "comment"))
+	require.NoError(t, tr.close(ctx, id))
+
+	assert.Equal(t, []apiRequest{
+		{method: "POST", path: "/api/v4/projects/g%2Fp/issues", body: map[string]interface{}{
+			"title": "title", "description": "body", "labels": "alert,ops",
+		}},
+		{method: "POST", path: "/api/v4/projects/g%2Fp/issues/7/notes", body: map[string]interface{}{"body": "comment"}},
+		{method: "PUT", path: "/api/v4/projects/g%2Fp/issues/7", body: map[string]interface{}{"state_event": "close"}},
+	}, *reqs)
+}
+
+func TestJira(t *testing.T) {
+	s, reqs := newAPIServer(t, map[string]string{
+		"POST /rest/api/2/issue":                   `{"id":"10001","key":"OPS-1"}`,
+		"POST /rest/api/2/issue/OPS-1/comment":     `{}`,
+		"GET /rest/api/2/issue/OPS-1/transitions":  `{"transitions":[{"id":"11","name":"In Progress"},{"id":"31","name":"Done"}]}`,
+		"POST /rest/api/2/issue/OPS-1/transitions": ``,
+	})
+
+	tc, err := NewJira(jiraConfig(s.URL), nil, nil)
+	require.NoError(t, err)
+	tr := tc.tracker
+	ctx := context.Background()
+
+	id, err := tr.create(ctx, "title", "body")
+	require.NoError(t, err)
+	assert.Equal(t, "OPS-1", id)
+	require.NoError(t, tr.comment(ctx, id, "comment"))
+	require.NoError(t, tr.close(ctx, id))
+
+	assert.Equal(t, []apiRequest{
+		{method: "POST", path: "/rest/api/2/issue", body: map[string]interface{}{
+			"fields": map[string]interface{}{
+				"project":     map[string]interface{}{"key": "OPS"},
+				"summary":     "title",
+				"description": "body",
+				"issuetype":   map[string]interface{}{"name": "Bug"},
+			},
+		}},
+		{method: "POST", path: "/rest/api/2/issue/OPS-1/comment", body: map[string]interface{}{"body": "comment"}},
+		{method: "GET", path: "/rest/api/2/issue/OPS-1/transitions"},
+		{method: "POST", path: "/rest/api/2/issue/OPS-1/transitions", body: map[string]interface{}{
+			"transition": map[string]interface{}{"id": "31"},
+		}},
+	}, *reqs)
+}
+
+func TestJira_close_no_transition(t *testing.T) {
+	s, _ := newAPIServer(t, map[string]string{
+		"GET /rest/api/2/issue/OPS-1/transitions": `{"transitions":[{"id":"11","name":"In Progress"}]}`,
+	})
+
+	tc, err := NewJira(jiraConfig(s.URL), nil, nil)
+	require.NoError(t, err)
+
+	err = tc.tracker.close(context.Background(), "OPS-1")
+	require.Error(t, err)
+	assert.Equal(t, "transition 'Done' is not available", err.Error())
+}
+
+func githubConfig(u string) github.GitHub {
+	return github.GitHub{Name: "gh", URL: u, Token: "t", Owner: "o", Repo: "r", Labels: []string{"alert"}}
+}
+
+func gitlabConfig(u string) gitlab.GitLab {
+	return gitlab.GitLab{Name: "gl", URL: u, Token: "t", Project: "g/p", Labels: []string{"alert", "ops"}}
+}
+
+func jiraConfig(u string) jira.Jira {
+	return jira.Jira{Name: "jira", URL: u, Token: "t", Project: "OPS", IssueType: "Bug"}
+}
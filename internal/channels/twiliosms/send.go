@@ -0,0 +1,42 @@
+package twiliosms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/balerter/balerter/internal/channels/twiliovoice"
+	"github.com/balerter/balerter/internal/message"
+)
+
+// Send the message as SMS to all numbers
+func (tw *TwilioSMS) Send(mes *message.Message) error {
+	ctx, cancel := context.WithTimeout(context.Background(), tw.timeout)
+	defer cancel()
+
+	body := mes.Render(tw.template)
+
+	limit := tw.maxLength
+	if limit == 0 {
+		limit = smsLength(body)
+	}
+
+	parts := []string{message.Truncate(body, limit, truncateMark)}
+	if tw.split {
+		parts = message.Split(body, limit, tw.maxParts, truncateMark)
+	}
+
+	for _, to := range tw.to {
+		for _, part := range parts {
+			err := tw.client.Post(ctx, "Messages.json", []twiliovoice.Field{
+				{Name: "From", Value: tw.from},
+				{Name: "To", Value: to},
+				{Name: "Body", Value: part},
+			})
+			if err != nil {
+				return fmt.Errorf("error send sms to %s, %w", to, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package twiliosms
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/channels/twiliovoice"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type sms struct {
+	to   string
+	body string
+}
+
+func newTestChannel(t *testing.T, status int, sent *[]sms) (*TwilioSMS, func()) {
+	s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/Accounts/sid/Messages.json", req.URL.Path)
+		user, pass, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "sid", user)
+		assert.Equal(t, "token", pass)
+		require.NoError(t, req.ParseMultipartForm(1024))
+		*sent = append(*sent, sms{to: req.FormValue("To"), body: req.FormValue("Body")})
+		rw.WriteHeader(status)
+	}))
+
+	tw := &TwilioSMS{
+		from:     "+100",
+		to:       []string{"+1", "+2"},
+		template: defaultTemplate,
+		maxParts: defaultMaxParts,
+		timeout:  time.Second,
+		client: &twiliovoice.Client{
+			APIPrefix: s.URL,
+			SID:       "sid",
+			Token:     "token",
+			HTTP:      &http.Client{},
+			Logger:    zap.NewNop(),
+		},
+		logger: zap.NewNop(),
+	}
+
+	return tw, s.Close
+}
+
+func TestSend(t *testing.T) {
+	var sent []sms
+	tw, closeFn := newTestChannel(t, http.StatusCreated, &sent)
+	defer closeFn()
+
+	err := tw.Send(&message.Message{Level: "error", AlertName: "foo", Text: "bar"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []sms{{to: "+1", body: "[error] foo\nbar"}, {to: "+2", body: "[error] foo\nbar"}}, sent)
+}
+
+func TestSend_truncate(t *testing.T) {
+	var sent []sms
+	tw, closeFn := newTestChannel(t, http.StatusCreated, &sent)
+	defer closeFn()
+
+	tw.to = []string{"+1"}
+	tw.template = "{TEXT}"
+	tw.maxLength = 10
+
+	err := tw.Send(&message.Message{Text: "1234567890abc"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []sms{{to: "+1", body: "1234567..."}}, sent)
+}
+
+func TestSend_split(t *testing.T) {
+	var sent []sms
+	tw, closeFn := newTestChannel(t, http.StatusCreated, &sent)
+	defer closeFn()
+
+	tw.to = []string{"+1"}
+	tw.template = "{TEXT}"
+	tw.maxLength = 10
+	tw.split = true
+
+	err := tw.Send(&message.Message{Text: "1234567890abc"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []sms{{to: "+1", body: "1234567890"}, {to: "+1", body: "abc"}}, sent)
+}
+
+func TestSend_error(t *testing.T) {
+	var sent []sms
+	tw, closeFn := newTestChannel(t, http.StatusBadRequest, &sent)
+	defer closeFn()
+
+	err := tw.Send(&message.Message{Text: "foo"})
+	require.Error(t, err)
+	assert.Equal(t, "error send sms to +1, unexpected status code 400", err.Error())
+	assert.Equal(t, 1, len(sent))
+}
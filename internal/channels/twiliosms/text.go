@@ -0,0 +1,48 @@
+package twiliosms
+
+import (
+	"strings"
+)
+
+const (
+	gsm7Length    = 160
+	unicodeLength = 70
+	truncateMark  = "..."
+)
+
+// gsm7Chars contains the basic character set of the GSM 03.38 encoding
+const gsm7Chars = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// gsm7ExtChars contains the extension table of the GSM 03.38 encoding,
+// every character takes two septets (escape and the character)
+const gsm7ExtChars = "^{}\\[~]|€"
+
+// gsm7Extended returns the count of extension characters in the string,
+// ok is false if the string can not be encoded with GSM 03.38
+func gsm7Extended(s string) (count int, ok bool) {
+	for _, r := range s {
+		switch {
+		case strings.ContainsRune(gsm7Chars, r):
+		case strings.ContainsRune(gsm7ExtChars, r):
+			count++
+		default:
+			return 0, false
+		}
+	}
+	return count, true
+}
+
+// smsLength returns max length of one SMS for the text in characters.
+// Extension characters take two septets, so the length is reduced by their count,
+// but never below the half of the SMS, which fits even if all characters are extended
+func smsLength(s string) int {
+	ext, ok := gsm7Extended(s)
+	if !ok {
+		return unicodeLength
+	}
+	if gsm7Length-ext < gsm7Length/2 {
+		return gsm7Length / 2
+	}
+	return gsm7Length - ext
+}
@@ -0,0 +1,28 @@
+package twiliosms
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_smsLength(t *testing.T) {
+	assert.Equal(t, 160, smsLength("Hello, world! @£$"))
+	assert.Equal(t, 70, smsLength("Привет"))
+	assert.Equal(t, 155, smsLength("cpu {host} [1] €"))
+	assert.Equal(t, 80, smsLength(strings.Repeat("{}", 50)))
+}
+
+func Test_smsLength_septets(t *testing.T) {
+	body := strings.Repeat("a", 150) + strings.Repeat("[]", 10)
+	limit := smsLength(body)
+	for _, part := range message.Split(body, limit, 0, truncateMark) {
+		ext, ok := gsm7Extended(part)
+		require.True(t, ok)
+		assert.LessOrEqual(t, utf8.RuneCountInString(part)+ext, gsm7Length)
+	}
+}
@@ -0,0 +1,77 @@
+package twiliosms
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/balerter/balerter/internal/channels/twiliovoice"
+	"github.com/balerter/balerter/internal/config/channels/twiliosms"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultClientTimeout = time.Second * 30
+	defaultTemplate      = "[{LEVEL}] {ALERT_NAME}\n{TEXT}"
+	defaultMaxParts      = 5
+)
+
+// TwilioSMS represents a channel of type Twilio SMS
+type TwilioSMS struct {
+	name      string
+	from      string
+	to        []string
+	template  string
+	maxLength int
+	split     bool
+	maxParts  int
+	ignore    bool
+	timeout   time.Duration
+
+	client *twiliovoice.Client
+	logger *zap.Logger
+}
+
+// New creates new Twilio SMS channel
+func New(cfg twiliosms.TwilioSMS, logger *zap.Logger) (*TwilioSMS, error) {
+	tw := &TwilioSMS{
+		name:      cfg.Name,
+		from:      cfg.From,
+		to:        cfg.To,
+		template:  cfg.Template,
+		maxLength: cfg.MaxLength,
+		split:     cfg.Split,
+		maxParts:  cfg.MaxParts,
+		ignore:    cfg.Ignore,
+		timeout:   time.Millisecond * time.Duration(cfg.Timeout),
+		logger:    logger,
+	}
+
+	if tw.timeout == 0 {
+		tw.timeout = defaultClientTimeout
+	}
+	if tw.template == "" {
+		tw.template = defaultTemplate
+	}
+	if tw.maxParts == 0 {
+		tw.maxParts = defaultMaxParts
+	}
+
+	tw.client = &twiliovoice.Client{
+		APIPrefix: twiliovoice.APIPrefix,
+		SID:       cfg.SID,
+		Token:     cfg.Token,
+		HTTP:      &http.Client{},
+		Logger:    logger,
+	}
+
+	return tw, nil
+}
+
+// Name returns the channel name
+func (tw *TwilioSMS) Name() string {
+	return tw.name
+}
+
+func (tw *TwilioSMS) Ignore() bool {
+	return tw.ignore
+}
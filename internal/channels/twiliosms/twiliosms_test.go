@@ -0,0 +1,30 @@
+package twiliosms
+
+import (
+	"github.com/balerter/balerter/internal/config/channels/twiliosms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tw, err := New(twiliosms.TwilioSMS{Name: "foo", SID: "sid", Token: "token"}, zap.NewNop())
+	require.NoError(t, err)
+	assert.IsType(t, &TwilioSMS{}, tw)
+	assert.Equal(t, defaultTemplate, tw.template)
+	assert.Equal(t, defaultMaxParts, tw.maxParts)
+	assert.Equal(t, defaultClientTimeout, tw.timeout)
+	assert.Equal(t, "sid", tw.client.SID)
+	assert.Equal(t, "token", tw.client.Token)
+}
+
+func TestName(t *testing.T) {
+	tw := &TwilioSMS{name: "tw"}
+	assert.Equal(t, "tw", tw.Name())
+}
+
+func TestTwilioSMS_Ignore(t *testing.T) {
+	tw := &TwilioSMS{ignore: true}
+	assert.True(t, tw.Ignore())
+}
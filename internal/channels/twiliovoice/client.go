@@ -0,0 +1,81 @@
+package twiliovoice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// HTTPClient is an interface of http client for Twilio API requests
+type HTTPClient interface {
+	Do(r *http.Request) (*http.Response, error)
+}
+
+// Field is a form field of a Twilio API request
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Client sends requests to the Twilio REST API
+type Client struct {
+	APIPrefix string
+	SID       string
+	Token     string
+	HTTP      HTTPClient
+	Logger    *zap.Logger
+}
+
+// Post sends the form to the account resource, e.g. 'Calls.json' or 'Messages.json'
+func (c *Client) Post(ctx context.Context, resource string, fields []Field) error {
+	u := c.APIPrefix + "/Accounts/" + c.SID + "/" + resource
+
+	buf := bytes.NewBuffer(nil)
+
+	w := multipart.NewWriter(buf)
+	for _, f := range fields {
+		if err := w.WriteField(f.Name, f.Value); err != nil {
+			return err
+		}
+	}
+	err := w.Close()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.SetBasicAuth(c.SID, c.Token)
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error read response body, %w", err)
+	}
+
+	c.Logger.Debug("twilio response", zap.ByteString("response", respBody))
+
+	if resp.StatusCode != http.StatusCreated {
+		c.Logger.Error("unexpected status code from twilio request",
+			zap.Int("status", resp.StatusCode),
+			zap.ByteString("body", respBody),
+		)
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -8,18 +8,18 @@ import (
 	"sync"
 )
 
-// httpClientMock is a mock implementation of httpClient.
+// httpClientMock is a mock implementation of HTTPClient.
 //
-// 	func TestSomethingThatUseshttpClient(t *testing.T) {
+// 	func TestSomethingThatUsesHTTPClient(t *testing.T) {
 //
-// 		// make and configure a mocked httpClient
-// 		mockedhttpClient := &httpClientMock{
+// 		// make and configure a mocked HTTPClient
+// 		mockedHTTPClient := &httpClientMock{
 // 			DoFunc: func(r *http.Request) (*http.Response, error) {
 // 				panic("mock out the Do method")
 // 			},
 // 		}
 //
-// 		// use mockedhttpClient in code that requires httpClient
+// 		// use mockedHTTPClient in code that requires HTTPClient
 // 		// and then make assertions.
 //
 // 	}
@@ -41,7 +41,7 @@ type httpClientMock struct {
 // Do calls DoFunc.
 func (mock *httpClientMock) Do(r *http.Request) (*http.Response, error) {
 	if mock.DoFunc == nil {
-		panic("httpClientMock.DoFunc: method is nil but httpClient.Do was just called")
+		panic("httpClientMock.DoFunc: method is nil but HTTPClient.Do was just called")
 	}
 	callInfo := struct {
 		R *http.Request
@@ -56,7 +56,7 @@ func (mock *httpClientMock) Do(r *http.Request) (*http.Response, error) {
 
 // DoCalls gets all the calls that were made to Do.
 // Check the length with:
-//     len(mockedhttpClient.DoCalls())
+//     len(mockedHTTPClient.DoCalls())
 func (mock *httpClientMock) DoCalls() []struct {
 	R *http.Request
 } {
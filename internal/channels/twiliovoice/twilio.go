@@ -7,17 +7,14 @@ import (
 	"time"
 )
 
-//go:generate moq -out http_client_mock.go -skip-ensure -fmt goimports . httpClient
+//go:generate moq -out http_client_mock.go -skip-ensure -fmt goimports . HTTPClient:httpClientMock
 
 const (
-	apiPrefix            = "https://api.twilio.com/2010-04-01"
+	// APIPrefix is a prefix of Twilio REST API urls
+	APIPrefix            = "https://api.twilio.com/2010-04-01"
 	defaultClientTimeout = time.Second * 30
 )
 
-type httpClient interface {
-	Do(r *http.Request) (*http.Response, error)
-}
-
 type TwilioVoice struct {
 	name   string
 	sid    string
@@ -28,7 +25,7 @@ type TwilioVoice struct {
 	ignore bool
 
 	apiPrefix string
-	client    httpClient
+	client    HTTPClient
 	timeout   time.Duration
 
 	logger *zap.Logger
@@ -45,7 +42,7 @@ func New(cfg twiliovoice.Twilio, logger *zap.Logger) (*TwilioVoice, error) {
 		ignore:    cfg.Ignore,
 		timeout:   time.Millisecond * time.Duration(cfg.Timeout),
 		logger:    logger,
-		apiPrefix: apiPrefix,
+		apiPrefix: APIPrefix,
 	}
 
 	if tw.timeout == 0 {
@@ -1,13 +1,18 @@
 package webhook
 
 import (
+	"fmt"
 	"github.com/balerter/balerter/internal/config/channels/webhook"
+	"github.com/balerter/balerter/internal/util"
 	"net/http"
 	"time"
 )
 
 const (
 	defaultTimeout = 3000
+
+	defaultSignatureHeader = "X-Signature"
+	defaultTimestampHeader = "X-Timestamp"
 )
 
 // Core represent the channel of the type Webhook
@@ -19,7 +24,7 @@ type Core struct {
 }
 
 // NewCore creates new Core channel
-func NewCore(cfg webhook.Settings, version string) *Core {
+func NewCore(cfg webhook.Settings, version string) (*Core, error) {
 	t := cfg.Timeout
 	if t == 0 {
 		t = defaultTimeout
@@ -31,6 +36,16 @@ func NewCore(cfg webhook.Settings, version string) *Core {
 		Timeout: timeout,
 	}
 
+	if cfg.TLS != nil {
+		tlsConfig, err := util.TLSConfig(cfg.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("error create tls config, %w", err)
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		client.Transport = transport
+	}
+
 	c := &Core{
 		cfg:     cfg,
 		client:  client,
@@ -38,5 +53,5 @@ func NewCore(cfg webhook.Settings, version string) *Core {
 		version: version,
 	}
 
-	return c
+	return c, nil
 }
@@ -1,11 +1,18 @@
 package webhook
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"github.com/balerter/balerter/internal/config/channels/webhook"
 	"github.com/balerter/balerter/internal/message"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // Send the message to the channel
@@ -13,6 +20,17 @@ func (w *Core) Send(body io.Reader, m *message.Message) (*http.Response, error)
 	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
 	defer cancel()
 
+	// the body is read to sign it
+	var data []byte
+	if w.cfg.Auth != nil && w.cfg.Auth.Type == webhook.AuthTypeHMAC && body != nil {
+		var err error
+		data, err = io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("error read body, %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
 	req, err := http.NewRequestWithContext(ctx, w.cfg.Method, w.cfg.URL, body)
 	if err != nil {
 		return nil, err
@@ -24,6 +42,39 @@ func (w *Core) Send(body io.Reader, m *message.Message) (*http.Response, error)
 	}
 	req.URL.RawQuery = query.Encode()
 
+	w.setHeaders(req, data)
+
+	return w.client.Do(req)
+}
+
+// Check sends the HEAD request to the url with the headers and the auth of the channel.
+// The check fails if the server is not available, rejects the auth or responds with the server error status
+func (w *Core) Check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, w.cfg.URL, nil)
+	if err != nil {
+		return err
+	}
+
+	w.setHeaders(req, nil)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden || resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected response status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// setHeaders adds the user agent, the configured headers and the auth to the request
+func (w *Core) setHeaders(req *http.Request, body []byte) {
 	req.Header.Set("User-Agent", "Balerter "+w.version)
 
 	for key, value := range w.cfg.Headers {
@@ -47,8 +98,31 @@ func (w *Core) Send(body io.Reader, m *message.Message) (*http.Response, error)
 				query.Add(param, value)
 			}
 			req.URL.RawQuery = query.Encode()
+		case webhook.AuthTypeHMAC:
+			sign(req, &w.cfg.Auth.AuthHMACConfig, body, time.Now())
 		}
 	}
+}
 
-	return w.client.Do(req)
+// sign adds the timestamp and the signature headers.
+// The signature is 'sha256=' + hex(HMAC-SHA256(secret, timestamp + "." + body)), where the timestamp
+// is the unix time in seconds from the timestamp header. Receivers should reject old timestamps to prevent replay
+func sign(req *http.Request, cfg *webhook.AuthHMACConfig, body []byte, now time.Time) {
+	signatureHeader := cfg.SignatureHeader
+	if signatureHeader == "" {
+		signatureHeader = defaultSignatureHeader
+	}
+	timestampHeader := cfg.TimestampHeader
+	if timestampHeader == "" {
+		timestampHeader = defaultTimestampHeader
+	}
+
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(cfg.Secret))
+	mac.Write([]byte(ts + ".")) // nolint:errcheck // hash write never returns an error
+	mac.Write(body)             // nolint:errcheck // hash write never returns an error
+
+	req.Header.Set(timestampHeader, ts)
+	req.Header.Set(signatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
 }
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/balerter/balerter/internal/config/channels/webhook"
+	"github.com/balerter/balerter/internal/message"
+)
+
+// payload builds the request body in the configured mode
+type payload struct {
+	mode string
+	// body and bodies are strings in the raw mode and parsed templates in other modes
+	body   interface{}
+	bodies map[string]interface{}
+}
+
+func newPayload(cfg webhook.PayloadConfig) (*payload, error) {
+	p := &payload{
+		mode:   cfg.Mode,
+		bodies: make(map[string]interface{}, len(cfg.LevelBodies)),
+	}
+	if p.mode == "" {
+		p.mode = webhook.PayloadModeRaw
+	}
+
+	parse := func(s string) (interface{}, error) {
+		if p.mode == webhook.PayloadModeRaw {
+			return s, nil
+		}
+		return parseTemplate(s)
+	}
+
+	var err error
+	p.body, err = parse(cfg.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error parse body template, %w", err)
+	}
+	for level, s := range cfg.LevelBodies {
+		p.bodies[level], err = parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("error parse body template for level %s, %w", level, err)
+		}
+	}
+
+	return p, nil
+}
+
+// contentType returns the content type of the body, empty for the raw mode
+func (p *payload) contentType() string {
+	switch p.mode {
+	case webhook.PayloadModeJSON:
+		return "application/json"
+	case webhook.PayloadModeForm:
+		return "application/x-www-form-urlencoded"
+	}
+	return ""
+}
+
+func (p *payload) render(m *message.Message) ([]byte, error) {
+	tpl, ok := p.bodies[m.Level]
+	if !ok {
+		tpl = p.body
+	}
+
+	if p.mode == webhook.PayloadModeRaw {
+		return []byte(interpolate(tpl.(string), m)), nil
+	}
+
+	v, ok := render(tpl, templateVars(m))
+	if !ok {
+		v = nil
+	}
+
+	if p.mode == webhook.PayloadModeForm {
+		return formEncode(v)
+	}
+
+	return json.Marshal(v)
+}
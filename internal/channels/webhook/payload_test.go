@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"testing"
+
+	webhookConfig "github.com/balerter/balerter/internal/config/channels/webhook"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayload_render(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         webhookConfig.PayloadConfig
+		mes         *message.Message
+		want        string
+		contentType string
+	}{
+		{
+			name: "raw",
+			cfg:  webhookConfig.PayloadConfig{Body: `{"text": "$text"}`},
+			mes:  message.New("error", "foo", "bar", "", nil),
+			want: `{"text": "bar"}`,
+		},
+		{
+			name: "raw level body",
+			cfg:  webhookConfig.PayloadConfig{Body: `$text`, LevelBodies: map[string]string{"success": "resolved $alert_name"}},
+			mes:  message.New("success", "foo", "bar", "", nil),
+			want: `resolved foo`,
+		},
+		{
+			name:        "json escapes values",
+			cfg:         webhookConfig.PayloadConfig{Mode: "json", Body: `{"text": "${text}", "fields": "${fields}"}`},
+			mes:         message.New("error", "foo", "a \"quoted\"\ntext", "", map[string]string{"k": "v"}),
+			want:        `{"fields":{"k":"v"},"text":"a \"quoted\"\ntext"}`,
+			contentType: "application/json",
+		},
+		{
+			name: "json yaml template with level body",
+			cfg: webhookConfig.PayloadConfig{Mode: "json", Body: "text: ${text}",
+				LevelBodies: map[string]string{"success": "resolved: ${alert_name}\n"}},
+			mes:         message.New("success", "foo", "bar", "", nil),
+			want:        `{"resolved":"foo"}`,
+			contentType: "application/json",
+		},
+		{
+			name:        "form",
+			cfg:         webhookConfig.PayloadConfig{Mode: "form", Body: `{"text": "${text}", "level": "${level}"}`},
+			mes:         message.New("warning", "foo", "a&b", "", nil),
+			want:        `level=warning&text=a%26b`,
+			contentType: "application/x-www-form-urlencoded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newPayload(tt.cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.contentType, p.contentType())
+
+			data, err := p.render(tt.mes)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(data))
+		})
+	}
+}
+
+func TestNewPayload_error(t *testing.T) {
+	_, err := newPayload(webhookConfig.PayloadConfig{Mode: "json", Body: `{"a": `})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error parse body template")
+
+	_, err = newPayload(webhookConfig.PayloadConfig{Mode: "json", LevelBodies: map[string]string{"error": `[`}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error parse body template for level error")
+}
@@ -1,15 +1,19 @@
 package webhook
 
 import (
+	"bytes"
+	"fmt"
 	"github.com/balerter/balerter/internal/message"
-	"strings"
 )
 
 // Send the message to the channel
 func (w *Webhook) Send(m *message.Message) error {
-	body := interpolate(w.body, m)
+	body, err := w.payload.render(m)
+	if err != nil {
+		return fmt.Errorf("error render body, %w", err)
+	}
 
-	resp, err := w.whCore.Send(strings.NewReader(body), m)
+	resp, err := w.whCore.Send(bytes.NewReader(body), m)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,15 @@
 package webhook
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+
 	webhookConfig "github.com/balerter/balerter/internal/config/channels/webhook"
+	"github.com/balerter/balerter/internal/config/common"
 	"go.uber.org/zap"
 	"io"
 	"net/http"
@@ -149,6 +157,53 @@ func TestSend(t *testing.T) {
 		a.NoError(err)
 	})
 
+	t.Run("hmac", func(t *testing.T) {
+		a := require.New(t)
+		conf.Settings.Auth = &webhookConfig.AuthConfig{
+			Type: webhookConfig.AuthTypeHMAC,
+			AuthHMACConfig: webhookConfig.AuthHMACConfig{
+				Secret: "secret",
+			},
+		}
+		conf.Settings.Payload = webhookConfig.PayloadConfig{Body: `{"message": "$text"}`}
+
+		err := testHook(conf, msg, func(w http.ResponseWriter, req *http.Request) {
+			b, err := io.ReadAll(req.Body)
+			a.NoError(err)
+			a.Equal(`{"message": "alert text"}`, string(b))
+
+			ts := req.Header.Get("X-Timestamp")
+			a.NotEmpty(ts)
+
+			mac := hmac.New(sha256.New, []byte("secret"))
+			mac.Write([]byte(ts + "." + string(b)))
+			a.Equal("sha256="+hex.EncodeToString(mac.Sum(nil)), req.Header.Get("X-Signature"))
+		})
+		a.NoError(err)
+	})
+
+	t.Run("json-payload", func(t *testing.T) {
+		a := require.New(t)
+		conf.Settings.Auth = nil
+		conf.Settings.Payload = webhookConfig.PayloadConfig{Mode: "json", Body: `{"message": "${text}"}`}
+
+		err := testHook(conf, msg, func(w http.ResponseWriter, req *http.Request) {
+			a.Equal("application/json", req.Header.Get("Content-Type"))
+
+			b, err := io.ReadAll(req.Body)
+			a.NoError(err)
+			a.Equal(`{"message":"alert text"}`, string(b))
+		})
+		a.NoError(err)
+
+		conf.Settings.Headers = map[string]string{"content-type": "application/vnd.foo+json"}
+		err = testHook(conf, msg, func(w http.ResponseWriter, req *http.Request) {
+			a.Equal("application/vnd.foo+json", req.Header.Get("Content-Type"))
+		})
+		a.NoError(err)
+		conf.Settings.Headers = nil
+	})
+
 	t.Run("timeout-error", func(t *testing.T) {
 		a := require.New(t)
 		conf.Settings.Timeout = 1000
@@ -160,6 +215,46 @@ func TestSend(t *testing.T) {
 	})
 }
 
+func Test_sign(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "http://127.0.0.1", nil)
+	require.NoError(t, err)
+
+	cfg := &webhookConfig.AuthHMACConfig{Secret: "secret", SignatureHeader: "X-Sig", TimestampHeader: "X-Ts"}
+	sign(req, cfg, []byte("body"), time.Unix(1600000000, 0))
+
+	require.Equal(t, "1600000000", req.Header.Get("X-Ts"))
+	require.Equal(t, "sha256=244993394588ac85981b4505c45730b0a3a72b224b1365e89b5b3c231b84c7f6", req.Header.Get("X-Sig"))
+}
+
+func TestSend_tls(t *testing.T) {
+	s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+	defer s.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, ca, 0o600))
+
+	conf := webhookConfig.Webhook{
+		Name: "foo",
+		Settings: webhookConfig.Settings{
+			URL:    s.URL,
+			Method: http.MethodPost,
+		},
+	}
+
+	err := webhookSend(conf, &message.Message{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "certificate")
+
+	conf.Settings.TLS = &common.TLS{CA: caFile}
+	require.NoError(t, webhookSend(conf, &message.Message{}))
+
+	conf.Settings.TLS = &common.TLS{CA: "/not/exists"}
+	_, err = New(conf, "", zap.NewNop())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "error create tls config")
+}
+
 func Test_interpolate(t *testing.T) {
 	format := "$level:$alert_name:$text:$image"
 
@@ -0,0 +1,232 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	keyIf   = "$if"
+	keyThen = "$then"
+	keyElse = "$else"
+)
+
+var reference = regexp.MustCompile(`\$\{\s*([^}\s]+)\s*}`)
+
+// parseTemplate parses the JSON or YAML template
+func parseTemplate(s string) (interface{}, error) {
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+	return normalize(v)
+}
+
+// normalize converts YAML maps to map[string]interface{}
+func normalize(v interface{}) (interface{}, error) {
+	switch val := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("map key %v must be a string", k)
+			}
+			n, err := normalize(item)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = n
+		}
+		return m, nil
+	case []interface{}:
+		r := make([]interface{}, len(val))
+		for i, item := range val {
+			n, err := normalize(item)
+			if err != nil {
+				return nil, err
+			}
+			r[i] = n
+		}
+		return r, nil
+	}
+	return v, nil
+}
+
+// templateVars returns values for the template references
+func templateVars(m *message.Message) map[string]interface{} {
+	fields := make(map[string]interface{}, len(m.Fields))
+	for k, v := range m.Fields {
+		fields[k] = v
+	}
+
+	vars := map[string]interface{}{
+		"level":      m.Level,
+		"alert_name": m.AlertName,
+		"text":       m.Text,
+		"image":      m.Image,
+		"fields":     fields,
+	}
+
+	if m.Alert != nil {
+		vars["alert"] = map[string]interface{}{
+			"name":        m.Alert.Name,
+			"level":       m.Alert.Level.String(),
+			"count":       m.Alert.Count,
+			"start":       m.Alert.Start.Format(time.RFC3339),
+			"last_change": m.Alert.LastChange.Format(time.RFC3339),
+		}
+	}
+
+	return vars
+}
+
+// lookup returns the value by the dotted path. A key with dots is matched before nested keys
+func lookup(vars map[string]interface{}, path string) interface{} {
+	var cur interface{} = vars
+	for path != "" {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if v, ok := m[path]; ok {
+			return v
+		}
+		key, rest, _ := strings.Cut(path, ".")
+		cur, path = m[key], rest
+		if cur == nil {
+			return nil
+		}
+	}
+	return cur
+}
+
+// render renders the template value. The second result is false if the value must be omitted
+func render(v interface{}, vars map[string]interface{}) (interface{}, bool) {
+	switch val := v.(type) {
+	case string:
+		return renderString(val, vars), true
+	case []interface{}:
+		r := make([]interface{}, 0, len(val))
+		for _, item := range val {
+			if rv, ok := render(item, vars); ok {
+				r = append(r, rv)
+			}
+		}
+		return r, true
+	case map[string]interface{}:
+		if cond, ok := val[keyIf]; ok {
+			branch := keyElse
+			if evalCondition(fmt.Sprint(cond), vars) {
+				branch = keyThen
+			}
+			b, ok := val[branch]
+			if !ok {
+				return nil, false
+			}
+			return render(b, vars)
+		}
+		r := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			if rv, ok := render(item, vars); ok {
+				r[k] = rv
+			}
+		}
+		return r, true
+	}
+	return v, true
+}
+
+// renderString replaces references in the string.
+// If the string is a single reference, the value is returned as is, so objects and numbers keep the type
+func renderString(s string, vars map[string]interface{}) interface{} {
+	if loc := reference.FindStringSubmatchIndex(s); loc != nil && loc[0] == 0 && loc[1] == len(s) {
+		return lookup(vars, s[loc[2]:loc[3]])
+	}
+
+	return reference.ReplaceAllStringFunc(s, func(ref string) string {
+		return stringify(lookup(vars, reference.FindStringSubmatch(ref)[1]))
+	})
+}
+
+// stringify converts the value to a string for the string interpolation and the form values
+func stringify(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+	return fmt.Sprint(v)
+}
+
+// evalCondition evaluates 'path', '!path', 'path == value' and 'path != value' conditions
+func evalCondition(cond string, vars map[string]interface{}) bool {
+	cond = strings.TrimSpace(cond)
+
+	for _, op := range []string{"==", "!="} {
+		if left, right, ok := strings.Cut(cond, op); ok {
+			l := stringify(lookup(vars, strings.TrimSpace(left)))
+			r := strings.TrimSpace(right)
+			if u, err := strconv.Unquote(r); err == nil {
+				r = u
+			} else {
+				r = strings.Trim(r, "'")
+			}
+			return (l == r) == (op == "==")
+		}
+	}
+
+	if strings.HasPrefix(cond, "!") {
+		return !truthy(lookup(vars, strings.TrimSpace(cond[1:])))
+	}
+
+	return truthy(lookup(vars, cond))
+}
+
+func truthy(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return false
+	case string:
+		return val != ""
+	case bool:
+		return val
+	case int:
+		return val != 0
+	case map[string]interface{}:
+		return len(val) > 0
+	case []interface{}:
+		return len(val) > 0
+	}
+	return true
+}
+
+// formEncode encodes the rendered object as a form, nested values are JSON encoded
+func formEncode(v interface{}) ([]byte, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("form template must be an object")
+	}
+
+	values := url.Values{}
+	for k, item := range m {
+		values.Set(k, stringify(item))
+	}
+
+	return []byte(values.Encode()), nil
+}
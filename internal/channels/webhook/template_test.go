@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testVars() map[string]interface{} {
+	m := message.New("error", "foo", "line \"one\"\nline two", "", map[string]string{"host": "h1", "dc.name": "eu"})
+	start := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	m.Alert = &alert.Alert{Name: "foo", Level: alert.LevelError, Count: 3, Start: start, LastChange: start}
+	return templateVars(m)
+}
+
+func Test_parseTemplate(t *testing.T) {
+	v, err := parseTemplate("a:\n  b: [1, '${text}']\n")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1, "${text}"}}}, v)
+
+	_, err = parseTemplate(`{"a": `)
+	require.Error(t, err)
+
+	_, err = parseTemplate("1: a")
+	require.Error(t, err)
+	assert.Equal(t, "map key 1 must be a string", err.Error())
+}
+
+func Test_lookup(t *testing.T) {
+	vars := testVars()
+	assert.Equal(t, "foo", lookup(vars, "alert_name"))
+	assert.Equal(t, "h1", lookup(vars, "fields.host"))
+	assert.Equal(t, "eu", lookup(vars, "fields.dc.name"))
+	assert.Equal(t, 3, lookup(vars, "alert.count"))
+	assert.Equal(t, "2022-01-02T03:04:05Z", lookup(vars, "alert.start"))
+	assert.Nil(t, lookup(vars, "fields.unknown"))
+	assert.Nil(t, lookup(vars, "text.foo"))
+}
+
+func Test_render(t *testing.T) {
+	tpl, err := parseTemplate(`{
+		"text": "${text}",
+		"summary": "[${level}] ${alert_name} x${alert.count}",
+		"fields": "${fields}",
+		"host": "${fields.host}",
+		"count": "${alert.count}",
+		"missing": "${unknown}",
+		"resolved": {"$if": "level == success", "$then": true, "$else": false},
+		"image": {"$if": "image", "$then": "${image}"},
+		"tags": ["alert", {"$if": "level != success", "$then": "firing"}, {"$if": "!image", "$then": "no-image"}]
+	}`)
+	require.NoError(t, err)
+
+	v, ok := render(tpl, testVars())
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{
+		"text":     "line \"one\"\nline two",
+		"summary":  "[error] foo x3",
+		"fields":   map[string]interface{}{"host": "h1", "dc.name": "eu"},
+		"host":     "h1",
+		"count":    3,
+		"missing":  nil,
+		"resolved": false,
+		"tags":     []interface{}{"alert", "firing", "no-image"},
+	}, v)
+}
+
+func Test_evalCondition(t *testing.T) {
+	vars := testVars()
+	assert.True(t, evalCondition("level == error", vars))
+	assert.True(t, evalCondition(`level == "error"`, vars))
+	assert.True(t, evalCondition("level == 'error'", vars))
+	assert.False(t, evalCondition("level != error", vars))
+	assert.True(t, evalCondition("alert.count == 3", vars))
+	assert.True(t, evalCondition("fields", vars))
+	assert.False(t, evalCondition("image", vars))
+	assert.True(t, evalCondition("!image", vars))
+}
+
+func Test_formEncode(t *testing.T) {
+	data, err := formEncode(map[string]interface{}{"b": "x y", "a": map[string]interface{}{"k": "v"}, "c": 1, "d": nil})
+	require.NoError(t, err)
+	assert.Equal(t, "a=%7B%22k%22%3A%22v%22%7D&b=x+y&c=1&d=", string(data))
+
+	_, err = formEncode([]interface{}{})
+	require.Error(t, err)
+}
@@ -1,30 +1,62 @@
 package webhook
 
 import (
+	"context"
+	"net/http"
+
 	"github.com/balerter/balerter/internal/config/channels/webhook"
 	"go.uber.org/zap"
 )
 
 // Webhook implements a Provider for webhook notifications.
 type Webhook struct {
-	logger *zap.Logger
-	name   string
-	body   string
-	whCore *Core
-	ignore bool
+	logger  *zap.Logger
+	name    string
+	payload *payload
+	whCore  *Core
+	ignore  bool
 }
 
 // New creates new Webhook channel
 func New(cfg webhook.Webhook, version string, logger *zap.Logger) (*Webhook, error) {
+	p, err := newPayload(cfg.Settings.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the content type of the payload mode, if it is not set by the user
+	if ct := p.contentType(); ct != "" && !hasHeader(cfg.Settings.Headers, "Content-Type") {
+		headers := make(map[string]string, len(cfg.Settings.Headers)+1)
+		for k, v := range cfg.Settings.Headers {
+			headers[k] = v
+		}
+		headers["Content-Type"] = ct
+		cfg.Settings.Headers = headers
+	}
+
+	core, err := NewCore(cfg.Settings, version)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Webhook{
-		body:   cfg.Settings.Payload.Body,
-		logger: logger,
-		name:   cfg.Name,
-		whCore: NewCore(cfg.Settings, version),
-		ignore: cfg.Ignore,
+		payload: p,
+		logger:  logger,
+		name:    cfg.Name,
+		whCore:  core,
+		ignore:  cfg.Ignore,
 	}, nil
 }
 
+func hasHeader(headers map[string]string, name string) bool {
+	for k := range headers {
+		if http.CanonicalHeaderKey(k) == name {
+			return true
+		}
+	}
+	return false
+}
+
 // Name returns the channel name
 func (w *Webhook) Name() string {
 	return w.name
@@ -33,3 +65,8 @@ func (w *Webhook) Name() string {
 func (w *Webhook) Ignore() bool {
 	return w.ignore
 }
+
+// Check checks the webhook url is available
+func (w *Webhook) Check(ctx context.Context) error {
+	return w.whCore.Check(ctx)
+}
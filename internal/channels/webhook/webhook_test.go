@@ -1,9 +1,15 @@
 package webhook
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	webhookConfig "github.com/balerter/balerter/internal/config/channels/webhook"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestWebhookName(t *testing.T) {
@@ -15,3 +21,38 @@ func TestWebhook_Ignore(t *testing.T) {
 	w := &Webhook{ignore: true}
 	assert.True(t, w.Ignore())
 }
+
+func TestWebhook_Check(t *testing.T) {
+	status := http.StatusMethodNotAllowed
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodHead, req.Method)
+		assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+		w.WriteHeader(status)
+	}))
+	defer s.Close()
+
+	w, err := New(webhookConfig.Webhook{
+		Name: "foo",
+		Settings: webhookConfig.Settings{
+			URL:    s.URL,
+			Method: http.MethodPost,
+			Auth: &webhookConfig.AuthConfig{
+				Type:             webhookConfig.AuthTypeBearer,
+				AuthBearerConfig: webhookConfig.AuthBearerConfig{Token: "token"},
+			},
+		},
+	}, "", zap.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, w.Check(context.Background()))
+
+	status = http.StatusUnauthorized
+	err = w.Check(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, "unexpected response status code 401", err.Error())
+
+	status = http.StatusBadGateway
+	err = w.Check(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, "unexpected response status code 502", err.Error())
+}
@@ -0,0 +1,14 @@
+package action
+
+import (
+	"net/http"
+	"time"
+)
+
+// Executor executes the user actions received by the channels, e.g. telegram inline buttons.
+// It is implemented by the channels manager
+type Executor interface {
+	Ack(alertName, level string) error
+	Silence(alertName string, d time.Duration) error
+	RunScript(name string, req *http.Request) error
+}
@@ -0,0 +1,73 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+const uploadTimeout = time.Second * 10
+
+// attachmentsSender is implemented by the channels which send the attachments natively
+type attachmentsSender interface {
+	SupportsAttachments() bool
+}
+
+// uploader uploads the attachments for the channels without uploads support
+type uploader interface {
+	Upload(ctx context.Context, storage, filename, contentType string, data []byte) (string, error)
+}
+
+// SetUploader sets the upload storage for the attachments
+func (m *ChannelsManager) SetUploader(u uploader) {
+	m.uploader = u
+}
+
+func supportsAttachments(ch alertChannel) bool {
+	s, ok := ch.(attachmentsSender)
+	return ok && s.SupportsAttachments()
+}
+
+// attachmentsAsLinks returns the copy of the message with the links to the uploaded attachments in the text.
+// The attachment is uploaded once, the URL is saved to the attachment and reused for the next channels
+func (m *ChannelsManager) attachmentsAsLinks(mes *message.Message) *message.Message {
+	result := *mes
+	result.Attachments = nil
+
+	if m.uploader == nil {
+		m.logger.Warn("the attachments are not sent, the upload storage is not configured",
+			zap.String("alert name", mes.AlertName))
+		return &result
+	}
+
+	var links []string
+
+	for idx := range mes.Attachments {
+		a := &mes.Attachments[idx]
+
+		if a.URL == "" {
+			ctx, cancel := context.WithTimeout(context.Background(), uploadTimeout)
+			filename := fmt.Sprintf("%s-%d-%s", mes.AlertName, time.Now().UnixNano(), a.Name)
+			url, err := m.uploader.Upload(ctx, m.attachmentsStorage, filename, a.ContentType, a.Data)
+			cancel()
+			if err != nil {
+				m.logger.Error("error upload the attachment", zap.String("alert name", mes.AlertName),
+					zap.String("attachment", a.Name), zap.Error(err))
+				continue
+			}
+			a.URL = url
+		}
+
+		links = append(links, a.Name+": "+a.URL)
+	}
+
+	if len(links) > 0 {
+		result.Text += "\n\n" + strings.Join(links, "\n")
+	}
+
+	return &result
+}
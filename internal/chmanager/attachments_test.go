@@ -0,0 +1,85 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type uploaderMock struct {
+	calls int
+	err   error
+}
+
+func (u *uploaderMock) Upload(_ context.Context, storage, filename, _ string, _ []byte) (string, error) {
+	u.calls++
+	if u.err != nil {
+		return "", u.err
+	}
+	return "https://example.com/" + storage + "/" + filename, nil
+}
+
+type attachmentsChannelMock struct {
+	*alertChannelMock
+}
+
+func (ch *attachmentsChannelMock) SupportsAttachments() bool {
+	return true
+}
+
+func TestManager_Send_attachments(t *testing.T) {
+	u := &uploaderMock{}
+
+	m := New(nil, zap.NewNop())
+	m.SetUploader(u)
+	m.attachmentsStorage = "s1"
+
+	plain1 := newChannelMock("plain1", nil)
+	plain2 := newChannelMock("plain2", nil)
+	native := &attachmentsChannelMock{alertChannelMock: newChannelMock("native", nil)}
+	m.channels["plain1"] = plain1
+	m.channels["plain2"] = plain2
+	m.channels["native"] = native
+
+	a := alert.New("alert1")
+	a.Level = alert.LevelError
+	m.Send(a, "text", &alert.Options{
+		Attachments: []alert.Attachment{{Name: "rows.csv", ContentType: "text/csv", Data: []byte("a,b")}},
+	})
+
+	assert.Equal(t, 1, u.calls)
+
+	mes := native.Calls[len(native.Calls)-1].Arguments.Get(0).(*message.Message)
+	assert.Equal(t, "text", mes.Text)
+	require.Equal(t, 1, len(mes.Attachments))
+	assert.Equal(t, "rows.csv", mes.Attachments[0].Name)
+
+	for _, ch := range []*alertChannelMock{plain1, plain2} {
+		mes := ch.Calls[len(ch.Calls)-1].Arguments.Get(0).(*message.Message)
+		assert.Regexp(t, `^text\n\nrows\.csv: https://example\.com/s1/alert1-\d+-rows\.csv$`, mes.Text)
+		assert.Nil(t, mes.Attachments)
+	}
+}
+
+func TestManager_attachmentsAsLinks_errors(t *testing.T) {
+	mes := message.New("error", "alert1", "text", "", nil)
+	mes.Attachments = []alert.Attachment{{Name: "rows.csv"}}
+
+	m := New(nil, zap.NewNop())
+
+	res := m.attachmentsAsLinks(mes)
+	assert.Equal(t, "text", res.Text)
+	assert.Nil(t, res.Attachments)
+
+	m.SetUploader(&uploaderMock{err: fmt.Errorf("err1")})
+
+	res = m.attachmentsAsLinks(mes)
+	assert.Equal(t, "text", res.Text)
+	assert.Equal(t, "", mes.Attachments[0].URL)
+}
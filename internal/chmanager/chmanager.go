@@ -4,10 +4,22 @@ import (
 	"fmt"
 	"github.com/balerter/balerter/internal/channels/alertmanager"
 	alertmanagerreceiver "github.com/balerter/balerter/internal/channels/alertmanager_receiver"
+	"github.com/balerter/balerter/internal/channels/file"
+	"github.com/balerter/balerter/internal/channels/googlechat"
+	"github.com/balerter/balerter/internal/channels/gotify"
+	"github.com/balerter/balerter/internal/channels/kafka"
 	"github.com/balerter/balerter/internal/channels/log"
+	"github.com/balerter/balerter/internal/channels/matrix"
+	"github.com/balerter/balerter/internal/channels/nats"
+	"github.com/balerter/balerter/internal/channels/ntfy"
+	"github.com/balerter/balerter/internal/channels/pushover"
+	"github.com/balerter/balerter/internal/channels/ticket"
+	"github.com/balerter/balerter/internal/channels/twiliosms"
 	"github.com/balerter/balerter/internal/channels/twiliovoice"
 	"github.com/balerter/balerter/internal/channels/webhook"
 	"github.com/balerter/balerter/internal/config/channels"
+	"github.com/balerter/balerter/internal/config/common"
+	"github.com/balerter/balerter/internal/corestorage"
 
 	"github.com/balerter/balerter/internal/channels/discord"
 	"github.com/balerter/balerter/internal/channels/email"
@@ -17,6 +29,8 @@ import (
 	"github.com/balerter/balerter/internal/channels/telegram"
 	"github.com/balerter/balerter/internal/message"
 	"go.uber.org/zap"
+	"sync"
+	"time"
 )
 
 /*
@@ -34,16 +48,37 @@ type alertChannel interface {
 type ChannelsManager struct {
 	logger   *zap.Logger
 	channels map[string]alertChannel
+	// kv is used by channels with a state between messages
+	kv corestorage.KV
+	// fallbacks contains the fallback channels names by the channel name
+	fallbacks map[string][]string
+	// rateLimiters and quietHours contain the limits by the channel name
+	rateLimiters map[string]*rateLimiter
+	quietHours   map[string]*quietHours
+	now          func() time.Time
+
+	uploader           uploader
+	attachmentsStorage string
+
+	healthCheckInterval time.Duration
+	healthMu            sync.RWMutex
+	health              map[string]Health
 
 	errs chan error
 }
 
 // New returns new Alert manager instance
-func New(logger *zap.Logger) *ChannelsManager {
+func New(kv corestorage.KV, logger *zap.Logger) *ChannelsManager {
 	m := &ChannelsManager{
-		logger:   logger,
-		channels: make(map[string]alertChannel),
-		errs:     make(chan error),
+		logger:       logger,
+		kv:           kv,
+		channels:     make(map[string]alertChannel),
+		health:       make(map[string]Health),
+		fallbacks:    make(map[string][]string),
+		rateLimiters: make(map[string]*rateLimiter),
+		quietHours:   make(map[string]*quietHours),
+		now:          time.Now,
+		errs:         make(chan error),
 	}
 
 	go func() {
@@ -61,31 +96,44 @@ func (m *ChannelsManager) Init(cfg *channels.Channels, version string) error {
 		return nil
 	}
 
+	m.attachmentsStorage = cfg.AttachmentsStorage
+
+	m.healthCheckInterval = time.Millisecond * time.Duration(cfg.HealthCheckInterval)
+	if m.healthCheckInterval == 0 {
+		m.healthCheckInterval = defaultHealthCheckInterval
+	}
+
 	for idx := range cfg.Email {
-		module, err := email.New(cfg.Email[idx], m.logger)
+		module, err := email.New(cfg.Email[idx], m.kv, m.logger)
 		if err != nil {
 			return fmt.Errorf("error init email channel %s, %w", cfg.Email[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.Email[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.Slack {
-		module, err := slack.New(cfg.Slack[idx], m.logger)
+		module, err := slack.New(cfg.Slack[idx], m.kv, m.logger)
 		if err != nil {
 			return fmt.Errorf("error init slack channel %s, %w", cfg.Slack[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.Slack[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.Telegram {
-		module, err := telegram.New(cfg.Telegram[idx], m.logger)
+		module, err := telegram.New(cfg.Telegram[idx], m.kv, m.logger)
 		if err != nil {
 			return fmt.Errorf("error init telegram channel %s, %w", cfg.Telegram[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.Telegram[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.Syslog {
@@ -94,16 +142,20 @@ func (m *ChannelsManager) Init(cfg *channels.Channels, version string) error {
 			return fmt.Errorf("error init syslog channel %s, %w", cfg.Syslog[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.Syslog[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.Notify {
 		module, err := notify.New(cfg.Notify[idx], m.logger)
 		if err != nil {
-			return fmt.Errorf("error init syslog channel %s, %w", cfg.Notify[idx].Name, err)
+			return fmt.Errorf("error init notify channel %s, %w", cfg.Notify[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.Notify[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.Discord {
@@ -112,7 +164,9 @@ func (m *ChannelsManager) Init(cfg *channels.Channels, version string) error {
 			return fmt.Errorf("error init discord channel %s, %w", cfg.Discord[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.Discord[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.Webhook {
@@ -121,16 +175,20 @@ func (m *ChannelsManager) Init(cfg *channels.Channels, version string) error {
 			return fmt.Errorf("error init webhook channel %s, %w", cfg.Webhook[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.Webhook[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.Alertmanager {
-		module, err := alertmanager.New(cfg.Alertmanager[idx], version, m.logger)
+		module, err := alertmanager.New(cfg.Alertmanager[idx], m.kv, version, m.logger)
 		if err != nil {
 			return fmt.Errorf("error init alertmanager channel %s, %w", cfg.Alertmanager[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.Alertmanager[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.AlertmanagerReceiver {
@@ -139,16 +197,20 @@ func (m *ChannelsManager) Init(cfg *channels.Channels, version string) error {
 			return fmt.Errorf("error init alertmanager_receiver channel %s, %w", cfg.AlertmanagerReceiver[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.AlertmanagerReceiver[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.TwilioVoice {
 		module, err := twiliovoice.New(cfg.TwilioVoice[idx], m.logger)
 		if err != nil {
-			return fmt.Errorf("error init twilio channel %s, %w", cfg.AlertmanagerReceiver[idx].Name, err)
+			return fmt.Errorf("error init twilio channel %s, %w", cfg.TwilioVoice[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.TwilioVoice[idx].Delivery); err != nil {
+			return err
+		}
 	}
 
 	for idx := range cfg.Log {
@@ -157,7 +219,153 @@ func (m *ChannelsManager) Init(cfg *channels.Channels, version string) error {
 			return fmt.Errorf("error init log channel %s, %w", cfg.Log[idx].Name, err)
 		}
 
-		m.channels[module.Name()] = module
+		if err = m.addChannel(module, cfg.Log[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.GoogleChat {
+		module, err := googlechat.New(cfg.GoogleChat[idx], m.logger)
+		if err != nil {
+			return fmt.Errorf("error init googlechat channel %s, %w", cfg.GoogleChat[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.GoogleChat[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.Matrix {
+		module, err := matrix.New(cfg.Matrix[idx], m.logger)
+		if err != nil {
+			return fmt.Errorf("error init matrix channel %s, %w", cfg.Matrix[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.Matrix[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.TwilioSMS {
+		module, err := twiliosms.New(cfg.TwilioSMS[idx], m.logger)
+		if err != nil {
+			return fmt.Errorf("error init twiliosms channel %s, %w", cfg.TwilioSMS[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.TwilioSMS[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.Ntfy {
+		module, err := ntfy.New(cfg.Ntfy[idx], m.logger)
+		if err != nil {
+			return fmt.Errorf("error init ntfy channel %s, %w", cfg.Ntfy[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.Ntfy[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.Gotify {
+		module, err := gotify.New(cfg.Gotify[idx], m.logger)
+		if err != nil {
+			return fmt.Errorf("error init gotify channel %s, %w", cfg.Gotify[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.Gotify[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.Pushover {
+		module, err := pushover.New(cfg.Pushover[idx], m.logger)
+		if err != nil {
+			return fmt.Errorf("error init pushover channel %s, %w", cfg.Pushover[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.Pushover[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.Kafka {
+		module, err := kafka.New(cfg.Kafka[idx], m.logger)
+		if err != nil {
+			return fmt.Errorf("error init kafka channel %s, %w", cfg.Kafka[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.Kafka[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.Nats {
+		module, err := nats.New(cfg.Nats[idx], m.logger)
+		if err != nil {
+			return fmt.Errorf("error init nats channel %s, %w", cfg.Nats[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.Nats[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.GitHub {
+		module, err := ticket.NewGitHub(cfg.GitHub[idx], m.kv, m.logger)
+		if err != nil {
+			return fmt.Errorf("error init github channel %s, %w", cfg.GitHub[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.GitHub[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.GitLab {
+		module, err := ticket.NewGitLab(cfg.GitLab[idx], m.kv, m.logger)
+		if err != nil {
+			return fmt.Errorf("error init gitlab channel %s, %w", cfg.GitLab[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.GitLab[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.Jira {
+		module, err := ticket.NewJira(cfg.Jira[idx], m.kv, m.logger)
+		if err != nil {
+			return fmt.Errorf("error init jira channel %s, %w", cfg.Jira[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.Jira[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	for idx := range cfg.File {
+		module, err := file.New(cfg.File[idx], m.logger)
+		if err != nil {
+			return fmt.Errorf("error init file channel %s, %w", cfg.File[idx].Name, err)
+		}
+
+		if err = m.addChannel(module, cfg.File[idx].Delivery); err != nil {
+			return err
+		}
+	}
+
+	return m.checkFallbacks()
+}
+
+// addChannel adds the channel with its fallback channels, rate limit and quiet hours
+func (m *ChannelsManager) addChannel(module alertChannel, delivery common.Delivery) error {
+	m.channels[module.Name()] = module
+	m.fallbacks[module.Name()] = delivery.Fallback
+
+	if err := m.setLimits(module.Name(), delivery.RateLimit, delivery.QuietHours); err != nil {
+		return fmt.Errorf("error init limits of the channel %s, %w", module.Name(), err)
 	}
 
 	return nil
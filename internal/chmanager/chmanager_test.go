@@ -6,12 +6,24 @@ import (
 	"github.com/balerter/balerter/internal/config/channels/alertmanagerreceiver"
 	"github.com/balerter/balerter/internal/config/channels/discord"
 	"github.com/balerter/balerter/internal/config/channels/email"
+	"github.com/balerter/balerter/internal/config/channels/file"
+	"github.com/balerter/balerter/internal/config/channels/github"
+	"github.com/balerter/balerter/internal/config/channels/gitlab"
+	"github.com/balerter/balerter/internal/config/channels/googlechat"
+	"github.com/balerter/balerter/internal/config/channels/gotify"
+	"github.com/balerter/balerter/internal/config/channels/jira"
+	"github.com/balerter/balerter/internal/config/channels/kafka"
+	"github.com/balerter/balerter/internal/config/channels/nats"
 	"github.com/balerter/balerter/internal/config/channels/notify"
+	"github.com/balerter/balerter/internal/config/channels/ntfy"
+	"github.com/balerter/balerter/internal/config/channels/pushover"
 	"github.com/balerter/balerter/internal/config/channels/slack"
 	"github.com/balerter/balerter/internal/config/channels/syslog"
 	"github.com/balerter/balerter/internal/config/channels/telegram"
+	"github.com/balerter/balerter/internal/config/channels/twiliosms"
 	"github.com/balerter/balerter/internal/config/channels/twiliovoice"
 	"github.com/balerter/balerter/internal/config/channels/webhook"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +32,7 @@ import (
 )
 
 func TestManager_Init(t *testing.T) {
-	m := New(zap.NewNop())
+	m := New(nil, zap.NewNop())
 
 	cfg := &channels.Channels{
 		Email:                []email.Email{{Name: "email1"}},
@@ -33,11 +45,22 @@ func TestManager_Init(t *testing.T) {
 		Alertmanager:         []alertmanager.Alertmanager{{Name: "am1"}},
 		AlertmanagerReceiver: []alertmanagerreceiver.AlertmanagerReceiver{{Name: "amr1"}},
 		TwilioVoice:          []twiliovoice.Twilio{{Name: "tw1"}},
+		GoogleChat:           []googlechat.GoogleChat{{Name: "gc1"}},
+		TwilioSMS:            []twiliosms.TwilioSMS{{Name: "tws1"}},
+		Ntfy:                 []ntfy.Ntfy{{Name: "ntfy1"}},
+		Gotify:               []gotify.Gotify{{Name: "gotify1"}},
+		Pushover:             []pushover.Pushover{{Name: "po1"}},
+		Kafka:                []kafka.Kafka{{Name: "kf1"}},
+		Nats:                 []nats.Nats{{Name: "nt1", URL: "nats://127.0.0.1:4222"}},
+		GitHub:               []github.GitHub{{Name: "gh1"}},
+		GitLab:               []gitlab.GitLab{{Name: "gl1"}},
+		Jira:                 []jira.Jira{{Name: "jira1"}},
+		File:                 []file.File{{Name: "file1", Path: filepath.Join(t.TempDir(), "alerts.jsonl")}},
 	}
 
 	err := m.Init(cfg, "")
 	require.NoError(t, err)
-	require.Equal(t, 10, len(m.channels))
+	require.Equal(t, 21, len(m.channels))
 
 	c, ok := m.channels["email1"]
 	require.True(t, ok)
@@ -78,4 +101,28 @@ func TestManager_Init(t *testing.T) {
 	c, ok = m.channels["tw1"]
 	require.True(t, ok)
 	assert.Equal(t, "tw1", c.Name())
+
+	c, ok = m.channels["gc1"]
+	require.True(t, ok)
+	assert.Equal(t, "gc1", c.Name())
+
+	c, ok = m.channels["tws1"]
+	require.True(t, ok)
+	assert.Equal(t, "tws1", c.Name())
+
+	c, ok = m.channels["ntfy1"]
+	require.True(t, ok)
+	assert.Equal(t, "ntfy1", c.Name())
+
+	c, ok = m.channels["gotify1"]
+	require.True(t, ok)
+	assert.Equal(t, "gotify1", c.Name())
+
+	c, ok = m.channels["po1"]
+	require.True(t, ok)
+	assert.Equal(t, "po1", c.Name())
+
+	c, ok = m.channels["file1"]
+	require.True(t, ok)
+	assert.Equal(t, "file1", c.Name())
 }
@@ -0,0 +1,82 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/balerter/balerter/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// checkFallbacks returns an error if the fallback channel is not configured or it is the channel itself
+func (m *ChannelsManager) checkFallbacks() error {
+	for name, fallbacks := range m.fallbacks {
+		for _, fallback := range fallbacks {
+			if fallback == name {
+				return fmt.Errorf("channel %s uses itself as a fallback", name)
+			}
+			if _, ok := m.channels[fallback]; !ok {
+				return fmt.Errorf("fallback channel %s for the channel %s not found", fallback, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// sendWithFallback sends the message to the channel. If it fails, the message is sent to the fallback channels in order
+// until one of them succeeds. The manager does not retry the send, the fallback is used on the first failure. The chain stops at the fallback channel from the targets, it receives the message directly.
+// The fallbacks of the fallback channels are not used. The fallback channel with the quiet hours or the exhausted
+// rate limit is skipped. The channels may change the message they send
+// (e.g. telegram adds the fields to the text), so every channel gets a copy of the original message
+func (m *ChannelsManager) sendWithFallback(name string, ch alertChannel, mes *message.Message, level alert.Level,
+	targets map[string]alertChannel) {
+	primaryMes := *mes
+
+	err := m.sendToChannel(ch, &primaryMes)
+	if err == nil {
+		return
+	}
+
+	m.logger.Error("error send the message to the channel", zap.String("channel name", name), zap.Error(err))
+
+	fallbacks := m.fallbacks[name]
+	if len(fallbacks) == 0 {
+		return
+	}
+
+	for _, fallbackName := range fallbacks {
+		if _, ok := targets[fallbackName]; ok {
+			m.logger.Debug("the fallback channel receives the message directly", zap.String("channel name", name),
+				zap.String("fallback channel name", fallbackName))
+			return
+		}
+
+		fallback, ok := m.channels[fallbackName]
+		if !ok {
+			continue
+		}
+
+		if m.limited(fallbackName, level) {
+			continue
+		}
+
+		fallbackMes := *mes
+
+		if err := m.sendToChannel(fallback, &fallbackMes); err != nil {
+			m.logger.Error("error send the message to the fallback channel", zap.String("channel name", name),
+				zap.String("fallback channel name", fallbackName), zap.Error(err))
+			continue
+		}
+
+		m.logger.Warn("the message was delivered by the fallback channel", zap.String("channel name", name),
+			zap.String("fallback channel name", fallbackName), zap.String("alert name", mes.AlertName))
+		metrics.IncChannelFallback(name, fallbackName)
+
+		return
+	}
+
+	m.logger.Error("the message was not delivered by the channel and the fallback channels",
+		zap.String("channel name", name), zap.String("alert name", mes.AlertName))
+}
@@ -0,0 +1,189 @@
+package manager
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/config/channels"
+	"github.com/balerter/balerter/internal/config/channels/log"
+	"github.com/balerter/balerter/internal/config/common"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newChannelMock(name string, err error) *alertChannelMock {
+	ch := &alertChannelMock{}
+	ch.On("Name").Return(name)
+	ch.On("Ignore").Return(false)
+	ch.On("Send", mock.Anything).Return(err)
+	return ch
+}
+
+func TestManager_checkFallbacks(t *testing.T) {
+	m := &ChannelsManager{
+		channels: map[string]alertChannel{
+			"chan1": &alertChannelMock{},
+			"chan2": &alertChannelMock{},
+		},
+		fallbacks: map[string][]string{"chan1": {"chan2"}},
+	}
+	require.NoError(t, m.checkFallbacks())
+
+	m.fallbacks["chan2"] = []string{"chan3"}
+	err := m.checkFallbacks()
+	require.Error(t, err)
+	assert.Equal(t, "fallback channel chan3 for the channel chan2 not found", err.Error())
+
+	m.fallbacks["chan2"] = []string{"chan2"}
+	err = m.checkFallbacks()
+	require.Error(t, err)
+	assert.Equal(t, "channel chan2 uses itself as a fallback", err.Error())
+}
+
+func TestManager_Init_fallbacks(t *testing.T) {
+	m := New(nil, zap.NewNop())
+
+	err := m.Init(&channels.Channels{
+		Log: []log.Log{{Name: "log1", Delivery: common.Delivery{Fallback: []string{"log2"}}}, {Name: "log2"}},
+	}, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"log2"}, m.fallbacks["log1"])
+
+	m = New(nil, zap.NewNop())
+
+	err = m.Init(&channels.Channels{
+		Log: []log.Log{{Name: "log1", Delivery: common.Delivery{Fallback: []string{"log3"}}}},
+	}, "")
+	require.Error(t, err)
+	assert.Equal(t, "fallback channel log3 for the channel log1 not found", err.Error())
+}
+
+func TestManager_Send_fallback(t *testing.T) {
+	primary := newChannelMock("primary", fmt.Errorf("err1"))
+	fallback1 := newChannelMock("fallback1", fmt.Errorf("err2"))
+	fallback2 := newChannelMock("fallback2", nil)
+	fallback3 := newChannelMock("fallback3", nil)
+
+	m := &ChannelsManager{
+		logger: zap.NewNop(),
+		channels: map[string]alertChannel{
+			"primary":   primary,
+			"fallback1": fallback1,
+			"fallback2": fallback2,
+			"fallback3": fallback3,
+		},
+		fallbacks: map[string][]string{"primary": {"fallback1", "fallback2", "fallback3"}},
+	}
+
+	m.Send(alert.New("foo"), "text", &alert.Options{Channels: []string{"primary"}})
+
+	primary.AssertNumberOfCalls(t, "Send", 1)
+	fallback1.AssertNumberOfCalls(t, "Send", 1)
+	fallback2.AssertNumberOfCalls(t, "Send", 1)
+	fallback3.AssertNumberOfCalls(t, "Send", 0)
+}
+
+func TestManager_Send_fallback_target(t *testing.T) {
+	primary := newChannelMock("primary", fmt.Errorf("err1"))
+	fallback1 := newChannelMock("fallback1", nil)
+	fallback2 := newChannelMock("fallback2", nil)
+
+	m := &ChannelsManager{
+		logger: zap.NewNop(),
+		channels: map[string]alertChannel{
+			"primary":   primary,
+			"fallback1": fallback1,
+			"fallback2": fallback2,
+		},
+		fallbacks: map[string][]string{"primary": {"fallback1", "fallback2"}},
+	}
+
+	m.Send(alert.New("foo"), "text", &alert.Options{Channels: []string{"primary", "fallback1"}})
+
+	primary.AssertNumberOfCalls(t, "Send", 1)
+	fallback1.AssertNumberOfCalls(t, "Send", 1)
+	fallback2.AssertNumberOfCalls(t, "Send", 0)
+}
+
+func TestManager_Send_no_fallback_on_success(t *testing.T) {
+	primary := newChannelMock("primary", nil)
+	fallback1 := newChannelMock("fallback1", nil)
+
+	m := &ChannelsManager{
+		logger: zap.NewNop(),
+		channels: map[string]alertChannel{
+			"primary":   primary,
+			"fallback1": fallback1,
+		},
+		fallbacks: map[string][]string{"primary": {"fallback1"}},
+	}
+
+	m.Send(alert.New("foo"), "text", &alert.Options{Channels: []string{"primary"}})
+
+	primary.AssertNumberOfCalls(t, "Send", 1)
+	fallback1.AssertNumberOfCalls(t, "Send", 0)
+}
+
+func TestManager_Send_fallback_original_message(t *testing.T) {
+	primary := &alertChannelMock{}
+	primary.On("Name").Return("primary")
+	primary.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		mes := args.Get(0).(*message.Message)
+		mes.Text += "\nfoo: bar"
+	}).Return(fmt.Errorf("err1"))
+
+	fallback1 := newChannelMock("fallback1", nil)
+
+	m := &ChannelsManager{
+		logger: zap.NewNop(),
+		channels: map[string]alertChannel{
+			"primary":   primary,
+			"fallback1": fallback1,
+		},
+		fallbacks: map[string][]string{"primary": {"fallback1"}},
+	}
+
+	m.Send(alert.New("foo"), "text", &alert.Options{
+		Channels: []string{"primary"},
+		Fields:   map[string]string{"foo": "bar"},
+	})
+
+	primary.AssertNumberOfCalls(t, "Send", 1)
+	fallback1.AssertNumberOfCalls(t, "Send", 1)
+
+	mes := fallback1.Calls[len(fallback1.Calls)-1].Arguments.Get(0).(*message.Message)
+	assert.Equal(t, "text", mes.Text)
+	assert.Equal(t, map[string]string{"foo": "bar"}, mes.Fields)
+}
+
+func TestManager_Send_fallback_limited(t *testing.T) {
+	primary := newChannelMock("primary", fmt.Errorf("err1"))
+	quiet := newChannelMock("quiet", nil)
+	limited := newChannelMock("limited", nil)
+	fallback := newChannelMock("fallback", nil)
+
+	m := New(nil, zap.NewNop())
+	m.channels["primary"] = primary
+	m.channels["quiet"] = quiet
+	m.channels["limited"] = limited
+	m.channels["fallback"] = fallback
+	m.fallbacks["primary"] = []string{"quiet", "limited", "fallback"}
+	m.now = func() time.Time { return time.Date(2022, 1, 1, 23, 0, 0, 0, time.UTC) }
+	require.NoError(t, m.setLimits("quiet", nil, &common.QuietHours{Ranges: []string{"22:00-07:00"}}))
+	require.NoError(t, m.setLimits("limited", &common.RateLimit{Messages: 1, Interval: 60000}, nil))
+
+	a := alert.New("foo")
+	a.Level = alert.LevelWarn
+	m.Send(a, "text", &alert.Options{Channels: []string{"primary"}})
+	m.Send(a, "text", &alert.Options{Channels: []string{"primary"}})
+
+	primary.AssertNumberOfCalls(t, "Send", 2)
+	quiet.AssertNumberOfCalls(t, "Send", 0)
+	limited.AssertNumberOfCalls(t, "Send", 1)
+	fallback.AssertNumberOfCalls(t, "Send", 1)
+}
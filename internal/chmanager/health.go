@@ -0,0 +1,100 @@
+package manager
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/balerter/balerter/internal/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultHealthCheckInterval = time.Minute * 5
+	healthCheckTimeout         = time.Second * 30
+)
+
+// healthChecker is a channel with a health check, e.g. the token validation
+type healthChecker interface {
+	Check(ctx context.Context) error
+}
+
+// Health is the result of the channel health check
+type Health struct {
+	Healthy   bool      `json:"healthy"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// HealthCheck checks the channels at the start and every health check interval, until the ctx is done
+func (m *ChannelsManager) HealthCheck(ctx context.Context, wg *sync.WaitGroup) {
+	interval := m.healthCheckInterval
+	if interval == 0 {
+		interval = defaultHealthCheckInterval
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		m.checkHealth(ctx)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.checkHealth(ctx)
+			}
+		}
+	}()
+}
+
+// checkHealth runs the health checks of the channels and saves the results
+func (m *ChannelsManager) checkHealth(ctx context.Context) {
+	for name, ch := range m.channels {
+		c, ok := ch.(healthChecker)
+		if !ok {
+			continue
+		}
+
+		checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		err := c.Check(checkCtx)
+		cancel()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		h := Health{
+			Healthy:   err == nil,
+			CheckedAt: time.Now(),
+		}
+		if err != nil {
+			h.Error = err.Error()
+			m.logger.Warn("channel health check failed", zap.String("channel name", name), zap.Error(err))
+		}
+
+		m.healthMu.Lock()
+		m.health[name] = h
+		m.healthMu.Unlock()
+
+		metrics.SetChannelHealth(name, h.Healthy)
+	}
+}
+
+// Health returns the last health check results of the channels
+func (m *ChannelsManager) Health() map[string]Health {
+	m.healthMu.RLock()
+	defer m.healthMu.RUnlock()
+
+	res := make(map[string]Health, len(m.health))
+	for name, h := range m.health {
+		res[name] = h
+	}
+
+	return res
+}
@@ -0,0 +1,69 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type checkerMock struct {
+	alertChannelMock
+	err error
+}
+
+func (c *checkerMock) Check(_ context.Context) error {
+	return c.err
+}
+
+func TestManager_checkHealth(t *testing.T) {
+	m := &ChannelsManager{
+		logger: zap.NewNop(),
+		health: map[string]Health{},
+		channels: map[string]alertChannel{
+			"chan1": &checkerMock{},
+			"chan2": &checkerMock{err: fmt.Errorf("err1")},
+			"chan3": &alertChannelMock{},
+		},
+	}
+
+	m.checkHealth(context.Background())
+
+	h := m.Health()
+	require.Len(t, h, 2)
+
+	assert.True(t, h["chan1"].Healthy)
+	assert.Equal(t, "", h["chan1"].Error)
+	assert.False(t, h["chan1"].CheckedAt.IsZero())
+
+	assert.False(t, h["chan2"].Healthy)
+	assert.Equal(t, "err1", h["chan2"].Error)
+}
+
+func TestManager_HealthCheck(t *testing.T) {
+	m := &ChannelsManager{
+		logger:              zap.NewNop(),
+		health:              map[string]Health{},
+		healthCheckInterval: time.Hour,
+		channels: map[string]alertChannel{
+			"chan1": &checkerMock{},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+
+	m.HealthCheck(ctx, wg)
+
+	require.Eventually(t, func() bool {
+		return len(m.Health()) == 1
+	}, time.Second, time.Millisecond*10)
+
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,218 @@
+package manager
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/config/common"
+	"github.com/balerter/balerter/internal/message"
+	"go.uber.org/zap"
+)
+
+const rateLimitAlertName = "balerter_rate_limit"
+
+// rateLimiter is a token bucket. The bucket contains up to capacity tokens and refills by capacity tokens per interval
+type rateLimiter struct {
+	mu         sync.Mutex
+	capacity   float64
+	tokens     float64
+	perNano    float64
+	last       time.Time
+	suppressed int
+	now        func() time.Time
+}
+
+func newRateLimiter(cfg *common.RateLimit, now func() time.Time) *rateLimiter {
+	return &rateLimiter{
+		capacity: float64(cfg.Messages),
+		tokens:   float64(cfg.Messages),
+		perNano:  float64(cfg.Messages) / float64(time.Duration(cfg.Interval)*time.Millisecond),
+		last:     now(),
+		now:      now,
+	}
+}
+
+func (rl *rateLimiter) refill() {
+	now := rl.now()
+	rl.tokens += float64(now.Sub(rl.last)) * rl.perNano
+	if rl.tokens > rl.capacity {
+		rl.tokens = rl.capacity
+	}
+	rl.last = now
+}
+
+// allow takes a token from the bucket. If the bucket is empty, the message is counted as suppressed.
+// For the first suppressed message it returns the duration until the next token is available
+func (rl *rateLimiter) allow() (ok bool, wait time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.refill()
+
+	if rl.tokens >= 1 {
+		rl.tokens--
+		return true, 0
+	}
+
+	rl.suppressed++
+	if rl.suppressed > 1 {
+		return false, 0
+	}
+
+	return false, time.Duration((1-rl.tokens)/rl.perNano) + 1
+}
+
+// takeSuppressed returns the count of the suppressed messages and resets it.
+// The summary message takes a token from the bucket
+func (rl *rateLimiter) takeSuppressed() int {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.refill()
+	if rl.tokens >= 1 {
+		rl.tokens--
+	}
+
+	n := rl.suppressed
+	rl.suppressed = 0
+	return n
+}
+
+type timeRange struct {
+	start int
+	end   int
+}
+
+// contains returns true if the minutes from the midnight are in the range. The range can wrap the midnight
+func (r timeRange) contains(minutes int) bool {
+	if r.start <= r.end {
+		return minutes >= r.start && minutes < r.end
+	}
+	return minutes >= r.start || minutes < r.end
+}
+
+// quietHours suppresses the messages with a level below the min level in the time ranges
+type quietHours struct {
+	ranges   []timeRange
+	location *time.Location
+	minLevel alert.Level
+}
+
+func newQuietHours(cfg *common.QuietHours) (*quietHours, error) {
+	q := &quietHours{
+		minLevel: alert.LevelError,
+	}
+
+	for _, s := range cfg.Ranges {
+		start, end, err := common.ParseTimeRange(s)
+		if err != nil {
+			return nil, err
+		}
+		q.ranges = append(q.ranges, timeRange{start: start, end: end})
+	}
+
+	var err error
+	q.location, err = time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("error load timezone, %w", err)
+	}
+
+	if cfg.MinLevel != "" {
+		q.minLevel, err = alert.LevelFromString(cfg.MinLevel)
+		if err != nil {
+			return nil, fmt.Errorf("error parse minLevel, %w", err)
+		}
+	}
+
+	return q, nil
+}
+
+// suppress returns true if the message with the level should not be sent at the time
+func (q *quietHours) suppress(level alert.Level, t time.Time) bool {
+	if level >= q.minLevel {
+		return false
+	}
+
+	t = t.In(q.location)
+	minutes := t.Hour()*60 + t.Minute() //nolint:gomnd // minutes in the hour
+
+	for _, r := range q.ranges {
+		if r.contains(minutes) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setLimits sets the rate limit and the quiet hours of the channel, if they are configured
+func (m *ChannelsManager) setLimits(name string, rateLimit *common.RateLimit, quiet *common.QuietHours) error {
+	if rateLimit != nil {
+		m.rateLimiters[name] = newRateLimiter(rateLimit, m.now)
+	}
+
+	if quiet != nil {
+		q, err := newQuietHours(quiet)
+		if err != nil {
+			return fmt.Errorf("error init quiet hours, %w", err)
+		}
+		m.quietHours[name] = q
+	}
+
+	return nil
+}
+
+// limited returns true if the message should not be sent to the channel because of the quiet hours or the rate limit
+func (m *ChannelsManager) limited(name string, level alert.Level) bool {
+	if q, ok := m.quietHours[name]; ok && q.suppress(level, m.now()) {
+		m.logger.Debug("the message was not sent, quiet hours", zap.String("channel name", name))
+		return true
+	}
+
+	rl, ok := m.rateLimiters[name]
+	if !ok {
+		return false
+	}
+
+	allowed, wait := rl.allow()
+	if allowed {
+		return false
+	}
+
+	m.logger.Debug("the message was not sent, rate limit", zap.String("channel name", name))
+
+	if wait > 0 {
+		time.AfterFunc(wait, func() {
+			m.sendSuppressed(name)
+		})
+	}
+
+	return true
+}
+
+// sendSuppressed sends the message with the count of the messages suppressed by the rate limit of the channel
+func (m *ChannelsManager) sendSuppressed(name string) {
+	ch, ok := m.channels[name]
+	if !ok {
+		return
+	}
+
+	rl, ok := m.rateLimiters[name]
+	if !ok {
+		return
+	}
+
+	n := rl.takeSuppressed()
+	if n == 0 {
+		return
+	}
+
+	text := fmt.Sprintf("%d messages suppressed by the rate limit of the channel %s", n, name)
+	mes := message.New(alert.LevelWarn.String(), rateLimitAlertName, text, "", nil)
+
+	if err := m.sendToChannel(ch, mes); err != nil {
+		m.logger.Error("error send the suppressed messages summary", zap.String("channel name", name), zap.Error(err))
+	}
+}
@@ -0,0 +1,148 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/config/channels"
+	"github.com/balerter/balerter/internal/config/channels/log"
+	"github.com/balerter/balerter/internal/config/common"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRateLimiter_allow(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	rl := newRateLimiter(&common.RateLimit{Messages: 2, Interval: 1000}, func() time.Time { return now })
+
+	ok, _ := rl.allow()
+	assert.True(t, ok)
+	ok, _ = rl.allow()
+	assert.True(t, ok)
+
+	ok, wait := rl.allow()
+	assert.False(t, ok)
+	assert.InDelta(t, float64(time.Millisecond*500), float64(wait), float64(time.Millisecond))
+
+	ok, wait = rl.allow()
+	assert.False(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	now = now.Add(time.Millisecond * 500)
+	assert.Equal(t, 2, rl.takeSuppressed())
+	assert.Equal(t, 0, rl.takeSuppressed())
+
+	ok, _ = rl.allow()
+	assert.False(t, ok)
+
+	now = now.Add(time.Hour)
+	ok, _ = rl.allow()
+	assert.True(t, ok)
+	ok, _ = rl.allow()
+	assert.True(t, ok)
+}
+
+func TestQuietHours_suppress(t *testing.T) {
+	q, err := newQuietHours(&common.QuietHours{Ranges: []string{"22:00-07:00", "12:00-13:00"}, Timezone: "Europe/Berlin"})
+	require.NoError(t, err)
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	at := func(h, m int) time.Time {
+		return time.Date(2022, 6, 1, h, m, 0, 0, loc)
+	}
+
+	assert.True(t, q.suppress(alert.LevelWarn, at(23, 0)))
+	assert.True(t, q.suppress(alert.LevelSuccess, at(6, 59)))
+	assert.True(t, q.suppress(alert.LevelWarn, at(12, 30)))
+	assert.False(t, q.suppress(alert.LevelWarn, at(7, 0)))
+	assert.False(t, q.suppress(alert.LevelWarn, at(13, 0)))
+	assert.False(t, q.suppress(alert.LevelError, at(23, 0)))
+	assert.True(t, q.suppress(alert.LevelWarn, at(20, 30).UTC().Add(time.Hour*2)))
+
+	q, err = newQuietHours(&common.QuietHours{Ranges: []string{"00:00-23:59"}, MinLevel: "warning"})
+	require.NoError(t, err)
+	assert.False(t, q.suppress(alert.LevelWarn, at(1, 0)))
+	assert.True(t, q.suppress(alert.LevelSuccess, at(1, 0)))
+}
+
+func TestManager_Send_quietHours(t *testing.T) {
+	ch := newChannelMock("chan1", nil)
+
+	m := New(nil, zap.NewNop())
+	m.channels["chan1"] = ch
+	m.now = func() time.Time { return time.Date(2022, 1, 1, 23, 0, 0, 0, time.UTC) }
+	require.NoError(t, m.setLimits("chan1", nil, &common.QuietHours{Ranges: []string{"22:00-07:00"}}))
+
+	a := alert.New("alert1")
+	a.Level = alert.LevelWarn
+	m.Send(a, "text", &alert.Options{})
+	ch.AssertNotCalled(t, "Send", mock.Anything)
+
+	a.Level = alert.LevelError
+	m.Send(a, "text", &alert.Options{})
+	ch.AssertNumberOfCalls(t, "Send", 1)
+}
+
+func TestManager_Send_rateLimit(t *testing.T) {
+	sent := make(chan *message.Message, 10)
+
+	ch := &alertChannelMock{}
+	ch.On("Name").Return("chan1")
+	ch.On("Ignore").Return(false)
+	ch.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		sent <- args.Get(0).(*message.Message)
+	}).Return(nil)
+
+	m := New(nil, zap.NewNop())
+	m.channels["chan1"] = ch
+	require.NoError(t, m.setLimits("chan1", &common.RateLimit{Messages: 1, Interval: 50}, nil))
+
+	a := alert.New("alert1")
+	a.Level = alert.LevelError
+	for i := 0; i < 3; i++ {
+		m.Send(a, "text", &alert.Options{})
+	}
+
+	mes := <-sent
+	assert.Equal(t, "text", mes.Text)
+
+	select {
+	case mes = <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("the summary message was not sent")
+	}
+	assert.Equal(t, "2 messages suppressed by the rate limit of the channel chan1", mes.Text)
+	assert.Equal(t, "warning", mes.Level)
+	assert.Equal(t, rateLimitAlertName, mes.AlertName)
+}
+
+func TestManager_Init_limits(t *testing.T) {
+	m := New(nil, zap.NewNop())
+
+	err := m.Init(&channels.Channels{
+		Log: []log.Log{{
+			Name: "log1",
+			Delivery: common.Delivery{
+				RateLimit:  &common.RateLimit{Messages: 10, Interval: 1000},
+				QuietHours: &common.QuietHours{Ranges: []string{"22:00-07:00"}},
+			},
+		}, {Name: "log2"}},
+	}, "")
+	require.NoError(t, err)
+	assert.Contains(t, m.rateLimiters, "log1")
+	assert.Contains(t, m.quietHours, "log1")
+	assert.NotContains(t, m.rateLimiters, "log2")
+
+	m = New(nil, zap.NewNop())
+	err = m.Init(&channels.Channels{
+		Log: []log.Log{{Name: "log1", Delivery: common.Delivery{QuietHours: &common.QuietHours{Ranges: []string{"bad"}}}}},
+	}, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error init limits of the channel log1")
+}
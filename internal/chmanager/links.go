@@ -0,0 +1,32 @@
+package manager
+
+import (
+	"strings"
+
+	"github.com/balerter/balerter/internal/message"
+)
+
+// linksRenderer is implemented by the channels which render the links natively, e.g. as the buttons
+type linksRenderer interface {
+	SupportsLinks() bool
+}
+
+func supportsLinks(ch alertChannel) bool {
+	r, ok := ch.(linksRenderer)
+	return ok && r.SupportsLinks()
+}
+
+// linksAsText returns the copy of the message with the links added to the text as the plain URLs
+func linksAsText(mes *message.Message) *message.Message {
+	result := *mes
+	result.Links = nil
+
+	lines := make([]string, 0, len(mes.Links))
+	for _, l := range mes.Links {
+		lines = append(lines, l.Label+": "+l.URL)
+	}
+
+	result.Text += "\n\n" + strings.Join(lines, "\n")
+
+	return &result
+}
@@ -0,0 +1,44 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type linksChannelMock struct {
+	*alertChannelMock
+}
+
+func (ch *linksChannelMock) SupportsLinks() bool {
+	return true
+}
+
+func TestManager_Send_links(t *testing.T) {
+	m := New(nil, zap.NewNop())
+
+	plain := newChannelMock("plain", nil)
+	native := &linksChannelMock{alertChannelMock: newChannelMock("native", nil)}
+	m.channels["plain"] = plain
+	m.channels["native"] = native
+
+	links := []alert.Link{
+		{Label: "Runbook", URL: "https://wiki.example.com/rb"},
+		{Label: "Dashboard", URL: "https://grafana.example.com/d/1"},
+	}
+
+	a := alert.New("alert1")
+	a.Level = alert.LevelError
+	m.Send(a, "text", &alert.Options{Links: links})
+
+	mes := native.Calls[len(native.Calls)-1].Arguments.Get(0).(*message.Message)
+	assert.Equal(t, "text", mes.Text)
+	assert.Equal(t, links, mes.Links)
+
+	mes = plain.Calls[len(plain.Calls)-1].Arguments.Get(0).(*message.Message)
+	assert.Equal(t, "text\n\nRunbook: https://wiki.example.com/rb\nDashboard: https://grafana.example.com/d/1", mes.Text)
+	assert.Nil(t, mes.Links)
+}
@@ -0,0 +1,58 @@
+package manager
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/balerter/balerter/internal/chmanager/action"
+)
+
+// listener is a channel receiving the user actions, e.g. telegram inline buttons
+type listener interface {
+	// Listening returns true if the channel receives the user actions, e.g. telegram with the inline buttons
+	Listening() bool
+	Listen(ctx context.Context, actions action.Executor)
+}
+
+// Runner runs the script by name
+type Runner interface {
+	RunScript(name string, req *http.Request) error
+}
+
+// actions executes the channels actions with the channels manager and the runner
+type actions struct {
+	*ChannelsManager
+	Runner
+}
+
+// Listen starts the channels listeners, they are stopped when the ctx is done
+func (m *ChannelsManager) Listen(ctx context.Context, wg *sync.WaitGroup, runner Runner) {
+	a := &actions{
+		ChannelsManager: m,
+		Runner:          runner,
+	}
+
+	for _, ch := range m.channels {
+		l, ok := ch.(listener)
+		if !ok || !l.Listening() {
+			continue
+		}
+
+		wg.Add(1)
+		go func(l listener) {
+			defer wg.Done()
+			l.Listen(ctx, a)
+		}(l)
+	}
+}
+
+// hasListeners returns true if any channel receives the user actions. Only the actions mute the alerts
+func (m *ChannelsManager) hasListeners() bool {
+	for _, ch := range m.channels {
+		if l, ok := ch.(listener); ok && l.Listening() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package manager
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/balerter/balerter/internal/chmanager/action"
+	"github.com/stretchr/testify/assert"
+)
+
+type listenerMock struct {
+	alertChannelMock
+	actions  action.Executor
+	disabled bool
+}
+
+func (l *listenerMock) Listening() bool {
+	return !l.disabled
+}
+
+func (l *listenerMock) Listen(ctx context.Context, actions action.Executor) {
+	l.actions = actions
+	<-ctx.Done()
+}
+
+type runnerMock struct {
+	scripts []string
+}
+
+func (r *runnerMock) RunScript(name string, _ *http.Request) error {
+	r.scripts = append(r.scripts, name)
+	return nil
+}
+
+func TestManager_Listen(t *testing.T) {
+	l := &listenerMock{}
+	disabled := &listenerMock{disabled: true}
+	rnr := &runnerMock{}
+
+	m := &ChannelsManager{
+		channels: map[string]alertChannel{
+			"chan1": l,
+			"chan2": &alertChannelMock{},
+			"chan3": disabled,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+
+	m.Listen(ctx, wg, rnr)
+	cancel()
+	wg.Wait()
+
+	if assert.NotNil(t, l.actions) {
+		assert.NoError(t, l.actions.RunScript("s1", nil))
+		assert.Equal(t, []string{"s1"}, rnr.scripts)
+	}
+	assert.Nil(t, disabled.actions)
+}
+
+func TestManager_hasListeners(t *testing.T) {
+	m := &ChannelsManager{
+		channels: map[string]alertChannel{
+			"chan1": &alertChannelMock{},
+			"chan2": &listenerMock{disabled: true},
+		},
+	}
+	assert.False(t, m.hasListeners())
+
+	m.channels["chan3"] = &listenerMock{}
+	assert.True(t, m.hasListeners())
+}
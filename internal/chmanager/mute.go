@@ -0,0 +1,94 @@
+package manager
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/corestorage"
+	"go.uber.org/zap"
+)
+
+/*
+An alert can be muted from the channels (e.g. telegram inline buttons):
+- acknowledged alert is not sent again until the level changes
+- silenced alert is not sent until the silence expires
+Success messages are always sent and clear the acknowledgement
+*/
+
+func ackKey(alertName string) string {
+	return "chmanager:ack:" + alertName
+}
+
+func silenceKey(alertName string) string {
+	return "chmanager:silence:" + alertName
+}
+
+// Ack acknowledges the alert at the current level
+func (m *ChannelsManager) Ack(alertName, level string) error {
+	if m.kv == nil {
+		return fmt.Errorf("core storage is not available")
+	}
+	if err := m.kv.Upsert(ackKey(alertName), level); err != nil {
+		return fmt.Errorf("error save ack, %w", err)
+	}
+	return nil
+}
+
+// Silence mutes the alert for the duration
+func (m *ChannelsManager) Silence(alertName string, d time.Duration) error {
+	if m.kv == nil {
+		return fmt.Errorf("core storage is not available")
+	}
+	until := strconv.FormatInt(time.Now().Add(d).Unix(), 10)
+	if err := m.kv.Upsert(silenceKey(alertName), until); err != nil {
+		return fmt.Errorf("error save silence, %w", err)
+	}
+	return nil
+}
+
+// muted returns true if the alert message should not be sent. The storage is not checked
+// if no channel receives the user actions
+func (m *ChannelsManager) muted(a *alert.Alert) bool {
+	if m.kv == nil || !m.hasListeners() {
+		return false
+	}
+
+	ackLevel, ok, err := corestorage.Lookup(m.kv, ackKey(a.Name))
+	if err != nil {
+		m.logger.Error("error get ack", zap.String("alert name", a.Name), zap.Error(err))
+		return false
+	}
+
+	if ok {
+		if ackLevel == a.Level.String() {
+			return true
+		}
+		if err := m.kv.Delete(ackKey(a.Name)); err != nil {
+			m.logger.Error("error delete ack", zap.String("alert name", a.Name), zap.Error(err))
+		}
+	}
+
+	if a.Level == alert.LevelSuccess {
+		return false
+	}
+
+	v, ok, err := corestorage.Lookup(m.kv, silenceKey(a.Name))
+	if err != nil {
+		m.logger.Error("error get silence", zap.String("alert name", a.Name), zap.Error(err))
+		return false
+	}
+
+	if ok {
+		until, err := strconv.ParseInt(v, 10, 64)
+		if err == nil && time.Now().Unix() < until {
+			return true
+		}
+		if err := m.kv.Delete(silenceKey(a.Name)); err != nil {
+			m.logger.Error("error delete silence", zap.String("alert name", a.Name), zap.Error(err))
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,146 @@
+package manager
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/corestorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newKV returns KV mock backed by the map
+func newKV(m map[string]string) *corestorage.KVMock {
+	return &corestorage.KVMock{
+		GetFunc: func(k string) (string, error) {
+			v, ok := m[k]
+			if !ok {
+				return "", corestorage.ErrKVNotFound
+			}
+			return v, nil
+		},
+		UpsertFunc: func(k, v string) error {
+			m[k] = v
+			return nil
+		},
+		DeleteFunc: func(k string) error {
+			delete(m, k)
+			return nil
+		},
+	}
+}
+
+// listeners returns the channels with the listening channel, so the alerts can be muted
+func listeners() map[string]alertChannel {
+	return map[string]alertChannel{"listener": &listenerMock{}}
+}
+
+func newAlert(name string, level alert.Level) *alert.Alert {
+	a := alert.New(name)
+	a.Level = level
+	return a
+}
+
+func TestManager_Ack(t *testing.T) {
+	store := map[string]string{}
+	m := &ChannelsManager{channels: listeners(), kv: newKV(store), logger: zap.NewNop()}
+
+	require.NoError(t, m.Ack("foo", "error"))
+	assert.Equal(t, "error", store["chmanager:ack:foo"])
+
+	assert.True(t, m.muted(newAlert("foo", alert.LevelError)))
+	assert.False(t, m.muted(newAlert("bar", alert.LevelError)))
+
+	// the level is changed, the ack is cleared
+	assert.False(t, m.muted(newAlert("foo", alert.LevelWarn)))
+	assert.Empty(t, store)
+}
+
+func TestManager_Ack_clearedBySuccess(t *testing.T) {
+	store := map[string]string{"chmanager:ack:foo": "error"}
+	m := &ChannelsManager{channels: listeners(), kv: newKV(store), logger: zap.NewNop()}
+
+	assert.False(t, m.muted(newAlert("foo", alert.LevelSuccess)))
+	assert.Empty(t, store)
+}
+
+func TestManager_Silence(t *testing.T) {
+	store := map[string]string{}
+	m := &ChannelsManager{channels: listeners(), kv: newKV(store), logger: zap.NewNop()}
+
+	require.NoError(t, m.Silence("foo", time.Hour))
+	until, err := strconv.ParseInt(store["chmanager:silence:foo"], 10, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), until, 5)
+
+	assert.True(t, m.muted(newAlert("foo", alert.LevelError)))
+	assert.True(t, m.muted(newAlert("foo", alert.LevelWarn)))
+	assert.False(t, m.muted(newAlert("foo", alert.LevelSuccess)))
+	assert.Equal(t, 1, len(store))
+}
+
+func TestManager_Silence_expired(t *testing.T) {
+	store := map[string]string{"chmanager:silence:foo": strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)}
+	m := &ChannelsManager{channels: listeners(), kv: newKV(store), logger: zap.NewNop()}
+
+	assert.False(t, m.muted(newAlert("foo", alert.LevelError)))
+	assert.Empty(t, store)
+}
+
+func TestManager_muted_noKV(t *testing.T) {
+	m := &ChannelsManager{logger: zap.NewNop()}
+
+	assert.False(t, m.muted(newAlert("foo", alert.LevelError)))
+
+	err := m.Ack("foo", "error")
+	require.Error(t, err)
+	assert.Equal(t, "core storage is not available", err.Error())
+
+	err = m.Silence("foo", time.Hour)
+	require.Error(t, err)
+	assert.Equal(t, "core storage is not available", err.Error())
+}
+
+func TestManager_muted_kvError(t *testing.T) {
+	m := &ChannelsManager{
+		channels: listeners(),
+		kv: &corestorage.KVMock{
+			GetFunc: func(string) (string, error) {
+				return "", fmt.Errorf("err1")
+			},
+		},
+		logger: zap.NewNop(),
+	}
+
+	assert.False(t, m.muted(newAlert("foo", alert.LevelError)))
+}
+
+func TestManager_Send_muted(t *testing.T) {
+	chan1 := &alertChannelMock{}
+
+	m := &ChannelsManager{
+		channels: map[string]alertChannel{"chan1": chan1, "listener": &listenerMock{}},
+		kv:       newKV(map[string]string{"chmanager:ack:foo": "error"}),
+		logger:   zap.NewNop(),
+	}
+
+	m.Send(newAlert("foo", alert.LevelError), "alertText", &alert.Options{})
+
+	chan1.AssertNotCalled(t, "Send")
+}
+
+func TestManager_muted_noListeners(t *testing.T) {
+	kv := newKV(map[string]string{"chmanager:ack:foo": "error"})
+	m := &ChannelsManager{
+		channels: map[string]alertChannel{"chan1": &alertChannelMock{}, "chan2": &listenerMock{disabled: true}},
+		kv:       kv,
+		logger:   zap.NewNop(),
+	}
+
+	assert.False(t, m.muted(newAlert("foo", alert.LevelError)))
+	assert.Empty(t, kv.GetCalls())
+}
@@ -0,0 +1,29 @@
+package manager
+
+import (
+	"context"
+	"sync"
+
+	"github.com/balerter/balerter/internal/corestorage"
+)
+
+// resender is a channel re-sending the active alerts periodically, e.g. alertmanager
+type resender interface {
+	Resend(ctx context.Context, alerts corestorage.Alert)
+}
+
+// Resend starts re-sending the active alerts to the channels, it is stopped when the ctx is done
+func (m *ChannelsManager) Resend(ctx context.Context, wg *sync.WaitGroup, alerts corestorage.Alert) {
+	for _, ch := range m.channels {
+		r, ok := ch.(resender)
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(r resender) {
+			defer wg.Done()
+			r.Resend(ctx, alerts)
+		}(r)
+	}
+}
@@ -0,0 +1,41 @@
+package manager
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/balerter/balerter/internal/corestorage"
+	"github.com/stretchr/testify/assert"
+)
+
+type resenderMock struct {
+	alertChannelMock
+	alerts corestorage.Alert
+}
+
+func (r *resenderMock) Resend(ctx context.Context, alerts corestorage.Alert) {
+	r.alerts = alerts
+	<-ctx.Done()
+}
+
+func TestManager_Resend(t *testing.T) {
+	r := &resenderMock{}
+	alerts := &corestorage.AlertMock{}
+
+	m := &ChannelsManager{
+		channels: map[string]alertChannel{
+			"chan1": r,
+			"chan2": &alertChannelMock{},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+
+	m.Resend(ctx, wg, alerts)
+	cancel()
+	wg.Wait()
+
+	assert.Same(t, alerts, r.alerts)
+}
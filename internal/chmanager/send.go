@@ -1,17 +1,33 @@
 package manager
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/balerter/balerter/internal/alert"
 	"github.com/balerter/balerter/internal/message"
 	"go.uber.org/zap"
 )
 
+const (
+	testAlertName   = "balerter_test"
+	defaultTestText = "Test message from balerter"
+)
+
+// ErrChannelNotFound is returned if the channel with the name is not configured
+var ErrChannelNotFound = errors.New("channel not found")
+
 // Send a message
 func (m *ChannelsManager) Send(a *alert.Alert, text string, options *alert.Options) {
 	if options.Quiet {
 		return
 	}
 
+	if m.muted(a) {
+		m.logger.Debug("the message was not sent, the alert is muted", zap.String("alert name", a.Name))
+		return
+	}
+
 	chs := make(map[string]alertChannel)
 
 	if len(options.Channels) > 0 {
@@ -37,8 +53,37 @@ func (m *ChannelsManager) Send(a *alert.Alert, text string, options *alert.Optio
 	}
 
 	for name, module := range chs {
-		if err := module.Send(message.New(a.Level.String(), a.Name, text, options.Image, options.Fields)); err != nil {
-			m.logger.Error("error send the message to the channel", zap.String("channel name", name), zap.Error(err))
+		if m.limited(name, a.Level) {
+			continue
 		}
+
+		mes := message.New(a.Level.String(), a.Name, text, options.Image, options.Fields)
+		mes.Alert = a
+		mes.Script = options.Script
+		mes.Attachments = options.Attachments
+		mes.Links = options.Links
+
+		m.sendWithFallback(name, module, mes, a.Level, chs)
 	}
 }
+
+// SendTest sends the test message to the channel and returns the delivery result.
+// The test message is not muted and does not change any alert
+func (m *ChannelsManager) SendTest(name, text string) error {
+	ch, ok := m.channels[name]
+	if !ok {
+		return ErrChannelNotFound
+	}
+
+	if text == "" {
+		text = defaultTestText
+	}
+
+	mes := message.New(alert.LevelSuccess.String(), testAlertName, text, "", nil)
+
+	if err := m.sendToChannel(ch, mes); err != nil {
+		return fmt.Errorf("error send the message to the channel, %w", err)
+	}
+
+	return nil
+}
@@ -1,11 +1,13 @@
 package manager
 
 import (
+	"errors"
 	"fmt"
 	"github.com/balerter/balerter/internal/alert"
 	"github.com/balerter/balerter/internal/message"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 	"testing"
@@ -161,3 +163,37 @@ func TestChannelsManager_Send_ignore(t *testing.T) {
 
 	assert.Equal(t, 1, logger.FilterMessage("the message was not sent, empty channels").Len())
 }
+
+func TestManager_SendTest(t *testing.T) {
+	ch := &alertChannelMock{}
+	ch.On("Send", mock.Anything).Return(nil)
+
+	m := &ChannelsManager{
+		channels: map[string]alertChannel{"chan1": ch},
+	}
+
+	err := m.SendTest("chan2", "")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrChannelNotFound))
+
+	require.NoError(t, m.SendTest("chan1", ""))
+
+	mes := ch.Calls[0].Arguments.Get(0).(*message.Message)
+	assert.Equal(t, "success", mes.Level)
+	assert.Equal(t, "balerter_test", mes.AlertName)
+	assert.Equal(t, "Test message from balerter", mes.Text)
+}
+
+func TestManager_SendTest_Error(t *testing.T) {
+	ch := &alertChannelMock{}
+	ch.On("Send", mock.Anything).Return(fmt.Errorf("err1"))
+
+	m := &ChannelsManager{
+		channels: map[string]alertChannel{"chan1": ch},
+	}
+
+	err := m.SendTest("chan1", "text")
+	require.Error(t, err)
+	assert.Equal(t, "error send the message to the channel, err1", err.Error())
+	assert.Equal(t, "text", ch.Calls[0].Arguments.Get(0).(*message.Message).Text)
+}
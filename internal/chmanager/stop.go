@@ -0,0 +1,25 @@
+package manager
+
+import (
+	"go.uber.org/zap"
+)
+
+// stopper is a channel with a long-lived connection, e.g. kafka or nats
+type stopper interface {
+	Stop() error
+}
+
+// Stop closes the channels connections
+func (m *ChannelsManager) Stop() {
+	for name, ch := range m.channels {
+		s, ok := ch.(stopper)
+		if !ok {
+			continue
+		}
+
+		m.logger.Debug("stop channel", zap.String("name", name))
+		if err := s.Stop(); err != nil {
+			m.logger.Error("error stop channel", zap.String("name", name), zap.Error(err))
+		}
+	}
+}
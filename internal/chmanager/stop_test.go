@@ -0,0 +1,44 @@
+package manager
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type stopperMock struct {
+	alertChannelMock
+	err     error
+	stopped bool
+}
+
+func (s *stopperMock) Stop() error {
+	s.stopped = true
+	return s.err
+}
+
+func TestManager_Stop(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+
+	s1 := &stopperMock{}
+	s2 := &stopperMock{err: fmt.Errorf("err1")}
+
+	m := &ChannelsManager{
+		logger: zap.New(core),
+		channels: map[string]alertChannel{
+			"chan1": s1,
+			"chan2": &alertChannelMock{},
+			"chan3": s2,
+		},
+	}
+
+	m.Stop()
+
+	assert.True(t, s1.stopped)
+	assert.True(t, s2.stopped)
+	assert.Equal(t, 2, logs.FilterMessage("stop channel").Len())
+	assert.Equal(t, 1, logs.FilterMessage("error stop channel").Len())
+}
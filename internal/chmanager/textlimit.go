@@ -0,0 +1,37 @@
+package manager
+
+import (
+	"github.com/balerter/balerter/internal/message"
+)
+
+// textLimiter is implemented by the channels with the limit of the text length
+type textLimiter interface {
+	TextLimit(*message.Message) message.Limit
+}
+
+// sendToChannel sends the message to the channel. The links are added to the text, if the channel doesn't render them,
+// and the attachments are sent as the links, if the channel doesn't support uploads. The text over the channel limit is split, truncated or attached as a file,
+// depending on the channel, the truncated text refers to the first link. It stops on the first error
+func (m *ChannelsManager) sendToChannel(ch alertChannel, mes *message.Message) error {
+	link := message.FirstLink(mes)
+
+	if len(mes.Links) > 0 && !supportsLinks(ch) {
+		mes = linksAsText(mes)
+	}
+	if len(mes.Attachments) > 0 && !supportsAttachments(ch) {
+		mes = m.attachmentsAsLinks(mes)
+	}
+
+	tl, ok := ch.(textLimiter)
+	if !ok {
+		return ch.Send(mes)
+	}
+
+	for _, part := range message.ApplyLimit(mes, tl.TextLimit(mes), link) {
+		if err := ch.Send(part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
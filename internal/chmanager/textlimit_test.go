@@ -0,0 +1,71 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/balerter/balerter/internal/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type limitedChannelMock struct {
+	*alertChannelMock
+	limit message.Limit
+}
+
+func (ch *limitedChannelMock) TextLimit(*message.Message) message.Limit {
+	return ch.limit
+}
+
+func TestManager_sendToChannel(t *testing.T) {
+	m := New(nil, zap.NewNop())
+
+	ch := newChannelMock("chan1", nil)
+
+	mes := message.New("error", "alert1", "line one\nline two", "", nil)
+	require.NoError(t, m.sendToChannel(ch, mes))
+	ch.AssertNumberOfCalls(t, "Send", 1)
+
+	limited := &limitedChannelMock{
+		alertChannelMock: newChannelMock("chan2", nil),
+		limit:            message.Limit{MaxLength: 10, Mode: message.LimitSplit},
+	}
+	require.NoError(t, m.sendToChannel(limited, mes))
+	limited.AssertNumberOfCalls(t, "Send", 2)
+	assert.Equal(t, "line one", limited.Calls[0].Arguments.Get(0).(*message.Message).Text)
+	assert.Equal(t, "line two", limited.Calls[1].Arguments.Get(0).(*message.Message).Text)
+
+	failed := &limitedChannelMock{
+		alertChannelMock: newChannelMock("chan3", fmt.Errorf("err1")),
+		limit:            message.Limit{MaxLength: 10, Mode: message.LimitSplit},
+	}
+	err := m.sendToChannel(failed, mes)
+	require.Error(t, err)
+	assert.Equal(t, "err1", err.Error())
+	failed.AssertNumberOfCalls(t, "Send", 1)
+	failed.AssertCalled(t, "Send", mock.Anything)
+}
+
+func TestManager_sendToChannel_truncateLink(t *testing.T) {
+	m := New(nil, zap.NewNop())
+
+	limited := &limitedChannelMock{
+		alertChannelMock: newChannelMock("chan1", nil),
+		limit:            message.Limit{MaxLength: 100, Mode: message.LimitTruncate},
+	}
+
+	mes := message.New("error", "alert1", strings.Repeat("a", 200), "", nil)
+	mes.Links = []alert.Link{{Label: "Dashboard", URL: "https://example.com/d/1"}}
+
+	// the channel doesn't render the links, they are added to the text and cut, the mark refers to the first link
+	require.NoError(t, m.sendToChannel(limited, mes))
+	limited.AssertNumberOfCalls(t, "Send", 1)
+	sent := limited.Calls[len(limited.Calls)-1].Arguments.Get(0).(*message.Message)
+	assert.Equal(t, 100, len([]rune(sent.Text)))
+	assert.True(t, strings.HasSuffix(sent.Text, "\n…truncated, see https://example.com/d/1"))
+}
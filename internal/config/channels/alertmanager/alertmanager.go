@@ -3,16 +3,37 @@ package alertmanager
 import (
 	"fmt"
 	"github.com/balerter/balerter/internal/config/channels/webhook"
+	"github.com/balerter/balerter/internal/config/common"
+	"net/url"
 	"strings"
 )
 
+const (
+	// APIVersionV1 uses the /api/v1/alerts path, it is the default version
+	APIVersionV1 = "v1"
+	// APIVersionV2 uses the /api/v2/alerts path
+	APIVersionV2 = "v2"
+)
+
 // Alertmanager channel config
 type Alertmanager struct {
 	// Name of the channel
 	Name string `json:"name" yaml:"name" hcl:"name,label"`
-	// Settings contains webhook settings
+	// Settings contains webhook settings. If the url has no path, the path of the api version is used
 	Settings webhook.Settings `json:"settings" yaml:"settings" hcl:"settings,block"`
-	Ignore   bool             `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+	// APIVersion is v1 (default) or v2
+	APIVersion string `json:"apiVersion" yaml:"apiVersion" hcl:"apiVersion,optional"`
+	// Labels are the message fields sent as the alert labels, other fields are sent as annotations
+	Labels []string `json:"labels" yaml:"labels" hcl:"labels,optional"`
+	// StaticLabels are added to the labels of every alert
+	StaticLabels map[string]string `json:"staticLabels" yaml:"staticLabels" hcl:"staticLabels,optional"`
+	// GeneratorURL is the balerter API address, e.g. http://balerter:2000. It is used for the alert generatorURL
+	GeneratorURL string `json:"generatorURL" yaml:"generatorURL" hcl:"generatorURL,optional"`
+	// ResendInterval is the interval for re-sending active alerts in ms, 60000 by default
+	ResendInterval int  `json:"resendInterval" yaml:"resendInterval" hcl:"resendInterval,optional"`
+	Ignore         bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
 }
 
 // Validate config
@@ -21,9 +42,35 @@ func (cfg Alertmanager) Validate() error {
 		return fmt.Errorf("name must be not empty")
 	}
 
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+
 	if err := cfg.Settings.Validate(); err != nil {
 		return err
 	}
 
+	switch cfg.APIVersion {
+	case "", APIVersionV1, APIVersionV2:
+	default:
+		return fmt.Errorf("apiVersion must be v1 or v2")
+	}
+
+	for _, l := range cfg.Labels {
+		if strings.TrimSpace(l) == "" {
+			return fmt.Errorf("label must be not empty")
+		}
+	}
+
+	if cfg.GeneratorURL != "" {
+		if _, err := url.ParseRequestURI(cfg.GeneratorURL); err != nil {
+			return fmt.Errorf("error validate generatorURL: %w", err)
+		}
+	}
+
+	if cfg.ResendInterval < 0 {
+		return fmt.Errorf("resendInterval must be greater or equals 0")
+	}
+
 	return nil
 }
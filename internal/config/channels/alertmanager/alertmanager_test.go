@@ -7,8 +7,14 @@ import (
 
 func TestChannelAlertmanager_Validate(t *testing.T) {
 	type fields struct {
-		Name string
+		Name           string
+		Settings       webhook.Settings
+		APIVersion     string
+		Labels         []string
+		GeneratorURL   string
+		ResendInterval int
 	}
+	settings := webhook.Settings{URL: "http://127.0.0.1:9093"}
 	tests := []struct {
 		name    string
 		fields  fields
@@ -21,12 +27,46 @@ func TestChannelAlertmanager_Validate(t *testing.T) {
 			wantErr: true,
 			errText: "name must be not empty",
 		},
+		{
+			name:    "bad api version",
+			fields:  fields{Name: "foo", Settings: settings, APIVersion: "v3"},
+			wantErr: true,
+			errText: "apiVersion must be v1 or v2",
+		},
+		{
+			name:    "empty label",
+			fields:  fields{Name: "foo", Settings: settings, Labels: []string{"host", " "}},
+			wantErr: true,
+			errText: "label must be not empty",
+		},
+		{
+			name:    "bad generator url",
+			fields:  fields{Name: "foo", Settings: settings, GeneratorURL: "foo"},
+			wantErr: true,
+			errText: `error validate generatorURL: parse "foo": invalid URI for request`,
+		},
+		{
+			name:    "bad resend interval",
+			fields:  fields{Name: "foo", Settings: settings, ResendInterval: -1},
+			wantErr: true,
+			errText: "resendInterval must be greater or equals 0",
+		},
+		{
+			name: "ok",
+			fields: fields{Name: "foo", Settings: settings, APIVersion: "v2", Labels: []string{"host"},
+				GeneratorURL: "http://balerter:2000", ResendInterval: 1000},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := Alertmanager{
-				Name:     tt.fields.Name,
-				Settings: webhook.Settings{},
+				Name:           tt.fields.Name,
+				Settings:       tt.fields.Settings,
+				APIVersion:     tt.fields.APIVersion,
+				Labels:         tt.fields.Labels,
+				GeneratorURL:   tt.fields.GeneratorURL,
+				ResendInterval: tt.fields.ResendInterval,
 			}
 			err := cfg.Validate()
 			if (err != nil) != tt.wantErr {
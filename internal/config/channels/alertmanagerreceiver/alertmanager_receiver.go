@@ -3,6 +3,7 @@ package alertmanagerreceiver
 import (
 	"fmt"
 	"github.com/balerter/balerter/internal/config/channels/webhook"
+	"github.com/balerter/balerter/internal/config/common"
 	"strings"
 )
 
@@ -13,6 +14,8 @@ type AlertmanagerReceiver struct {
 	// Settings contains webhook settings
 	Settings webhook.Settings `json:"settings" yaml:"settings,block"`
 	Ignore   bool             `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
 }
 
 // Validate config
@@ -21,6 +24,10 @@ func (cfg AlertmanagerReceiver) Validate() error {
 		return fmt.Errorf("name must be not empty")
 	}
 
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+
 	if err := cfg.Settings.Validate(); err != nil {
 		return err
 	}
@@ -7,11 +7,23 @@ import (
 	"github.com/balerter/balerter/internal/config/channels/alertmanagerreceiver"
 	"github.com/balerter/balerter/internal/config/channels/discord"
 	"github.com/balerter/balerter/internal/config/channels/email"
+	"github.com/balerter/balerter/internal/config/channels/file"
+	"github.com/balerter/balerter/internal/config/channels/github"
+	"github.com/balerter/balerter/internal/config/channels/gitlab"
+	"github.com/balerter/balerter/internal/config/channels/googlechat"
+	"github.com/balerter/balerter/internal/config/channels/gotify"
+	"github.com/balerter/balerter/internal/config/channels/jira"
+	"github.com/balerter/balerter/internal/config/channels/kafka"
 	"github.com/balerter/balerter/internal/config/channels/log"
+	"github.com/balerter/balerter/internal/config/channels/matrix"
+	"github.com/balerter/balerter/internal/config/channels/nats"
 	"github.com/balerter/balerter/internal/config/channels/notify"
+	"github.com/balerter/balerter/internal/config/channels/ntfy"
+	"github.com/balerter/balerter/internal/config/channels/pushover"
 	"github.com/balerter/balerter/internal/config/channels/slack"
 	"github.com/balerter/balerter/internal/config/channels/syslog"
 	"github.com/balerter/balerter/internal/config/channels/telegram"
+	"github.com/balerter/balerter/internal/config/channels/twiliosms"
 	"github.com/balerter/balerter/internal/config/channels/twiliovoice"
 	"github.com/balerter/balerter/internal/config/channels/webhook"
 	"github.com/balerter/balerter/internal/util"
@@ -41,10 +53,44 @@ type Channels struct {
 	TwilioVoice []twiliovoice.Twilio `json:"twilioVoice" yaml:"twilioVoice" hcl:"twilioVoice,block"`
 	// Log channel
 	Log []log.Log `json:"log" yaml:"log" hcl:"log,block"`
+	// GoogleChat channel
+	GoogleChat []googlechat.GoogleChat `json:"googlechat" yaml:"googlechat" hcl:"googlechat,block"`
+	// Matrix channel
+	Matrix []matrix.Matrix `json:"matrix" yaml:"matrix" hcl:"matrix,block"`
+	// TwilioSMS channel
+	TwilioSMS []twiliosms.TwilioSMS `json:"twilioSMS" yaml:"twilioSMS" hcl:"twilioSMS,block"`
+	// Ntfy channel
+	Ntfy []ntfy.Ntfy `json:"ntfy" yaml:"ntfy" hcl:"ntfy,block"`
+	// Gotify channel
+	Gotify []gotify.Gotify `json:"gotify" yaml:"gotify" hcl:"gotify,block"`
+	// Pushover channel
+	Pushover []pushover.Pushover `json:"pushover" yaml:"pushover" hcl:"pushover,block"`
+	// Kafka channel
+	Kafka []kafka.Kafka `json:"kafka" yaml:"kafka" hcl:"kafka,block"`
+	// Nats channel
+	Nats []nats.Nats `json:"nats" yaml:"nats" hcl:"nats,block"`
+	// GitHub channel
+	GitHub []github.GitHub `json:"github" yaml:"github" hcl:"github,block"`
+	// GitLab channel
+	GitLab []gitlab.GitLab `json:"gitlab" yaml:"gitlab" hcl:"gitlab,block"`
+	// Jira channel
+	Jira []jira.Jira `json:"jira" yaml:"jira" hcl:"jira,block"`
+	// File channel
+	File []file.File `json:"file" yaml:"file" hcl:"file,block"`
+
+	// HealthCheckInterval is an interval of the channels health checks in ms, 300000 by default
+	HealthCheckInterval int `json:"healthCheckInterval" yaml:"healthCheckInterval" hcl:"healthCheckInterval,optional"`
+	// AttachmentsStorage is the name of the upload storage for the attachments of the channels without uploads support.
+	// May be empty, if only one upload storage is configured
+	AttachmentsStorage string `json:"attachmentsStorage" yaml:"attachmentsStorage" hcl:"attachmentsStorage,optional"`
 }
 
 // Validate config
 func (cfg Channels) Validate() error {
+	if cfg.HealthCheckInterval < 0 {
+		return fmt.Errorf("healthCheckInterval must be greater or equals 0")
+	}
+
 	var names []string
 
 	for _, c := range cfg.Email {
@@ -167,5 +213,137 @@ func (cfg Channels) Validate() error {
 		return fmt.Errorf("found duplicated name for channels 'log': %s", name)
 	}
 
+	names = names[:0]
+	for _, c := range cfg.GoogleChat {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel googlechat: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'googlechat': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.Matrix {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel matrix: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'matrix': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.TwilioSMS {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel twiliosms: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'twiliosms': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.Ntfy {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel ntfy: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'ntfy': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.Gotify {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel gotify: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'gotify': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.Pushover {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel pushover: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'pushover': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.Kafka {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel kafka: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'kafka': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.Nats {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel nats: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'nats': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.GitHub {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel github: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'github': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.GitLab {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel gitlab: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'gitlab': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.Jira {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel jira: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'jira': %s", name)
+	}
+
+	names = names[:0]
+	for _, c := range cfg.File {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("validate channel file: %w", err)
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for channels 'file': %s", name)
+	}
+
 	return nil
 }
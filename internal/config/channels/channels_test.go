@@ -5,26 +5,52 @@ import (
 
 	"github.com/balerter/balerter/internal/config/channels/discord"
 	"github.com/balerter/balerter/internal/config/channels/email"
+	"github.com/balerter/balerter/internal/config/channels/file"
+	"github.com/balerter/balerter/internal/config/channels/github"
+	"github.com/balerter/balerter/internal/config/channels/gitlab"
+	"github.com/balerter/balerter/internal/config/channels/googlechat"
+	"github.com/balerter/balerter/internal/config/channels/gotify"
+	"github.com/balerter/balerter/internal/config/channels/jira"
+	"github.com/balerter/balerter/internal/config/channels/kafka"
 	"github.com/balerter/balerter/internal/config/channels/log"
+	"github.com/balerter/balerter/internal/config/channels/matrix"
+	"github.com/balerter/balerter/internal/config/channels/nats"
 	"github.com/balerter/balerter/internal/config/channels/notify"
+	"github.com/balerter/balerter/internal/config/channels/ntfy"
+	"github.com/balerter/balerter/internal/config/channels/pushover"
 	"github.com/balerter/balerter/internal/config/channels/slack"
 	"github.com/balerter/balerter/internal/config/channels/syslog"
 	"github.com/balerter/balerter/internal/config/channels/telegram"
+	"github.com/balerter/balerter/internal/config/channels/twiliosms"
 	"github.com/balerter/balerter/internal/config/channels/twiliovoice"
 	"github.com/balerter/balerter/internal/config/channels/webhook"
 )
 
 func TestChannels_Validate(t *testing.T) {
 	type fields struct {
-		Email    []email.Email
-		Slack    []slack.Slack
-		Telegram []telegram.Telegram
-		Syslog   []syslog.Syslog
-		Notify   []notify.Notify
-		Discord  []discord.Discord
-		Webhook  []webhook.Webhook
-		Twilio   []twiliovoice.Twilio
-		Log      []log.Log
+		Email      []email.Email
+		Slack      []slack.Slack
+		Telegram   []telegram.Telegram
+		Syslog     []syslog.Syslog
+		Notify     []notify.Notify
+		Discord    []discord.Discord
+		Webhook    []webhook.Webhook
+		Twilio     []twiliovoice.Twilio
+		Log        []log.Log
+		GoogleChat []googlechat.GoogleChat
+		Matrix     []matrix.Matrix
+		TwilioSMS  []twiliosms.TwilioSMS
+		Ntfy       []ntfy.Ntfy
+		Gotify     []gotify.Gotify
+		Pushover   []pushover.Pushover
+		Kafka      []kafka.Kafka
+		Nats       []nats.Nats
+		GitHub     []github.GitHub
+		GitLab     []gitlab.GitLab
+		Jira       []jira.Jira
+		File       []file.File
+
+		HealthCheckInterval int
 	}
 	tests := []struct {
 		name    string
@@ -32,6 +58,12 @@ func TestChannels_Validate(t *testing.T) {
 		wantErr bool
 		errText string
 	}{
+		{
+			name:    "bad health check interval",
+			fields:  fields{HealthCheckInterval: -1},
+			wantErr: true,
+			errText: "healthCheckInterval must be greater or equals 0",
+		},
 		{
 			name: "duplicated email",
 			fields: fields{
@@ -132,6 +164,102 @@ func TestChannels_Validate(t *testing.T) {
 			wantErr: true,
 			errText: "found duplicated name for channels 'log': 1",
 		},
+		{
+			name: "duplicated googlechat",
+			fields: fields{
+				GoogleChat: []googlechat.GoogleChat{{Name: "1", URL: "https://chat.googleapis.com"}, {Name: "1", URL: "https://chat.googleapis.com"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'googlechat': 1",
+		},
+		{
+			name: "duplicated matrix",
+			fields: fields{
+				Matrix: []matrix.Matrix{{Name: "1", URL: "https://matrix.org", Token: "t", RoomID: "!a:matrix.org"}, {Name: "1", URL: "https://matrix.org", Token: "t", RoomID: "!a:matrix.org"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'matrix': 1",
+		},
+		{
+			name: "duplicated twiliosms",
+			fields: fields{
+				TwilioSMS: []twiliosms.TwilioSMS{{Name: "1", SID: "1", Token: "1", From: "1", To: []string{"1"}}, {Name: "1", SID: "1", Token: "1", From: "1", To: []string{"1"}}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'twiliosms': 1",
+		},
+		{
+			name: "duplicated ntfy",
+			fields: fields{
+				Ntfy: []ntfy.Ntfy{{Name: "1", Topic: "alerts"}, {Name: "1", Topic: "alerts"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'ntfy': 1",
+		},
+		{
+			name: "duplicated gotify",
+			fields: fields{
+				Gotify: []gotify.Gotify{{Name: "1", URL: "https://gotify.example.com", Token: "t"}, {Name: "1", URL: "https://gotify.example.com", Token: "t"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'gotify': 1",
+		},
+		{
+			name: "duplicated pushover",
+			fields: fields{
+				Pushover: []pushover.Pushover{{Name: "1", Token: "t", User: "u"}, {Name: "1", Token: "t", User: "u"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'pushover': 1",
+		},
+		{
+			name: "duplicated kafka",
+			fields: fields{
+				Kafka: []kafka.Kafka{kafka.Kafka{Name: "1", Brokers: []string{"127.0.0.1:9092"}, Topic: "alerts"}, kafka.Kafka{Name: "1", Brokers: []string{"127.0.0.1:9092"}, Topic: "alerts"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'kafka': 1",
+		},
+		{
+			name: "duplicated nats",
+			fields: fields{
+				Nats: []nats.Nats{nats.Nats{Name: "1", URL: "nats://127.0.0.1:4222", Subject: "alerts"}, nats.Nats{Name: "1", URL: "nats://127.0.0.1:4222", Subject: "alerts"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'nats': 1",
+		},
+		{
+			name: "duplicated github",
+			fields: fields{
+				GitHub: []github.GitHub{github.GitHub{Name: "1", Token: "t", Owner: "o", Repo: "r"}, github.GitHub{Name: "1", Token: "t", Owner: "o", Repo: "r"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'github': 1",
+		},
+		{
+			name: "duplicated gitlab",
+			fields: fields{
+				GitLab: []gitlab.GitLab{gitlab.GitLab{Name: "1", Token: "t", Project: "g/p"}, gitlab.GitLab{Name: "1", Token: "t", Project: "g/p"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'gitlab': 1",
+		},
+		{
+			name: "duplicated jira",
+			fields: fields{
+				Jira: []jira.Jira{jira.Jira{Name: "1", URL: "https://jira.example.com", Token: "t", Project: "OPS"}, jira.Jira{Name: "1", URL: "https://jira.example.com", Token: "t", Project: "OPS"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'jira': 1",
+		},
+		{
+			name: "duplicated file",
+			fields: fields{
+				File: []file.File{{Name: "1", Path: "a"}, {Name: "1", Path: "a"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for channels 'file': 1",
+		},
 		{
 			name: "ok",
 			fields: fields{
@@ -171,6 +299,20 @@ func TestChannels_Validate(t *testing.T) {
 				Webhook:     tt.fields.Webhook,
 				TwilioVoice: tt.fields.Twilio,
 				Log:         tt.fields.Log,
+				GoogleChat:  tt.fields.GoogleChat,
+				Matrix:      tt.fields.Matrix,
+				TwilioSMS:   tt.fields.TwilioSMS,
+				Ntfy:        tt.fields.Ntfy,
+				Gotify:      tt.fields.Gotify,
+				Pushover:    tt.fields.Pushover,
+				Kafka:       tt.fields.Kafka,
+				Nats:        tt.fields.Nats,
+				GitHub:      tt.fields.GitHub,
+				GitLab:      tt.fields.GitLab,
+				Jira:        tt.fields.Jira,
+				File:        tt.fields.File,
+
+				HealthCheckInterval: tt.fields.HealthCheckInterval,
 			}
 			err := cfg.Validate()
 			if (err != nil) != tt.wantErr {
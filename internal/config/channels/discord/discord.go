@@ -2,18 +2,36 @@ package discord
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"regexp"
 	"strings"
 )
 
+var webhookURLRe = regexp.MustCompile(`^https?://[^/]+/api(/v\d+)?/webhooks/\d+/[\w-]+$`)
+
 // Discord channel config
 type Discord struct {
 	// Name of the channel
 	Name string `json:"name" yaml:"name" hcl:"name,label"`
 	// Token is auth token
-	Token string `json:"token" yaml:"token" hcl:"token"`
+	Token string `json:"token" yaml:"token" hcl:"token,optional"`
 	// ChannelID of a discord channel
-	ChannelID int64 `json:"channelId" yaml:"channelId" hcl:"channelId"`
-	Ignore    bool  `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+	ChannelID int64 `json:"channelId" yaml:"channelId" hcl:"channelId,optional"`
+	// WebhookURL is the channel webhook URL, used instead of the bot token and the channel id
+	WebhookURL string `json:"webhookUrl" yaml:"webhookUrl" hcl:"webhookUrl,optional"`
+	// Mentions of roles and users for the alert level
+	Mentions []Mention `json:"mentions" yaml:"mentions" hcl:"mention,block"`
+	Ignore   bool      `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Mention configures roles and users mentioned in the messages of the alert level
+type Mention struct {
+	// Level of the alert: success, warning or error
+	Level string  `json:"level" yaml:"level" hcl:"level,label"`
+	Roles []int64 `json:"roles" yaml:"roles" hcl:"roles,optional"`
+	Users []int64 `json:"users" yaml:"users" hcl:"users,optional"`
 }
 
 // Validate config
@@ -22,12 +40,28 @@ func (cfg Discord) Validate() error {
 		return fmt.Errorf("name must be not empty")
 	}
 
-	if strings.TrimSpace(cfg.Token) == "" {
-		return fmt.Errorf("token must be not empty")
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+
+	if cfg.WebhookURL != "" {
+		if !webhookURLRe.MatchString(cfg.WebhookURL) {
+			return fmt.Errorf("webhook url must be like https://discord.com/api/webhooks/<id>/<token>")
+		}
+	} else {
+		if strings.TrimSpace(cfg.Token) == "" {
+			return fmt.Errorf("token must be not empty")
+		}
+
+		if cfg.ChannelID < 1 {
+			return fmt.Errorf("channel id must be not empty")
+		}
 	}
 
-	if cfg.ChannelID < 1 {
-		return fmt.Errorf("channel id must be not empty")
+	for _, m := range cfg.Mentions {
+		if m.Level != "success" && m.Level != "warning" && m.Level != "error" {
+			return fmt.Errorf("mention level must be success, warning or error")
+		}
 	}
 
 	return nil
@@ -4,9 +4,11 @@ import "testing"
 
 func TestChannelDiscord_Validate(t *testing.T) {
 	type fields struct {
-		Name      string
-		Token     string
-		ChannelID int64
+		Name       string
+		Token      string
+		ChannelID  int64
+		WebhookURL string
+		Mentions   []Mention
 	}
 	tests := []struct {
 		name    string
@@ -32,6 +34,24 @@ func TestChannelDiscord_Validate(t *testing.T) {
 			wantErr: true,
 			errText: "channel id must be not empty",
 		},
+		{
+			name:    "bad webhook url",
+			fields:  fields{Name: "foo", WebhookURL: "https://discord.com/api/webhooks/123"},
+			wantErr: true,
+			errText: "webhook url must be like https://discord.com/api/webhooks/<id>/<token>",
+		},
+		{
+			name:    "ok webhook",
+			fields:  fields{Name: "foo", WebhookURL: "https://discord.com/api/webhooks/123/abc-DEF_1"},
+			wantErr: false,
+			errText: "",
+		},
+		{
+			name:    "bad mention level",
+			fields:  fields{Name: "foo", Token: "foo@bar.com", ChannelID: 123, Mentions: []Mention{{Level: "warn", Roles: []int64{1}}}},
+			wantErr: true,
+			errText: "mention level must be success, warning or error",
+		},
 		{
 			name:    "ok",
 			fields:  fields{Name: "foo", Token: "foo@bar.com", ChannelID: 123},
@@ -42,9 +62,11 @@ func TestChannelDiscord_Validate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := Discord{
-				Name:      tt.fields.Name,
-				Token:     tt.fields.Token,
-				ChannelID: tt.fields.ChannelID,
+				Name:       tt.fields.Name,
+				Token:      tt.fields.Token,
+				ChannelID:  tt.fields.ChannelID,
+				WebhookURL: tt.fields.WebhookURL,
+				Mentions:   tt.fields.Mentions,
 			}
 			err := cfg.Validate()
 			if (err != nil) != tt.wantErr {
@@ -2,6 +2,8 @@ package email
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"html/template"
 	"strings"
 )
 
@@ -15,6 +17,16 @@ type Email struct {
 	To string `json:"to" yaml:"to" hcl:"to"`
 	// Cc field
 	Cc string `json:"cc" yaml:"cc" hcl:"cc,optional"`
+	// Bcc field
+	Bcc string `json:"bcc" yaml:"bcc" hcl:"bcc,optional"`
+	// ReplyTo field
+	ReplyTo string `json:"replyTo" yaml:"replyTo" hcl:"replyTo,optional"`
+	// Recipients overrides to, cc and bcc for the alert level
+	Recipients []Recipients `json:"recipients" yaml:"recipients" hcl:"recipients,block"`
+	// Subject template, supports {LEVEL}, {ALERT_NAME}. Default is '[{ALERT_NAME}/{LEVEL}]'
+	Subject string `json:"subject" yaml:"subject" hcl:"subject,optional"`
+	// Template is html/template of the email body, the built-in template is used if empty
+	Template string `json:"template" yaml:"template" hcl:"template,optional"`
 	// Host value
 	Host string `json:"host" yaml:"host" hcl:"host"`
 	// Port value
@@ -28,6 +40,17 @@ type Email struct {
 	// Timeout value
 	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
 	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Recipients of the alert level. Empty fields fall back to the channel values
+type Recipients struct {
+	// Level of the alert: success, warning or error
+	Level string `json:"level" yaml:"level" hcl:"level,label"`
+	To    string `json:"to" yaml:"to" hcl:"to,optional"`
+	Cc    string `json:"cc" yaml:"cc" hcl:"cc,optional"`
+	Bcc   string `json:"bcc" yaml:"bcc" hcl:"bcc,optional"`
 }
 
 // Validate checks the email configuration.
@@ -35,6 +58,10 @@ func (cfg Email) Validate() error {
 	if strings.TrimSpace(cfg.Name) == "" {
 		return fmt.Errorf("name must be not empty")
 	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
 	if strings.TrimSpace(cfg.From) == "" {
 		return fmt.Errorf("from must be not empty")
 	}
@@ -51,6 +78,16 @@ func (cfg Email) Validate() error {
 	if s != "none" && s != "ssl" && s != "tls" && s != "" {
 		return fmt.Errorf("secure must be set to none, ssl or tls")
 	}
+	for _, r := range cfg.Recipients {
+		if r.Level != "success" && r.Level != "warning" && r.Level != "error" {
+			return fmt.Errorf("recipients level must be success, warning or error")
+		}
+	}
+	if cfg.Template != "" {
+		if _, err := template.New("email").Parse(cfg.Template); err != nil {
+			return fmt.Errorf("error parse template, %w", err)
+		}
+	}
 
 	return nil
 }
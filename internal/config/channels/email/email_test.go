@@ -4,11 +4,13 @@ import "testing"
 
 func TestChannelEmail_Validate(t *testing.T) {
 	type fields struct {
-		Name string
-		From string
-		To   string
-		Host string
-		Port string
+		Name       string
+		From       string
+		To         string
+		Host       string
+		Port       string
+		Recipients []Recipients
+		Template   string
 	}
 	tests := []struct {
 		name    string
@@ -46,6 +48,27 @@ func TestChannelEmail_Validate(t *testing.T) {
 			wantErr: true,
 			errText: "port must be not empty",
 		},
+		{
+			name: "bad recipients level",
+			fields: fields{Name: "foo", From: "gopher@example.net", To: "foo@example.com", Host: "mail.example.com", Port: "25",
+				Recipients: []Recipients{{Level: "warn", To: "bar@example.com"}}},
+			wantErr: true,
+			errText: "recipients level must be success, warning or error",
+		},
+		{
+			name: "bad template",
+			fields: fields{Name: "foo", From: "gopher@example.net", To: "foo@example.com", Host: "mail.example.com", Port: "25",
+				Template: "{{ .Text "},
+			wantErr: true,
+			errText: "error parse template, template: email:1: unclosed action",
+		},
+		{
+			name: "ok with recipients and template",
+			fields: fields{Name: "foo", From: "gopher@example.net", To: "foo@example.com", Host: "mail.example.com", Port: "25",
+				Recipients: []Recipients{{Level: "error", To: "oncall@example.com"}}, Template: "<p>{{ .Text }}</p>"},
+			wantErr: false,
+			errText: "",
+		},
 		{
 			name:    "ok",
 			fields:  fields{Name: "foo", From: "gopher@example.net", To: "foo@example.com", Host: "mail.example.com", Port: "25"},
@@ -56,11 +79,13 @@ func TestChannelEmail_Validate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := Email{
-				Name: tt.fields.Name,
-				From: tt.fields.From,
-				To:   tt.fields.To,
-				Host: tt.fields.Host,
-				Port: tt.fields.Port,
+				Name:       tt.fields.Name,
+				From:       tt.fields.From,
+				To:         tt.fields.To,
+				Host:       tt.fields.Host,
+				Port:       tt.fields.Port,
+				Recipients: tt.fields.Recipients,
+				Template:   tt.fields.Template,
 			}
 			err := cfg.Validate()
 			if (err != nil) != tt.wantErr {
@@ -0,0 +1,53 @@
+package file
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"strings"
+)
+
+// File channel config
+type File struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// Path of the file, the messages are appended as JSON lines
+	Path string `json:"path" yaml:"path" hcl:"path"`
+	// MaxSize of the file in megabytes, the file is rotated when the size is exceeded. 0 disables the size rotation
+	MaxSize int `json:"maxSize" yaml:"maxSize" hcl:"maxSize,optional"`
+	// RotateInterval in ms, the file is rotated at the start of every interval. 0 disables the time rotation
+	RotateInterval int `json:"rotateInterval" yaml:"rotateInterval" hcl:"rotateInterval,optional"`
+	// Compress the rotated files with gzip
+	Compress bool `json:"compress" yaml:"compress" hcl:"compress,optional"`
+	// MaxBackups is a count of the rotated files to keep. 0 keeps all files
+	MaxBackups int `json:"maxBackups" yaml:"maxBackups" hcl:"maxBackups,optional"`
+	// Fsync the file after every message
+	Fsync  bool `json:"fsync" yaml:"fsync" hcl:"fsync,optional"`
+	Ignore bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg File) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(cfg.Path) == "" {
+		return fmt.Errorf("path must be not empty")
+	}
+	if cfg.MaxSize < 0 {
+		return fmt.Errorf("maxSize must be greater or equals 0")
+	}
+	if cfg.RotateInterval < 0 {
+		return fmt.Errorf("rotateInterval must be greater or equals 0")
+	}
+	if cfg.MaxBackups < 0 {
+		return fmt.Errorf("maxBackups must be greater or equals 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package file
+
+import "testing"
+
+func TestFile_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     File
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			cfg:     File{Path: "/tmp/alerts.jsonl"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty path",
+			cfg:     File{Name: "foo"},
+			wantErr: true,
+			errText: "path must be not empty",
+		},
+		{
+			name:    "bad max size",
+			cfg:     File{Name: "foo", Path: "/tmp/alerts.jsonl", MaxSize: -1},
+			wantErr: true,
+			errText: "maxSize must be greater or equals 0",
+		},
+		{
+			name:    "bad rotate interval",
+			cfg:     File{Name: "foo", Path: "/tmp/alerts.jsonl", RotateInterval: -1},
+			wantErr: true,
+			errText: "rotateInterval must be greater or equals 0",
+		},
+		{
+			name:    "bad max backups",
+			cfg:     File{Name: "foo", Path: "/tmp/alerts.jsonl", MaxBackups: -1},
+			wantErr: true,
+			errText: "maxBackups must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			cfg:     File{Name: "foo", Path: "/tmp/alerts.jsonl", MaxSize: 10, RotateInterval: 86400000, Compress: true, MaxBackups: 7},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"net/url"
+	"strings"
+)
+
+// GitHub channel config
+type GitHub struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// URL of the GitHub API, https://api.github.com by default
+	URL string `json:"url" yaml:"url" hcl:"url,optional"`
+	// Token is a personal access token with the issues write permission
+	Token string `json:"token" yaml:"token" hcl:"token"`
+	// Owner and Repo of the repository for the issues
+	Owner string `json:"owner" yaml:"owner" hcl:"owner"`
+	Repo  string `json:"repo" yaml:"repo" hcl:"repo"`
+	// Labels for the new issues
+	Labels []string `json:"labels" yaml:"labels" hcl:"labels,optional"`
+	// Assignees for the new issues
+	Assignees []string `json:"assignees" yaml:"assignees" hcl:"assignees,optional"`
+	// Title template of the issue, supports {LEVEL} and {ALERT_NAME} placeholders
+	Title string `json:"title" yaml:"title" hcl:"title,optional"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg GitHub) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	if cfg.URL != "" {
+		if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+			return fmt.Errorf("error validate url: %w", err)
+		}
+	}
+	if strings.TrimSpace(cfg.Token) == "" {
+		return fmt.Errorf("token must be not empty")
+	}
+	if strings.TrimSpace(cfg.Owner) == "" {
+		return fmt.Errorf("owner must be not empty")
+	}
+	if strings.TrimSpace(cfg.Repo) == "" {
+		return fmt.Errorf("repo must be not empty")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package github
+
+import "testing"
+
+func TestGitHub_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     GitHub
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			cfg:     GitHub{Token: "t", Owner: "o", Repo: "r"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "bad url",
+			cfg:     GitHub{Name: "foo", URL: "foo", Token: "t", Owner: "o", Repo: "r"},
+			wantErr: true,
+			errText: "error validate url: parse \"foo\": invalid URI for request",
+		},
+		{
+			name:    "empty token",
+			cfg:     GitHub{Name: "foo", Owner: "o", Repo: "r"},
+			wantErr: true,
+			errText: "token must be not empty",
+		},
+		{
+			name:    "empty owner",
+			cfg:     GitHub{Name: "foo", Token: "t", Repo: "r"},
+			wantErr: true,
+			errText: "owner must be not empty",
+		},
+		{
+			name:    "empty repo",
+			cfg:     GitHub{Name: "foo", Token: "t", Owner: "o"},
+			wantErr: true,
+			errText: "repo must be not empty",
+		},
+		{
+			name:    "bad timeout",
+			cfg:     GitHub{Name: "foo", Token: "t", Owner: "o", Repo: "r", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			cfg:     GitHub{Name: "foo", Token: "t", Owner: "o", Repo: "r"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
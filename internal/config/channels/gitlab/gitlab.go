@@ -0,0 +1,56 @@
+package gitlab
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"net/url"
+	"strings"
+)
+
+// GitLab channel config
+type GitLab struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// URL of the GitLab instance, https://gitlab.com by default
+	URL string `json:"url" yaml:"url" hcl:"url,optional"`
+	// Token is an access token with the api scope
+	Token string `json:"token" yaml:"token" hcl:"token"`
+	// Project is an ID or a path (group/project) of the project for the issues
+	Project string `json:"project" yaml:"project" hcl:"project"`
+	// Labels for the new issues
+	Labels []string `json:"labels" yaml:"labels" hcl:"labels,optional"`
+	// Title template of the issue, supports {LEVEL} and {ALERT_NAME} placeholders
+	Title string `json:"title" yaml:"title" hcl:"title,optional"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg GitLab) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	if cfg.URL != "" {
+		if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+			return fmt.Errorf("error validate url: %w", err)
+		}
+	}
+	if strings.TrimSpace(cfg.Token) == "" {
+		return fmt.Errorf("token must be not empty")
+	}
+	if strings.TrimSpace(cfg.Project) == "" {
+		return fmt.Errorf("project must be not empty")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package gitlab
+
+import "testing"
+
+func TestGitLab_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     GitLab
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			cfg:     GitLab{Token: "t", Project: "g/p"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "bad url",
+			cfg:     GitLab{Name: "foo", URL: "foo", Token: "t", Project: "g/p"},
+			wantErr: true,
+			errText: "error validate url: parse \"foo\": invalid URI for request",
+		},
+		{
+			name:    "empty token",
+			cfg:     GitLab{Name: "foo", Project: "g/p"},
+			wantErr: true,
+			errText: "token must be not empty",
+		},
+		{
+			name:    "empty project",
+			cfg:     GitLab{Name: "foo", Token: "t"},
+			wantErr: true,
+			errText: "project must be not empty",
+		},
+		{
+			name:    "bad timeout",
+			cfg:     GitLab{Name: "foo", Token: "t", Project: "g/p", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			cfg:     GitLab{Name: "foo", Token: "t", Project: "g/p"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
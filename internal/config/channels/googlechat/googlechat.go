@@ -0,0 +1,44 @@
+package googlechat
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"net/url"
+	"strings"
+)
+
+// GoogleChat channel config
+type GoogleChat struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// URL is an incoming webhook URL of a Google Chat space
+	URL string `json:"url" yaml:"url" hcl:"url"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg GoogleChat) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	addr := strings.TrimSpace(cfg.URL)
+	if addr == "" {
+		return fmt.Errorf("url must be not empty")
+	}
+	if _, err := url.ParseRequestURI(addr); err != nil {
+		return fmt.Errorf("error validate url: %w", err)
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
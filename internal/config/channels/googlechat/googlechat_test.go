@@ -0,0 +1,64 @@
+package googlechat
+
+import "testing"
+
+func TestGoogleChat_Validate(t *testing.T) {
+	type fields struct {
+		Name    string
+		URL     string
+		Timeout int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			fields:  fields{Name: "", URL: "https://chat.googleapis.com/v1/spaces/1/messages"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty url",
+			fields:  fields{Name: "foo", URL: ""},
+			wantErr: true,
+			errText: "url must be not empty",
+		},
+		{
+			name:    "bad url",
+			fields:  fields{Name: "foo", URL: "foo"},
+			wantErr: true,
+			errText: "error validate url: parse \"foo\": invalid URI for request",
+		},
+		{
+			name:    "bad timeout",
+			fields:  fields{Name: "foo", URL: "https://chat.googleapis.com/v1/spaces/1/messages", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			fields:  fields{Name: "foo", URL: "https://chat.googleapis.com/v1/spaces/1/messages"},
+			wantErr: false,
+			errText: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := GoogleChat{
+				Name:    tt.fields.Name,
+				URL:     tt.fields.URL,
+				Timeout: tt.fields.Timeout,
+			}
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
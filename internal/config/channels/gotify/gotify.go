@@ -0,0 +1,53 @@
+package gotify
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"net/url"
+	"strings"
+)
+
+// Gotify channel config
+type Gotify struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// URL of the Gotify server
+	URL string `json:"url" yaml:"url" hcl:"url"`
+	// Token is an application token
+	Token string `json:"token" yaml:"token" hcl:"token"`
+	// Title template, supports {LEVEL}, {ALERT_NAME}, {TEXT}, {IMAGE} and {FIELDS} placeholders
+	Title string `json:"title" yaml:"title" hcl:"title,optional"`
+	// Body template, supports the same placeholders as the Title
+	Body string `json:"body" yaml:"body" hcl:"body,optional"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg Gotify) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	addr := strings.TrimSpace(cfg.URL)
+	if addr == "" {
+		return fmt.Errorf("url must be not empty")
+	}
+	if _, err := url.ParseRequestURI(addr); err != nil {
+		return fmt.Errorf("error validate url: %w", err)
+	}
+	if strings.TrimSpace(cfg.Token) == "" {
+		return fmt.Errorf("token must be not empty")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package gotify
+
+import "testing"
+
+func TestGotify_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Gotify
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			cfg:     Gotify{URL: "https://gotify.example.com", Token: "t"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty url",
+			cfg:     Gotify{Name: "foo", Token: "t"},
+			wantErr: true,
+			errText: "url must be not empty",
+		},
+		{
+			name:    "bad url",
+			cfg:     Gotify{Name: "foo", URL: "foo", Token: "t"},
+			wantErr: true,
+			errText: "error validate url: parse \"foo\": invalid URI for request",
+		},
+		{
+			name:    "empty token",
+			cfg:     Gotify{Name: "foo", URL: "https://gotify.example.com"},
+			wantErr: true,
+			errText: "token must be not empty",
+		},
+		{
+			name:    "bad timeout",
+			cfg:     Gotify{Name: "foo", URL: "https://gotify.example.com", Token: "t", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			cfg:     Gotify{Name: "foo", URL: "https://gotify.example.com", Token: "t"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package jira
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"net/url"
+	"strings"
+)
+
+// Jira channel config
+type Jira struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// URL of the Jira instance
+	URL string `json:"url" yaml:"url" hcl:"url"`
+	// Username for the basic auth with the API token (Jira Cloud).
+	// If empty, the Token is used as a personal access token (Jira Server/Data Center)
+	Username string `json:"username" yaml:"username" hcl:"username,optional"`
+	// Token is an API token or a personal access token
+	Token string `json:"token" yaml:"token" hcl:"token"`
+	// Project key for the issues
+	Project string `json:"project" yaml:"project" hcl:"project"`
+	// IssueType of the new issues, Task by default
+	IssueType string `json:"issueType" yaml:"issueType" hcl:"issueType,optional"`
+	// Labels for the new issues
+	Labels []string `json:"labels" yaml:"labels" hcl:"labels,optional"`
+	// CloseTransition is a name of the transition for resolved alerts, Done by default
+	CloseTransition string `json:"closeTransition" yaml:"closeTransition" hcl:"closeTransition,optional"`
+	// Title template of the issue, supports {LEVEL} and {ALERT_NAME} placeholders
+	Title string `json:"title" yaml:"title" hcl:"title,optional"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg Jira) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(cfg.URL) == "" {
+		return fmt.Errorf("url must be not empty")
+	}
+	if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+		return fmt.Errorf("error validate url: %w", err)
+	}
+	if strings.TrimSpace(cfg.Token) == "" {
+		return fmt.Errorf("token must be not empty")
+	}
+	if strings.TrimSpace(cfg.Project) == "" {
+		return fmt.Errorf("project must be not empty")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
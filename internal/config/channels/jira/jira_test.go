@@ -0,0 +1,65 @@
+package jira
+
+import "testing"
+
+func TestJira_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Jira
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			cfg:     Jira{URL: "https://jira.example.com", Token: "t", Project: "OPS"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty url",
+			cfg:     Jira{Name: "foo", Token: "t", Project: "OPS"},
+			wantErr: true,
+			errText: "url must be not empty",
+		},
+		{
+			name:    "bad url",
+			cfg:     Jira{Name: "foo", URL: "foo", Token: "t", Project: "OPS"},
+			wantErr: true,
+			errText: "error validate url: parse \"foo\": invalid URI for request",
+		},
+		{
+			name:    "empty token",
+			cfg:     Jira{Name: "foo", URL: "https://jira.example.com", Project: "OPS"},
+			wantErr: true,
+			errText: "token must be not empty",
+		},
+		{
+			name:    "empty project",
+			cfg:     Jira{Name: "foo", URL: "https://jira.example.com", Token: "t"},
+			wantErr: true,
+			errText: "project must be not empty",
+		},
+		{
+			name:    "bad timeout",
+			cfg:     Jira{Name: "foo", URL: "https://jira.example.com", Token: "t", Project: "OPS", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			cfg:     Jira{Name: "foo", URL: "https://jira.example.com", Token: "t", Project: "OPS"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/balerter/balerter/internal/config/common"
+)
+
+// Kafka channel config
+type Kafka struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// Brokers is a list of bootstrap brokers in the host:port form
+	Brokers []string `json:"brokers" yaml:"brokers" hcl:"brokers"`
+	// Topic for the messages
+	Topic string `json:"topic" yaml:"topic" hcl:"topic"`
+	// TLS enables TLS connections to the brokers
+	TLS *common.TLS `json:"tls" yaml:"tls" hcl:"tls,block"`
+	// SASL authentication
+	SASL *SASL `json:"sasl" yaml:"sasl" hcl:"sasl,block"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// SASL authentication config
+type SASL struct {
+	// Mechanism is one of plain, scram-sha-256, scram-sha-512
+	Mechanism string `json:"mechanism" yaml:"mechanism" hcl:"mechanism"`
+	Username  string `json:"username" yaml:"username" hcl:"username"`
+	Password  string `json:"password" yaml:"password" hcl:"password"`
+}
+
+// Validate config
+func (cfg Kafka) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	if len(cfg.Brokers) == 0 {
+		return fmt.Errorf("brokers must be not empty")
+	}
+	for _, b := range cfg.Brokers {
+		if strings.TrimSpace(b) == "" {
+			return fmt.Errorf("broker address must be not empty")
+		}
+	}
+	if strings.TrimSpace(cfg.Topic) == "" {
+		return fmt.Errorf("topic must be not empty")
+	}
+	if cfg.TLS != nil {
+		if err := cfg.TLS.Validate(); err != nil {
+			return fmt.Errorf("error validate tls, %w", err)
+		}
+	}
+	if cfg.SASL != nil {
+		switch cfg.SASL.Mechanism {
+		case "plain", "scram-sha-256", "scram-sha-512":
+		default:
+			return fmt.Errorf("unsupported sasl mechanism '%s'", cfg.SASL.Mechanism)
+		}
+		if cfg.SASL.Username == "" {
+			return fmt.Errorf("sasl username must be not empty")
+		}
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
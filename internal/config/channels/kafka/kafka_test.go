@@ -0,0 +1,84 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/common"
+)
+
+func TestKafka_Validate(t *testing.T) {
+	brokers := []string{"127.0.0.1:9092"}
+
+	tests := []struct {
+		name    string
+		cfg     Kafka
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			cfg:     Kafka{Brokers: brokers, Topic: "alerts"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty brokers",
+			cfg:     Kafka{Name: "foo", Topic: "alerts"},
+			wantErr: true,
+			errText: "brokers must be not empty",
+		},
+		{
+			name:    "empty broker",
+			cfg:     Kafka{Name: "foo", Brokers: []string{" "}, Topic: "alerts"},
+			wantErr: true,
+			errText: "broker address must be not empty",
+		},
+		{
+			name:    "empty topic",
+			cfg:     Kafka{Name: "foo", Brokers: brokers},
+			wantErr: true,
+			errText: "topic must be not empty",
+		},
+		{
+			name:    "bad tls",
+			cfg:     Kafka{Name: "foo", Brokers: brokers, Topic: "alerts", TLS: &common.TLS{Cert: "cert.pem"}},
+			wantErr: true,
+			errText: "error validate tls, cert and key must be set together",
+		},
+		{
+			name:    "bad sasl mechanism",
+			cfg:     Kafka{Name: "foo", Brokers: brokers, Topic: "alerts", SASL: &SASL{Mechanism: "gssapi", Username: "u"}},
+			wantErr: true,
+			errText: "unsupported sasl mechanism 'gssapi'",
+		},
+		{
+			name:    "empty sasl username",
+			cfg:     Kafka{Name: "foo", Brokers: brokers, Topic: "alerts", SASL: &SASL{Mechanism: "plain"}},
+			wantErr: true,
+			errText: "sasl username must be not empty",
+		},
+		{
+			name:    "bad timeout",
+			cfg:     Kafka{Name: "foo", Brokers: brokers, Topic: "alerts", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name: "ok",
+			cfg: Kafka{Name: "foo", Brokers: brokers, Topic: "alerts",
+				SASL: &SASL{Mechanism: "scram-sha-512", Username: "u", Password: "p"}},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
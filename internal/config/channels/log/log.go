@@ -2,12 +2,15 @@ package log
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
 	"strings"
 )
 
 type Log struct {
 	Name   string `json:"name" yaml:"name" hcl:"name,label"`
 	Ignore bool   `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
 }
 
 // Validate checks the webhook configuration.
@@ -16,5 +19,9 @@ func (cfg Log) Validate() error {
 		return fmt.Errorf("name must be not empty")
 	}
 
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
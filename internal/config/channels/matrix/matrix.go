@@ -0,0 +1,54 @@
+package matrix
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"net/url"
+	"strings"
+)
+
+// Matrix channel config
+type Matrix struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// URL of the homeserver, e.g. https://matrix.org
+	URL string `json:"url" yaml:"url" hcl:"url"`
+	// Token is an access token of the bot user
+	Token string `json:"token" yaml:"token" hcl:"token"`
+	// RoomID is an internal room id, e.g. !abcdef:matrix.org
+	RoomID string `json:"roomId" yaml:"roomId" hcl:"roomId"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg Matrix) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	addr := strings.TrimSpace(cfg.URL)
+	if addr == "" {
+		return fmt.Errorf("url must be not empty")
+	}
+	if _, err := url.ParseRequestURI(addr); err != nil {
+		return fmt.Errorf("error validate url: %w", err)
+	}
+	if strings.TrimSpace(cfg.Token) == "" {
+		return fmt.Errorf("token must be not empty")
+	}
+	if !strings.HasPrefix(cfg.RoomID, "!") {
+		return fmt.Errorf("room id must be not empty and starts with '!'")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package matrix
+
+import "testing"
+
+func TestMatrix_Validate(t *testing.T) {
+	type fields struct {
+		Name    string
+		URL     string
+		Token   string
+		RoomID  string
+		Timeout int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			fields:  fields{Name: "", URL: "https://matrix.org", Token: "t", RoomID: "!a:matrix.org"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty url",
+			fields:  fields{Name: "foo", URL: "", Token: "t", RoomID: "!a:matrix.org"},
+			wantErr: true,
+			errText: "url must be not empty",
+		},
+		{
+			name:    "bad url",
+			fields:  fields{Name: "foo", URL: "foo", Token: "t", RoomID: "!a:matrix.org"},
+			wantErr: true,
+			errText: "error validate url: parse \"foo\": invalid URI for request",
+		},
+		{
+			name:    "empty token",
+			fields:  fields{Name: "foo", URL: "https://matrix.org", Token: "", RoomID: "!a:matrix.org"},
+			wantErr: true,
+			errText: "token must be not empty",
+		},
+		{
+			name:    "bad room id",
+			fields:  fields{Name: "foo", URL: "https://matrix.org", Token: "t", RoomID: "#alias:matrix.org"},
+			wantErr: true,
+			errText: "room id must be not empty and starts with '!'",
+		},
+		{
+			name:    "bad timeout",
+			fields:  fields{Name: "foo", URL: "https://matrix.org", Token: "t", RoomID: "!a:matrix.org", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			fields:  fields{Name: "foo", URL: "https://matrix.org", Token: "t", RoomID: "!a:matrix.org"},
+			wantErr: false,
+			errText: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Matrix{
+				Name:    tt.fields.Name,
+				URL:     tt.fields.URL,
+				Token:   tt.fields.Token,
+				RoomID:  tt.fields.RoomID,
+				Timeout: tt.fields.Timeout,
+			}
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package nats
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/balerter/balerter/internal/config/common"
+)
+
+// Nats channel config
+type Nats struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// URL of the NATS server, nats://host:port or tls://host:port
+	URL string `json:"url" yaml:"url" hcl:"url"`
+	// Subject prefix, the message is published to the '<subject>.<alert name>' subject
+	Subject string `json:"subject" yaml:"subject" hcl:"subject"`
+	// Token for the token authentication
+	Token string `json:"token" yaml:"token" hcl:"token,optional"`
+	// Username and Password for the user/password authentication
+	Username string `json:"username" yaml:"username" hcl:"username,optional"`
+	Password string `json:"password" yaml:"password" hcl:"password,optional"`
+	// TLS enables TLS connection to the server
+	TLS *common.TLS `json:"tls" yaml:"tls" hcl:"tls,block"`
+	// JetStream waits for the stream acknowledgement of the message
+	JetStream bool `json:"jetstream" yaml:"jetstream" hcl:"jetstream,optional"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg Nats) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(cfg.URL) == "" {
+		return fmt.Errorf("url must be not empty")
+	}
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return fmt.Errorf("error validate url: %w", err)
+	}
+	if u.Scheme != "nats" && u.Scheme != "tls" {
+		return fmt.Errorf("url scheme must be nats or tls")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("url host must be not empty")
+	}
+	subject := strings.TrimSpace(cfg.Subject)
+	if subject == "" {
+		return fmt.Errorf("subject must be not empty")
+	}
+	if strings.ContainsAny(subject, " \t*>") || strings.HasPrefix(subject, ".") || strings.HasSuffix(subject, ".") {
+		return fmt.Errorf("bad subject '%s'", cfg.Subject)
+	}
+	if cfg.Token != "" && cfg.Username != "" {
+		return fmt.Errorf("token and username must not be set together")
+	}
+	if cfg.TLS != nil {
+		if err := cfg.TLS.Validate(); err != nil {
+			return fmt.Errorf("error validate tls, %w", err)
+		}
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package nats
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/common"
+)
+
+func TestNats_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Nats
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			cfg:     Nats{URL: "nats://127.0.0.1:4222", Subject: "alerts"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty url",
+			cfg:     Nats{Name: "foo", Subject: "alerts"},
+			wantErr: true,
+			errText: "url must be not empty",
+		},
+		{
+			name:    "bad url scheme",
+			cfg:     Nats{Name: "foo", URL: "http://127.0.0.1:4222", Subject: "alerts"},
+			wantErr: true,
+			errText: "url scheme must be nats or tls",
+		},
+		{
+			name:    "empty url host",
+			cfg:     Nats{Name: "foo", URL: "nats://", Subject: "alerts"},
+			wantErr: true,
+			errText: "url host must be not empty",
+		},
+		{
+			name:    "empty subject",
+			cfg:     Nats{Name: "foo", URL: "nats://127.0.0.1:4222"},
+			wantErr: true,
+			errText: "subject must be not empty",
+		},
+		{
+			name:    "bad subject",
+			cfg:     Nats{Name: "foo", URL: "nats://127.0.0.1:4222", Subject: "alerts.>"},
+			wantErr: true,
+			errText: "bad subject 'alerts.>'",
+		},
+		{
+			name:    "token and username",
+			cfg:     Nats{Name: "foo", URL: "nats://127.0.0.1:4222", Subject: "alerts", Token: "t", Username: "u"},
+			wantErr: true,
+			errText: "token and username must not be set together",
+		},
+		{
+			name:    "bad tls",
+			cfg:     Nats{Name: "foo", URL: "tls://127.0.0.1:4222", Subject: "alerts", TLS: &common.TLS{Key: "key.pem"}},
+			wantErr: true,
+			errText: "error validate tls, cert and key must be set together",
+		},
+		{
+			name:    "bad timeout",
+			cfg:     Nats{Name: "foo", URL: "nats://127.0.0.1:4222", Subject: "alerts", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			cfg:     Nats{Name: "foo", URL: "nats://127.0.0.1:4222", Subject: "balerter.alerts", JetStream: true},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
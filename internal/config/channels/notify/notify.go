@@ -2,6 +2,7 @@ package notify
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
 	"strings"
 )
 
@@ -12,6 +13,8 @@ type Notify struct {
 	// Icons settings
 	Icons  *ChannelNotifyIcons `json:"icons" yaml:"icons" hcl:"icons,block"`
 	Ignore bool                `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
 }
 
 // ChannelNotifyIcons is icon settings
@@ -30,5 +33,9 @@ func (cfg Notify) Validate() error {
 		return fmt.Errorf("name must be not empty")
 	}
 
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
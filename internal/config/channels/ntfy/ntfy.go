@@ -0,0 +1,59 @@
+package ntfy
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"net/url"
+	"strings"
+)
+
+// Ntfy channel config
+type Ntfy struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// URL of the ntfy server, https://ntfy.sh by default
+	URL string `json:"url" yaml:"url" hcl:"url,optional"`
+	// Topic to publish messages
+	Topic string `json:"topic" yaml:"topic" hcl:"topic"`
+	// Token is an access token, if the topic is protected
+	Token string `json:"token" yaml:"token" hcl:"token,optional"`
+	// Username and Password for basic auth, if the topic is protected
+	Username string `json:"username" yaml:"username" hcl:"username,optional"`
+	Password string `json:"password" yaml:"password" hcl:"password,optional"`
+	// Title template, supports {LEVEL}, {ALERT_NAME}, {TEXT}, {IMAGE} and {FIELDS} placeholders
+	Title string `json:"title" yaml:"title" hcl:"title,optional"`
+	// Body template, supports the same placeholders as the Title
+	Body string `json:"body" yaml:"body" hcl:"body,optional"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg Ntfy) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(cfg.URL) != "" {
+		if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+			return fmt.Errorf("error validate url: %w", err)
+		}
+	}
+	if strings.TrimSpace(cfg.Topic) == "" {
+		return fmt.Errorf("topic must be not empty")
+	}
+	if cfg.Token != "" && cfg.Username != "" {
+		return fmt.Errorf("token and username must not be used together")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
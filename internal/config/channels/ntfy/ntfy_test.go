@@ -0,0 +1,59 @@
+package ntfy
+
+import "testing"
+
+func TestNtfy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Ntfy
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			cfg:     Ntfy{Topic: "alerts"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "bad url",
+			cfg:     Ntfy{Name: "foo", URL: "foo", Topic: "alerts"},
+			wantErr: true,
+			errText: "error validate url: parse \"foo\": invalid URI for request",
+		},
+		{
+			name:    "empty topic",
+			cfg:     Ntfy{Name: "foo"},
+			wantErr: true,
+			errText: "topic must be not empty",
+		},
+		{
+			name:    "token and username",
+			cfg:     Ntfy{Name: "foo", Topic: "alerts", Token: "tk", Username: "user"},
+			wantErr: true,
+			errText: "token and username must not be used together",
+		},
+		{
+			name:    "bad timeout",
+			cfg:     Ntfy{Name: "foo", Topic: "alerts", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			cfg:     Ntfy{Name: "foo", URL: "https://ntfy.example.com", Topic: "alerts", Token: "tk"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
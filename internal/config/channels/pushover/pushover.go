@@ -0,0 +1,65 @@
+package pushover
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"strings"
+)
+
+const (
+	minRetry  = 30
+	maxExpire = 10800
+)
+
+// Pushover channel config
+type Pushover struct {
+	// Name of the channel
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// Token is an application API token
+	Token string `json:"token" yaml:"token" hcl:"token"`
+	// User is a user or a group key
+	User string `json:"user" yaml:"user" hcl:"user"`
+	// Device names, all user devices by default
+	Device []string `json:"device" yaml:"device" hcl:"device,optional"`
+	// Title template, supports {LEVEL}, {ALERT_NAME}, {TEXT}, {IMAGE} and {FIELDS} placeholders
+	Title string `json:"title" yaml:"title" hcl:"title,optional"`
+	// Body template, supports the same placeholders as the Title
+	Body string `json:"body" yaml:"body" hcl:"body,optional"`
+	// Retry is an interval in seconds to repeat emergency notifications until acknowledged, 60 by default
+	Retry int `json:"retry" yaml:"retry" hcl:"retry,optional"`
+	// Expire is a time in seconds to stop repeating of emergency notifications, 3600 by default
+	Expire int `json:"expire" yaml:"expire" hcl:"expire,optional"`
+	// Timeout value in milliseconds
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+// Validate config
+func (cfg Pushover) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(cfg.Token) == "" {
+		return fmt.Errorf("token must be not empty")
+	}
+	if strings.TrimSpace(cfg.User) == "" {
+		return fmt.Errorf("user must be not empty")
+	}
+	if cfg.Retry != 0 && cfg.Retry < minRetry {
+		return fmt.Errorf("retry must be greater or equals %d", minRetry)
+	}
+	if cfg.Expire < 0 || cfg.Expire > maxExpire {
+		return fmt.Errorf("expire must be between 0 and %d", maxExpire)
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+
+	return nil
+}
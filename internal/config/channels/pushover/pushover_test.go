@@ -0,0 +1,65 @@
+package pushover
+
+import "testing"
+
+func TestPushover_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Pushover
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			cfg:     Pushover{Token: "t", User: "u"},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty token",
+			cfg:     Pushover{Name: "foo", User: "u"},
+			wantErr: true,
+			errText: "token must be not empty",
+		},
+		{
+			name:    "empty user",
+			cfg:     Pushover{Name: "foo", Token: "t"},
+			wantErr: true,
+			errText: "user must be not empty",
+		},
+		{
+			name:    "small retry",
+			cfg:     Pushover{Name: "foo", Token: "t", User: "u", Retry: 10},
+			wantErr: true,
+			errText: "retry must be greater or equals 30",
+		},
+		{
+			name:    "big expire",
+			cfg:     Pushover{Name: "foo", Token: "t", User: "u", Expire: 20000},
+			wantErr: true,
+			errText: "expire must be between 0 and 10800",
+		},
+		{
+			name:    "bad timeout",
+			cfg:     Pushover{Name: "foo", Token: "t", User: "u", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater or equals 0",
+		},
+		{
+			name:    "ok",
+			cfg:     Pushover{Name: "foo", Token: "t", User: "u", Retry: 60, Expire: 3600},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package slack
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
 	"strings"
 )
 
@@ -13,7 +14,12 @@ type Slack struct {
 	Token string `json:"token" yaml:"token" hcl:"token"`
 	// Channel name
 	Channel string `json:"channel" yaml:"channel"  hcl:"channel"`
-	Ignore  bool   `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+	// Threads enables posting the alert updates as replies to the first message of the incident
+	// and updating the first message on resolve. The message timestamp is stored in the core KV storage
+	Threads bool `json:"threads" yaml:"threads" hcl:"threads,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
 }
 
 // Validate config
@@ -21,6 +27,10 @@ func (cfg Slack) Validate() error {
 	if strings.TrimSpace(cfg.Name) == "" {
 		return fmt.Errorf("name must be not empty")
 	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
 	if strings.TrimSpace(cfg.Token) == "" {
 		return fmt.Errorf("token must be not empty")
 	}
@@ -2,10 +2,21 @@ package syslog
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
 	"github.com/balerter/balerter/internal/util"
 	"strings"
 )
 
+const (
+	// FormatJSON writes JSON messages with the log/syslog package, it is the default format
+	FormatJSON = "json"
+	// FormatRFC5424 writes RFC 5424 messages, with octet-counting framing for tcp and tls
+	FormatRFC5424 = "rfc5424"
+
+	maxAppNameLen = 48
+	maxMsgIDLen   = 32
+)
+
 var (
 	syslogSeverity = []string{"EMERG", "ALERT", "CRIT", "ERR", "WARNING", "NOTICE", "INFO", "DEBUG"}
 	syslogFacility = []string{"KERN", "USER", "MAIL", "DAEMON", "AUTH", "SYSLOG",
@@ -25,7 +36,24 @@ type Syslog struct {
 	Address string `json:"address" yaml:"address" hcl:"address"`
 	// Priority value, Severity+Facility
 	Priority string `json:"priority" yaml:"priority" hcl:"priority"`
-	Ignore   bool   `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+	// Format is json (default) or rfc5424
+	Format string `json:"format" yaml:"format" hcl:"format,optional"`
+	// TLS config for the tls network
+	TLS *common.TLS `json:"tls" yaml:"tls" hcl:"tls,block"`
+	// AppName for rfc5424 format, default is 'balerter'
+	AppName string `json:"appName" yaml:"appName" hcl:"appName,optional"`
+	// MsgID for rfc5424 format, default is 'alert'
+	MsgID string `json:"msgId" yaml:"msgId" hcl:"msgId,optional"`
+	// Facility for rfc5424 format, default is USER. The severity is derived from the alert level
+	Facility string `json:"facility" yaml:"facility" hcl:"facility,optional"`
+	// EnterpriseNumber is the IANA private enterprise number used in the SD-IDs for rfc5424 format,
+	// e.g. alert@<number>. It is required for rfc5424 format
+	EnterpriseNumber int `json:"enterpriseNumber" yaml:"enterpriseNumber" hcl:"enterpriseNumber,optional"`
+	// Timeout of the connection and the write in milliseconds for rfc5424 format
+	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
 }
 
 // Validate config
@@ -34,8 +62,19 @@ func (cfg Syslog) Validate() error {
 		return fmt.Errorf("name must be not empty")
 	}
 
-	if !strings.EqualFold(cfg.Network, "tcp") && !strings.EqualFold(cfg.Network, "udp") && cfg.Network != "" {
-		return fmt.Errorf("corrent values for 'network': 'tcp', 'udp' or empty value")
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+
+	switch cfg.Format {
+	case "", FormatJSON:
+		if !strings.EqualFold(cfg.Network, "tcp") && !strings.EqualFold(cfg.Network, "udp") && cfg.Network != "" {
+			return fmt.Errorf("corrent values for 'network': 'tcp', 'udp' or empty value")
+		}
+	case FormatRFC5424:
+		return cfg.validateRFC5424()
+	default:
+		return fmt.Errorf("format must be json or rfc5424")
 	}
 
 	if err := validatePriority(cfg.Priority); err != nil {
@@ -45,6 +84,46 @@ func (cfg Syslog) Validate() error {
 	return nil
 }
 
+func (cfg Syslog) validateRFC5424() error {
+	n := strings.ToLower(cfg.Network)
+	if n != "tcp" && n != "udp" && n != "tls" {
+		return fmt.Errorf("corrent values for 'network': 'tcp', 'udp' or 'tls'")
+	}
+	if strings.TrimSpace(cfg.Address) == "" {
+		return fmt.Errorf("address must be not empty")
+	}
+	if cfg.Facility != "" && !util.InArray(cfg.Facility, syslogFacility) {
+		return fmt.Errorf("bad facility %s", cfg.Facility)
+	}
+	if !isPrintASCII(cfg.AppName) || len(cfg.AppName) > maxAppNameLen {
+		return fmt.Errorf("app name must be up to %d printable ascii characters", maxAppNameLen)
+	}
+	if !isPrintASCII(cfg.MsgID) || len(cfg.MsgID) > maxMsgIDLen {
+		return fmt.Errorf("msg id must be up to %d printable ascii characters", maxMsgIDLen)
+	}
+	if cfg.TLS != nil {
+		if err := cfg.TLS.Validate(); err != nil {
+			return fmt.Errorf("error validate tls, %w", err)
+		}
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals 0")
+	}
+	if cfg.EnterpriseNumber <= 0 {
+		return fmt.Errorf("enterprise number must be greater than 0")
+	}
+	return nil
+}
+
+func isPrintASCII(s string) bool {
+	for _, r := range s {
+		if r < 33 || r > 126 {
+			return false
+		}
+	}
+	return true
+}
+
 func validatePriority(p string) error {
 	if p == "" {
 		return nil
@@ -1,6 +1,10 @@
 package syslog
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/common"
+)
 
 func Test_validatePriority(t *testing.T) {
 	type args struct {
@@ -62,3 +66,48 @@ func Test_validatePriority(t *testing.T) {
 		})
 	}
 }
+
+func TestSyslog_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Syslog
+		errText string
+	}{
+		{name: "empty name", cfg: Syslog{}, errText: "name must be not empty"},
+		{name: "json bad network", cfg: Syslog{Name: "a", Network: "tls"}, errText: "corrent values for 'network': 'tcp', 'udp' or empty value"},
+		{name: "json ok", cfg: Syslog{Name: "a", Network: "tcp", Priority: "ERR|USER"}, errText: ""},
+		{name: "bad format", cfg: Syslog{Name: "a", Format: "foo"}, errText: "format must be json or rfc5424"},
+		{name: "rfc5424 empty network", cfg: Syslog{Name: "a", Format: "rfc5424"}, errText: "corrent values for 'network': 'tcp', 'udp' or 'tls'"},
+		{name: "rfc5424 empty address", cfg: Syslog{Name: "a", Format: "rfc5424", Network: "tls"}, errText: "address must be not empty"},
+		{name: "rfc5424 bad facility", cfg: Syslog{Name: "a", Format: "rfc5424", Network: "tcp", Address: "a:514", Facility: "FOO"},
+			errText: "bad facility FOO"},
+		{name: "rfc5424 bad app name", cfg: Syslog{Name: "a", Format: "rfc5424", Network: "tcp", Address: "a:514", AppName: "my app"},
+			errText: "app name must be up to 48 printable ascii characters"},
+		{name: "rfc5424 long msg id", cfg: Syslog{Name: "a", Format: "rfc5424", Network: "tcp", Address: "a:514", MsgID: "123456789012345678901234567890123"},
+			errText: "msg id must be up to 32 printable ascii characters"},
+		{name: "rfc5424 bad tls", cfg: Syslog{Name: "a", Format: "rfc5424", Network: "tls", Address: "a:6514", TLS: &common.TLS{Cert: "a"}},
+			errText: "error validate tls, cert and key must be set together"},
+		{name: "rfc5424 bad timeout", cfg: Syslog{Name: "a", Format: "rfc5424", Network: "udp", Address: "a:514", Timeout: -1},
+			errText: "timeout must be greater or equals 0"},
+		{name: "rfc5424 bad enterprise number", cfg: Syslog{Name: "a", Format: "rfc5424", Network: "udp", Address: "a:514", EnterpriseNumber: -1},
+			errText: "enterprise number must be greater than 0"},
+		{name: "rfc5424 empty enterprise number", cfg: Syslog{Name: "a", Format: "rfc5424", Network: "udp", Address: "a:514"},
+			errText: "enterprise number must be greater than 0"},
+		{name: "rfc5424 ok", cfg: Syslog{Name: "a", Format: "rfc5424", Network: "tls", Address: "a:6514", Facility: "LOCAL0",
+			AppName: "balerter", MsgID: "alert", EnterpriseNumber: 55555}, errText: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errText == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%v', wantErrText '%s'", err, tt.errText)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package telegram
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
 	"strings"
 )
 
@@ -17,6 +18,49 @@ type ProxyAuthConfig struct {
 	Password string `json:"password" yaml:"password" hcl:"password"`
 }
 
+// CallbacksConfig is config of receiving the inline buttons callbacks
+type CallbacksConfig struct {
+	// Mode is polling (default) or webhook
+	Mode string `json:"mode" yaml:"mode" hcl:"mode,optional"`
+	// Address to listen for the webhook updates, e.g. ':8443'
+	Address string `json:"address" yaml:"address" hcl:"address,optional"`
+	// URL is the public URL of the webhook, registered in the bot API on start
+	URL string `json:"url" yaml:"url" hcl:"url,optional"`
+	// Secret is checked in the X-Telegram-Bot-Api-Secret-Token header of the webhook requests
+	Secret string `json:"secret" yaml:"secret" hcl:"secret,optional"`
+}
+
+// Validate config
+func (cfg CallbacksConfig) Validate() error {
+	switch cfg.Mode {
+	case "", CallbacksModePolling:
+	case CallbacksModeWebhook:
+		if strings.TrimSpace(cfg.Address) == "" {
+			return fmt.Errorf("address must be not empty")
+		}
+		if strings.TrimSpace(cfg.URL) == "" {
+			return fmt.Errorf("url must be not empty")
+		}
+	default:
+		return fmt.Errorf("mode must be polling or webhook")
+	}
+	return nil
+}
+
+const (
+	// CallbacksModePolling receives callbacks with getUpdates long polling
+	CallbacksModePolling = "polling"
+	// CallbacksModeWebhook receives callbacks with the webhook
+	CallbacksModeWebhook = "webhook"
+
+	// ButtonAck acknowledges the alert
+	ButtonAck = "ack"
+	// ButtonSilence silences the alert for an hour
+	ButtonSilence = "silence"
+	// ButtonRerun runs the alert script again
+	ButtonRerun = "rerun"
+)
+
 // Telegram channel config
 type Telegram struct {
 	// Name of the channel
@@ -28,8 +72,16 @@ type Telegram struct {
 	// Proxy config, if proxy is needed
 	Proxy *ProxyConfig `json:"proxy" yaml:"proxy" hcl:"proxy,block"`
 	// Timeout value
-	Timeout int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
-	Ignore  bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+	Timeout int `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	// EditOnResolve edits the first alert message on the success instead of sending a new one
+	EditOnResolve bool `json:"editOnResolve" yaml:"editOnResolve" hcl:"editOnResolve,optional"`
+	// Buttons are inline keyboard buttons of the alert messages: ack, silence, rerun
+	Buttons []string `json:"buttons" yaml:"buttons" hcl:"buttons,optional"`
+	// Callbacks config of receiving the buttons callbacks
+	Callbacks *CallbacksConfig `json:"callbacks" yaml:"callbacks" hcl:"callbacks,block"`
+	Ignore    bool             `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
 }
 
 // Validate config
@@ -37,6 +89,10 @@ func (cfg Telegram) Validate() error {
 	if strings.TrimSpace(cfg.Name) == "" {
 		return fmt.Errorf("name must be not empty")
 	}
+
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
 	if strings.TrimSpace(cfg.Token) == "" {
 		return fmt.Errorf("token must be not empty")
 	}
@@ -46,6 +102,16 @@ func (cfg Telegram) Validate() error {
 	if cfg.Timeout < 0 {
 		return fmt.Errorf("timeout must be greater than 0")
 	}
+	for _, b := range cfg.Buttons {
+		if b != ButtonAck && b != ButtonSilence && b != ButtonRerun {
+			return fmt.Errorf("button must be ack, silence or rerun")
+		}
+	}
+	if cfg.Callbacks != nil {
+		if err := cfg.Callbacks.Validate(); err != nil {
+			return fmt.Errorf("error validate callbacks: %w", err)
+		}
+	}
 
 	return nil
 }
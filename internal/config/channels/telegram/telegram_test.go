@@ -6,10 +6,12 @@ import (
 
 func TestChannelTelegram_Validate(t *testing.T) {
 	type fields struct {
-		Name    string
-		Token   string
-		ChatID  int64
-		Timeout int
+		Name      string
+		Token     string
+		ChatID    int64
+		Timeout   int
+		Buttons   []string
+		Callbacks *CallbacksConfig
 	}
 	tests := []struct {
 		name    string
@@ -41,6 +43,37 @@ func TestChannelTelegram_Validate(t *testing.T) {
 			wantErr: true,
 			errText: "timeout must be greater than 0",
 		},
+		{
+			name:    "bad button",
+			fields:  fields{Name: "foo", Token: "foo", ChatID: 10, Buttons: []string{"ack", "foo"}},
+			wantErr: true,
+			errText: "button must be ack, silence or rerun",
+		},
+		{
+			name:    "bad callbacks mode",
+			fields:  fields{Name: "foo", Token: "foo", ChatID: 10, Callbacks: &CallbacksConfig{Mode: "foo"}},
+			wantErr: true,
+			errText: "error validate callbacks: mode must be polling or webhook",
+		},
+		{
+			name:    "webhook without address",
+			fields:  fields{Name: "foo", Token: "foo", ChatID: 10, Callbacks: &CallbacksConfig{Mode: "webhook"}},
+			wantErr: true,
+			errText: "error validate callbacks: address must be not empty",
+		},
+		{
+			name:    "webhook without url",
+			fields:  fields{Name: "foo", Token: "foo", ChatID: 10, Callbacks: &CallbacksConfig{Mode: "webhook", Address: ":8443"}},
+			wantErr: true,
+			errText: "error validate callbacks: url must be not empty",
+		},
+		{
+			name: "ok with buttons",
+			fields: fields{Name: "foo", Token: "foo", ChatID: 10, Buttons: []string{"ack", "silence", "rerun"},
+				Callbacks: &CallbacksConfig{Mode: "webhook", Address: ":8443", URL: "https://example.com/tg"}},
+			wantErr: false,
+			errText: "",
+		},
 		{
 			name:    "ok",
 			fields:  fields{Name: "foo", Token: "foo", ChatID: 10},
@@ -51,10 +84,12 @@ func TestChannelTelegram_Validate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := Telegram{
-				Name:    tt.fields.Name,
-				Token:   tt.fields.Token,
-				ChatID:  tt.fields.ChatID,
-				Timeout: tt.fields.Timeout,
+				Name:      tt.fields.Name,
+				Token:     tt.fields.Token,
+				ChatID:    tt.fields.ChatID,
+				Timeout:   tt.fields.Timeout,
+				Buttons:   tt.fields.Buttons,
+				Callbacks: tt.fields.Callbacks,
 			}
 			err := cfg.Validate()
 			if (err != nil) != tt.wantErr {
@@ -0,0 +1,69 @@
+package twiliosms
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"strings"
+)
+
+const (
+	minMaxLength = 10
+)
+
+// TwilioSMS channel config
+type TwilioSMS struct {
+	Name  string   `json:"name" yaml:"name" hcl:"name,label"`
+	SID   string   `json:"sid" yaml:"sid" hcl:"sid"`
+	Token string   `json:"token" yaml:"token" hcl:"token"`
+	From  string   `json:"from" yaml:"from" hcl:"from"`
+	To    []string `json:"to" yaml:"to" hcl:"to"`
+	// Template of the SMS body. Supports {LEVEL}, {ALERT_NAME}, {TEXT}, {IMAGE} and {FIELDS} placeholders
+	Template string `json:"template" yaml:"template" hcl:"template,optional"`
+	// MaxLength is a max length of one SMS in characters. By default, 160 for GSM-7 texts and 70 for others
+	MaxLength int `json:"maxLength" yaml:"maxLength" hcl:"maxLength,optional"`
+	// Split long texts to several SMS instead of truncation
+	Split bool `json:"split" yaml:"split" hcl:"split,optional"`
+	// MaxParts is a max count of SMS for one message if Split is enabled
+	MaxParts int  `json:"maxParts" yaml:"maxParts" hcl:"maxParts,optional"`
+	Ignore   bool `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+	Timeout  int  `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+func (tw TwilioSMS) Validate() error {
+	if strings.TrimSpace(tw.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+
+	if err := tw.Delivery.Validate(); err != nil {
+		return err
+	}
+	if tw.SID == "" {
+		return fmt.Errorf("sid must be not empty")
+	}
+	if tw.Token == "" {
+		return fmt.Errorf("token must be not empty")
+	}
+	if tw.From == "" {
+		return fmt.Errorf("from must be not empty")
+	}
+	if len(tw.To) == 0 {
+		return fmt.Errorf("to must be not empty")
+	}
+	for _, to := range tw.To {
+		if strings.TrimSpace(to) == "" {
+			return fmt.Errorf("to must not contain empty numbers")
+		}
+	}
+	if tw.MaxLength != 0 && tw.MaxLength < minMaxLength {
+		return fmt.Errorf("max length must be zero or greater or equals %d", minMaxLength)
+	}
+	if tw.MaxParts < 0 {
+		return fmt.Errorf("max parts must be greater or equals zero")
+	}
+	if tw.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater or equals zero")
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package twiliosms
+
+import "testing"
+
+func TestTwilioSMS_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      TwilioSMS
+		wantErr  bool
+		errValue string
+	}{
+		{
+			name:     "empty name",
+			cfg:      TwilioSMS{},
+			wantErr:  true,
+			errValue: "name must be not empty",
+		},
+		{
+			name:     "empty sid",
+			cfg:      TwilioSMS{Name: "1"},
+			wantErr:  true,
+			errValue: "sid must be not empty",
+		},
+		{
+			name:     "empty token",
+			cfg:      TwilioSMS{Name: "1", SID: "1"},
+			wantErr:  true,
+			errValue: "token must be not empty",
+		},
+		{
+			name:     "empty from",
+			cfg:      TwilioSMS{Name: "1", SID: "1", Token: "1"},
+			wantErr:  true,
+			errValue: "from must be not empty",
+		},
+		{
+			name:     "empty to",
+			cfg:      TwilioSMS{Name: "1", SID: "1", Token: "1", From: "1"},
+			wantErr:  true,
+			errValue: "to must be not empty",
+		},
+		{
+			name:     "empty number in to",
+			cfg:      TwilioSMS{Name: "1", SID: "1", Token: "1", From: "1", To: []string{"1", " "}},
+			wantErr:  true,
+			errValue: "to must not contain empty numbers",
+		},
+		{
+			name:     "bad max length",
+			cfg:      TwilioSMS{Name: "1", SID: "1", Token: "1", From: "1", To: []string{"1"}, MaxLength: 5},
+			wantErr:  true,
+			errValue: "max length must be zero or greater or equals 10",
+		},
+		{
+			name:     "bad max parts",
+			cfg:      TwilioSMS{Name: "1", SID: "1", Token: "1", From: "1", To: []string{"1"}, MaxParts: -1},
+			wantErr:  true,
+			errValue: "max parts must be greater or equals zero",
+		},
+		{
+			name:     "bad timeout",
+			cfg:      TwilioSMS{Name: "1", SID: "1", Token: "1", From: "1", To: []string{"1"}, Timeout: -1},
+			wantErr:  true,
+			errValue: "timeout must be greater or equals zero",
+		},
+		{
+			name:    "ok",
+			cfg:     TwilioSMS{Name: "1", SID: "1", Token: "1", From: "1", To: []string{"1", "2"}, Split: true},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errValue {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errValue)
+			}
+		})
+	}
+}
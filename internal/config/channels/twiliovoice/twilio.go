@@ -1,6 +1,10 @@
 package twiliovoice
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/balerter/balerter/internal/config/common"
+)
 
 type Twilio struct {
 	Name    string `json:"name" yaml:"name" hcl:"name,label"`
@@ -11,12 +15,18 @@ type Twilio struct {
 	TwiML   string `json:"twiml" yaml:"twiml" hcl:"twiml,optional"`
 	Ignore  bool   `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
 	Timeout int    `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
 }
 
 func (tw Twilio) Validate() error {
 	if tw.Name == "" {
 		return fmt.Errorf("name must be not empty")
 	}
+
+	if err := tw.Delivery.Validate(); err != nil {
+		return err
+	}
 	if tw.SID == "" {
 		return fmt.Errorf("sid must be not empty")
 	}
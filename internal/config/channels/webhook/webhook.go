@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+
+	"github.com/balerter/balerter/internal/config/common"
 )
 
 // AuthBasicConfig is basic auth config
@@ -30,11 +32,22 @@ type AuthCustomConfig struct {
 	QueryParams map[string]string `json:"queryParams" yaml:"queryParams" hcl:"queryParams,optional"`
 }
 
+// AuthHMACConfig is HMAC request signing config
+type AuthHMACConfig struct {
+	// Secret for the HMAC-SHA256 signature
+	Secret string `json:"secret" yaml:"secret" hcl:"secret,optional"`
+	// SignatureHeader is a header for the signature, X-Signature by default
+	SignatureHeader string `json:"signatureHeader" yaml:"signatureHeader" hcl:"signatureHeader,optional"`
+	// TimestampHeader is a header for the signed timestamp, X-Timestamp by default
+	TimestampHeader string `json:"timestampHeader" yaml:"timestampHeader" hcl:"timestampHeader,optional"`
+}
+
 // AuthConfig for requests with auth
 type AuthConfig struct {
 	AuthBasicConfig
 	AuthBearerConfig
 	AuthCustomConfig
+	AuthHMACConfig
 
 	// Type of the auth
 	Type string `json:"type" yaml:"type" hcl:"type"`
@@ -50,6 +63,8 @@ const (
 	AuthTypeBearer = "bearer"
 	// AuthTypeCustom use for custom auth
 	AuthTypeCustom = "custom"
+	// AuthTypeHMAC use for HMAC-SHA256 request signing
+	AuthTypeHMAC = "hmac"
 )
 
 // Validate checks the authorization configuration.
@@ -75,15 +90,56 @@ func (cfg AuthConfig) Validate() error {
 		return nil
 	case AuthTypeCustom:
 		return nil
+	case AuthTypeHMAC:
+		if cfg.AuthHMACConfig.Secret == "" {
+			return fmt.Errorf("secret must be not empty")
+		}
+		return nil
 	default:
-		return fmt.Errorf("type must be set to none, basic, bearer or custom")
+		return fmt.Errorf("type must be set to none, basic, bearer, custom or hmac")
 	}
 }
 
+// consts
+const (
+	// PayloadModeRaw use for the body with $level, $alert_name, $text, $image and $fields macros
+	PayloadModeRaw = "raw"
+	// PayloadModeJSON use for the JSON body built from the JSON or YAML template
+	PayloadModeJSON = "json"
+	// PayloadModeForm use for the form-encoded body built from the JSON or YAML template
+	PayloadModeForm = "form"
+)
+
 // PayloadConfig for POST requests
 type PayloadConfig struct {
 	QueryParams map[string]string `json:"queryParams" yaml:"queryParams" hcl:"queryParams,optional"`
 	Body        string            `json:"body" yaml:"body" hcl:"body,optional"`
+	// Mode of the body: raw (default), json or form.
+	// In the json and form modes the body is a JSON or YAML template with ${path} references
+	// (level, alert_name, text, image, fields, fields.<name>, alert.count, alert.start ...)
+	// and {"$if": "<condition>", "$then": ..., "$else": ...} conditional blocks
+	Mode string `json:"mode" yaml:"mode" hcl:"mode,optional"`
+	// LevelBodies overrides the Body for the level: success, warning or error
+	LevelBodies map[string]string `json:"levelBodies" yaml:"levelBodies" hcl:"levelBodies,optional"`
+}
+
+// Validate payload config
+func (cfg PayloadConfig) Validate() error {
+	switch cfg.Mode {
+	case "", PayloadModeRaw, PayloadModeJSON, PayloadModeForm:
+	default:
+		return fmt.Errorf("mode must be set to raw, json or form")
+	}
+
+	for level := range cfg.LevelBodies {
+		switch level {
+		case "success", "warning", "error":
+		default:
+			return fmt.Errorf("unknown level '%s' in levelBodies", level)
+		}
+	}
+
+	return nil
 }
 
 // Settings for webhook config
@@ -94,6 +150,8 @@ type Settings struct {
 	Payload PayloadConfig     `json:"payload" yaml:"payload" hcl:"payload,block"`
 	Timeout int               `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
 	Headers map[string]string `json:"headers" yaml:"headers" hcl:"headers,optional"`
+	// TLS configures the client certificate and the server verification
+	TLS *common.TLS `json:"tls" yaml:"tls" hcl:"tls,block"`
 }
 
 // Webhook configures notifications via webhook.
@@ -101,6 +159,8 @@ type Webhook struct {
 	Name     string   `json:"name" yaml:"name" hcl:"name,label"`
 	Settings Settings `json:"settings" yaml:"settings" hcl:"settings,block"`
 	Ignore   bool     `json:"ignore" yaml:"ignore" hcl:"ignore,optional"`
+
+	common.Delivery `json:",inline" yaml:",inline" hcl:",remain"`
 }
 
 // Validate checks the webhook configuration.
@@ -109,6 +169,10 @@ func (cfg Webhook) Validate() error {
 		return fmt.Errorf("name must be not empty")
 	}
 
+	if err := cfg.Delivery.Validate(); err != nil {
+		return err
+	}
+
 	return cfg.Settings.Validate()
 }
 
@@ -137,5 +201,15 @@ func (cfg Settings) Validate() error {
 			return fmt.Errorf("error validate auth: %w", err)
 		}
 	}
+
+	if err := cfg.Payload.Validate(); err != nil {
+		return fmt.Errorf("error validate payload: %w", err)
+	}
+
+	if cfg.TLS != nil {
+		if err := cfg.TLS.Validate(); err != nil {
+			return fmt.Errorf("error validate tls: %w", err)
+		}
+	}
 	return nil
 }
@@ -2,6 +2,8 @@ package webhook
 
 import (
 	"testing"
+
+	"github.com/balerter/balerter/internal/config/common"
 )
 
 func TestChannelWebhook_Validate(t *testing.T) {
@@ -12,6 +14,7 @@ func TestChannelWebhook_Validate(t *testing.T) {
 		Auth    AuthConfig
 		Payload PayloadConfig
 		Timeout int
+		TLS     *common.TLS
 	}
 	tests := []struct {
 		name    string
@@ -42,7 +45,7 @@ func TestChannelWebhook_Validate(t *testing.T) {
 			fields: fields{Name: "foo", URL: "https://foo.bar/baz",
 				Auth: AuthConfig{Type: "incorrect"}},
 			wantErr: true,
-			errText: "error validate auth: type must be set to none, basic, bearer or custom",
+			errText: "error validate auth: type must be set to none, basic, bearer, custom or hmac",
 		},
 		{
 			name: "empty login",
@@ -65,6 +68,20 @@ func TestChannelWebhook_Validate(t *testing.T) {
 			wantErr: true,
 			errText: "error validate auth: token must be not empty",
 		},
+		{
+			name: "empty hmac secret",
+			fields: fields{Name: "foo", URL: "https://foo.bar/baz",
+				Auth: AuthConfig{Type: "hmac"}},
+			wantErr: true,
+			errText: "error validate auth: secret must be not empty",
+		},
+		{
+			name: "bad tls",
+			fields: fields{Name: "foo", URL: "https://foo.bar/baz",
+				TLS: &common.TLS{Cert: "cert.pem"}},
+			wantErr: true,
+			errText: "error validate tls: cert and key must be set together",
+		},
 		{
 			name: "post basic ok",
 			fields: fields{
@@ -106,6 +123,41 @@ func TestChannelWebhook_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "bad payload mode",
+			fields: fields{Name: "foo", URL: "https://foo.bar/baz",
+				Payload: PayloadConfig{Mode: "xml"}},
+			wantErr: true,
+			errText: "error validate payload: mode must be set to raw, json or form",
+		},
+		{
+			name: "bad payload level",
+			fields: fields{Name: "foo", URL: "https://foo.bar/baz",
+				Payload: PayloadConfig{LevelBodies: map[string]string{"warn": "{}"}}},
+			wantErr: true,
+			errText: "error validate payload: unknown level 'warn' in levelBodies",
+		},
+		{
+			name: "post json ok",
+			fields: fields{Name: "foo", URL: "https://foo.bar/baz",
+				Payload: PayloadConfig{Mode: "json", Body: `{"text": "${text}"}`,
+					LevelBodies: map[string]string{"success": `{"resolved": true}`}}},
+			wantErr: false,
+		},
+		{
+			name: "post hmac ok",
+			fields: fields{
+				Name: "foo",
+				URL:  "https://foo.bar/baz",
+				Auth: AuthConfig{
+					Type:           "hmac",
+					AuthHMACConfig: AuthHMACConfig{Secret: "secret"},
+				},
+				Payload: PayloadConfig{Body: `{}`},
+				TLS:     &common.TLS{CA: "ca.pem", Cert: "cert.pem", Key: "key.pem"},
+			},
+			wantErr: false,
+		},
 		{
 			name: "get ok",
 			fields: fields{
@@ -129,6 +181,7 @@ func TestChannelWebhook_Validate(t *testing.T) {
 					Auth:    &tt.fields.Auth,
 					Payload: tt.fields.Payload,
 					Timeout: tt.fields.Timeout,
+					TLS:     tt.fields.TLS,
 				},
 			}
 			err := cfg.Validate()
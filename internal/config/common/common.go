@@ -1,5 +1,7 @@
 package common
 
+import "fmt"
+
 // BasicAuth use for prometheus and loki configurations
 type BasicAuth struct {
 	// Username for basic auth
@@ -7,3 +9,25 @@ type BasicAuth struct {
 	// Password for basic auth
 	Password string `json:"password" yaml:"password" hcl:"password"`
 }
+
+// TLS is a client TLS config
+type TLS struct {
+	// CA is a path to the CA certificate file
+	CA string `json:"ca" yaml:"ca" hcl:"ca,optional"`
+	// Cert is a path to the client certificate file
+	Cert string `json:"cert" yaml:"cert" hcl:"cert,optional"`
+	// Key is a path to the client key file
+	Key string `json:"key" yaml:"key" hcl:"key,optional"`
+	// ServerName overrides the server name for certificate verification
+	ServerName string `json:"serverName" yaml:"serverName" hcl:"serverName,optional"`
+	// InsecureSkipVerify disables server certificate verification
+	InsecureSkipVerify bool `json:"insecureSkipVerify" yaml:"insecureSkipVerify" hcl:"insecureSkipVerify,optional"`
+}
+
+// Validate TLS config
+func (cfg TLS) Validate() error {
+	if (cfg.Cert == "") != (cfg.Key == "") {
+		return fmt.Errorf("cert and key must be set together")
+	}
+	return nil
+}
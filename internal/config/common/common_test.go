@@ -0,0 +1,20 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLS_Validate(t *testing.T) {
+	err := TLS{Cert: "cert.pem"}.Validate()
+	require.Error(t, err)
+	assert.Equal(t, "cert and key must be set together", err.Error())
+
+	err = TLS{Key: "key.pem"}.Validate()
+	require.Error(t, err)
+
+	err = TLS{CA: "ca.pem", Cert: "cert.pem", Key: "key.pem"}.Validate()
+	require.NoError(t, err)
+}
@@ -0,0 +1,28 @@
+package common
+
+import "fmt"
+
+const (
+	// TimeFormatISO8601 converts the time values to the ISO-8601 strings
+	TimeFormatISO8601 = "iso8601"
+	// TimeFormatEpoch converts the time values to the unix timestamps in seconds
+	TimeFormatEpoch = "epoch"
+)
+
+// Conversion of the sql datasources query results
+type Conversion struct {
+	// TimeFormat of the time values: iso8601 (default) or epoch
+	TimeFormat string `json:"timeFormat" yaml:"timeFormat" hcl:"timeFormat,optional"`
+	// DecimalAsString returns the decimal values as strings to avoid the precision loss
+	DecimalAsString bool `json:"decimalAsString" yaml:"decimalAsString" hcl:"decimalAsString,optional"`
+}
+
+// Validate conversion config
+func (cfg Conversion) Validate() error {
+	switch cfg.TimeFormat {
+	case "", TimeFormatISO8601, TimeFormatEpoch:
+	default:
+		return fmt.Errorf("timeFormat must be %s or %s", TimeFormatISO8601, TimeFormatEpoch)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversion_Validate(t *testing.T) {
+	require.NoError(t, Conversion{}.Validate())
+	require.NoError(t, Conversion{TimeFormat: TimeFormatISO8601}.Validate())
+	require.NoError(t, Conversion{TimeFormat: TimeFormatEpoch, DecimalAsString: true}.Validate())
+
+	err := Conversion{TimeFormat: "unix"}.Validate()
+	require.Error(t, err)
+	assert.Equal(t, "timeFormat must be iso8601 or epoch", err.Error())
+}
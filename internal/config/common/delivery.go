@@ -0,0 +1,32 @@
+package common
+
+import (
+	"fmt"
+)
+
+// Delivery is the delivery config of the channel: the fallback channels, the rate limit and the quiet hours.
+// It is embedded into the channels configs with the `json:",inline" yaml:",inline" hcl:",remain"` tags,
+// so the fields are set on the channel level
+type Delivery struct {
+	// Fallback channels receive the message in order, if the channel fails to send it
+	Fallback []string `json:"fallback" yaml:"fallback" hcl:"fallback,optional"`
+	// RateLimit limits the count of the messages
+	RateLimit *RateLimit `json:"rateLimit" yaml:"rateLimit" hcl:"rateLimit,block"`
+	// QuietHours suppresses the low level messages in the time ranges
+	QuietHours *QuietHours `json:"quietHours" yaml:"quietHours" hcl:"quietHours,block"`
+}
+
+// Validate validates the rate limit and the quiet hours configs, if they are set
+func (cfg Delivery) Validate() error {
+	if cfg.RateLimit != nil {
+		if err := cfg.RateLimit.Validate(); err != nil {
+			return fmt.Errorf("error validate rateLimit: %w", err)
+		}
+	}
+	if cfg.QuietHours != nil {
+		if err := cfg.QuietHours.Validate(); err != nil {
+			return fmt.Errorf("error validate quietHours: %w", err)
+		}
+	}
+	return nil
+}
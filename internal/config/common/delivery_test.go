@@ -0,0 +1,100 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+type testChannel struct {
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	URL  string `json:"url" yaml:"url" hcl:"url"`
+
+	Delivery `json:",inline" yaml:",inline" hcl:",remain"`
+}
+
+type testChannels struct {
+	Channels []testChannel `json:"channels" yaml:"channels" hcl:"channel,block"`
+}
+
+func TestDelivery_Validate(t *testing.T) {
+	require.NoError(t, Delivery{}.Validate())
+
+	err := Delivery{RateLimit: &RateLimit{}}.Validate()
+	require.Error(t, err)
+	assert.Equal(t, "error validate rateLimit: messages must be greater than 0", err.Error())
+
+	err = Delivery{QuietHours: &QuietHours{}}.Validate()
+	require.Error(t, err)
+	assert.Equal(t, "error validate quietHours: ranges must be not empty", err.Error())
+}
+
+func assertTestChannel(t *testing.T, ch testChannel) {
+	assert.Equal(t, "foo", ch.Name)
+	assert.Equal(t, "http://example.com", ch.URL)
+	assert.Equal(t, []string{"bar", "baz"}, ch.Fallback)
+	assert.Equal(t, &RateLimit{Messages: 10, Interval: 60000}, ch.RateLimit)
+	assert.Equal(t, &QuietHours{Ranges: []string{"22:00-07:00"}, MinLevel: "error"}, ch.QuietHours)
+}
+
+func TestDelivery_decode_hcl(t *testing.T) {
+	cfg := &testChannels{}
+	err := hclsimple.Decode("config.hcl", []byte(`
+channel "foo" {
+  url = "http://example.com"
+  fallback = ["bar", "baz"]
+  rateLimit {
+    messages = 10
+    interval = 60000
+  }
+  quietHours {
+    ranges = ["22:00-07:00"]
+    minLevel = "error"
+  }
+}
+`), nil, cfg)
+	require.NoError(t, err)
+	require.Len(t, cfg.Channels, 1)
+	assertTestChannel(t, cfg.Channels[0])
+
+	err = hclsimple.Decode("config.hcl", []byte(`
+channel "foo" {
+  url = "http://example.com"
+  unknown = 1
+}
+`), nil, cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `An argument named "unknown" is not expected here`)
+}
+
+func TestDelivery_decode_yaml(t *testing.T) {
+	cfg := &testChannels{}
+	err := yaml.Unmarshal([]byte(`
+channels:
+  - name: foo
+    url: http://example.com
+    fallback:
+      - bar
+      - baz
+    rateLimit:
+      messages: 10
+      interval: 60000
+    quietHours:
+      ranges:
+        - 22:00-07:00
+      minLevel: error
+`), cfg)
+	require.NoError(t, err)
+	require.Len(t, cfg.Channels, 1)
+	assertTestChannel(t, cfg.Channels[0])
+}
+
+func TestDelivery_json(t *testing.T) {
+	data, err := json.Marshal(testChannel{Name: "foo", Delivery: Delivery{Fallback: []string{"bar"}}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"foo","url":"","fallback":["bar"],"rateLimit":null,"quietHours":null}`, string(data))
+}
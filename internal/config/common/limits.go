@@ -0,0 +1,93 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is a token bucket rate limit of the channel messages
+type RateLimit struct {
+	// Messages is a count of the messages allowed per the interval, it is the size of the bucket as well
+	Messages int `json:"messages" yaml:"messages" hcl:"messages"`
+	// Interval in ms
+	Interval int `json:"interval" yaml:"interval" hcl:"interval"`
+}
+
+// Validate rate limit config
+func (cfg RateLimit) Validate() error {
+	if cfg.Messages <= 0 {
+		return fmt.Errorf("messages must be greater than 0")
+	}
+	if cfg.Interval <= 0 {
+		return fmt.Errorf("interval must be greater than 0")
+	}
+	return nil
+}
+
+// QuietHours suppresses the messages below the min level in the time ranges
+type QuietHours struct {
+	// Ranges of the time like 22:00-07:00
+	Ranges []string `json:"ranges" yaml:"ranges" hcl:"ranges"`
+	// Timezone is the IANA time zone name, e.g. Europe/Berlin. UTC by default
+	Timezone string `json:"timezone" yaml:"timezone" hcl:"timezone,optional"`
+	// MinLevel is the lowest level of the messages, which are sent in the quiet hours: success, warning or error (default)
+	MinLevel string `json:"minLevel" yaml:"minLevel" hcl:"minLevel,optional"`
+}
+
+// Validate quiet hours config
+func (cfg QuietHours) Validate() error {
+	if len(cfg.Ranges) == 0 {
+		return fmt.Errorf("ranges must be not empty")
+	}
+	for _, r := range cfg.Ranges {
+		if _, _, err := ParseTimeRange(r); err != nil {
+			return err
+		}
+	}
+	if _, err := time.LoadLocation(cfg.Timezone); err != nil {
+		return fmt.Errorf("error load timezone, %w", err)
+	}
+	switch cfg.MinLevel {
+	case "", "success", "warning", "error":
+	default:
+		return fmt.Errorf("minLevel must be success, warning or error")
+	}
+	return nil
+}
+
+// ParseTimeRange parses the range like 22:00-07:00 and returns the minutes from the midnight of the start and the end
+func ParseTimeRange(s string) (start, end int, err error) {
+	from, to, ok := strings.Cut(s, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("bad time range '%s', must be like 22:00-07:00", s)
+	}
+
+	start, err = parseClock(strings.TrimSpace(from))
+	if err != nil {
+		return 0, 0, fmt.Errorf("bad time range '%s', %w", s, err)
+	}
+	end, err = parseClock(strings.TrimSpace(to))
+	if err != nil {
+		return 0, 0, fmt.Errorf("bad time range '%s', %w", s, err)
+	}
+
+	return start, end, nil
+}
+
+func parseClock(s string) (int, error) {
+	h, m, ok := strings.Cut(s, ":")
+	if !ok {
+		return 0, fmt.Errorf("bad time '%s'", s)
+	}
+	hours, err := strconv.Atoi(h)
+	if err != nil || hours < 0 || hours > 23 {
+		return 0, fmt.Errorf("bad time '%s'", s)
+	}
+	minutes, err := strconv.Atoi(m)
+	if err != nil || minutes < 0 || minutes > 59 || len(m) != 2 {
+		return 0, fmt.Errorf("bad time '%s'", s)
+	}
+	return hours*60 + minutes, nil //nolint:gomnd // minutes in the hour
+}
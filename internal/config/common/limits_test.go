@@ -0,0 +1,56 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit_Validate(t *testing.T) {
+	err := RateLimit{Interval: 1000}.Validate()
+	require.Error(t, err)
+	assert.Equal(t, "messages must be greater than 0", err.Error())
+
+	err = RateLimit{Messages: 10}.Validate()
+	require.Error(t, err)
+	assert.Equal(t, "interval must be greater than 0", err.Error())
+
+	require.NoError(t, RateLimit{Messages: 10, Interval: 1000}.Validate())
+}
+
+func TestQuietHours_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     QuietHours
+		errText string
+	}{
+		{name: "empty ranges", cfg: QuietHours{}, errText: "ranges must be not empty"},
+		{name: "bad range", cfg: QuietHours{Ranges: []string{"22:00"}}, errText: "bad time range '22:00', must be like 22:00-07:00"},
+		{name: "bad time", cfg: QuietHours{Ranges: []string{"22:00-24:00"}}, errText: "bad time range '22:00-24:00', bad time '24:00'"},
+		{name: "bad minutes", cfg: QuietHours{Ranges: []string{"22:0-23:00"}}, errText: "bad time range '22:0-23:00', bad time '22:0'"},
+		{name: "bad timezone", cfg: QuietHours{Ranges: []string{"22:00-07:00"}, Timezone: "Foo/Bar"},
+			errText: "error load timezone, unknown time zone Foo/Bar"},
+		{name: "bad level", cfg: QuietHours{Ranges: []string{"22:00-07:00"}, MinLevel: "foo"},
+			errText: "minLevel must be success, warning or error"},
+		{name: "ok", cfg: QuietHours{Ranges: []string{"22:00-07:00", "12:00 - 13:00"}, Timezone: "UTC", MinLevel: "warning"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errText == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.errText, err.Error())
+		})
+	}
+}
+
+func TestParseTimeRange(t *testing.T) {
+	start, end, err := ParseTimeRange("22:30-07:05")
+	require.NoError(t, err)
+	assert.Equal(t, 22*60+30, start)
+	assert.Equal(t, 7*60+5, end)
+}
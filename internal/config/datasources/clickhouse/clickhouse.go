@@ -2,6 +2,7 @@ package clickhouse
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
 	"strings"
 )
 
@@ -23,6 +24,8 @@ type Clickhouse struct {
 	SSLCertPath string `json:"sslCertPath" yaml:"sslCertPath" hcl:"sslCertPath,optional"`
 	// Timeout connection value
 	Timeout int `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	// Conversion of the query result values
+	Conversion *common.Conversion `json:"conversion" yaml:"conversion" hcl:"conversion,block"`
 }
 
 // Validate config
@@ -41,5 +44,11 @@ func (cfg Clickhouse) Validate() error {
 		return fmt.Errorf("timeout must be greater than 0")
 	}
 
+	if cfg.Conversion != nil {
+		if err := cfg.Conversion.Validate(); err != nil {
+			return fmt.Errorf("error validate conversion: %w", err)
+		}
+	}
+
 	return nil
 }
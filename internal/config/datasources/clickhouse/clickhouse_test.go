@@ -2,6 +2,8 @@ package clickhouse
 
 import (
 	"testing"
+
+	"github.com/balerter/balerter/internal/config/common"
 )
 
 func TestDataSourceClickhouse_Validate(t *testing.T) {
@@ -14,6 +16,7 @@ func TestDataSourceClickhouse_Validate(t *testing.T) {
 		Database    string
 		SSLCertPath string
 		Timeout     int
+		Conversion  *common.Conversion
 	}
 	tests := []struct {
 		name    string
@@ -51,6 +54,12 @@ func TestDataSourceClickhouse_Validate(t *testing.T) {
 			wantErr: false,
 			errText: "",
 		},
+		{
+			name:    "wrong conversion",
+			fields:  fields{Name: "a", Host: "a", Port: 10, Conversion: &common.Conversion{TimeFormat: "foo"}},
+			wantErr: true,
+			errText: "error validate conversion: timeFormat must be iso8601 or epoch",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -63,6 +72,7 @@ func TestDataSourceClickhouse_Validate(t *testing.T) {
 				Database:    tt.fields.Database,
 				SSLCertPath: tt.fields.SSLCertPath,
 				Timeout:     tt.fields.Timeout,
+				Conversion:  tt.fields.Conversion,
 			}
 			err := cfg.Validate()
 			if (err != nil) != tt.wantErr {
@@ -3,10 +3,12 @@ package datasources
 import (
 	"fmt"
 	"github.com/balerter/balerter/internal/config/datasources/clickhouse"
+	"github.com/balerter/balerter/internal/config/datasources/influxdb"
 	"github.com/balerter/balerter/internal/config/datasources/loki"
 	"github.com/balerter/balerter/internal/config/datasources/mysql"
 	"github.com/balerter/balerter/internal/config/datasources/postgres"
 	"github.com/balerter/balerter/internal/config/datasources/prometheus"
+	"github.com/balerter/balerter/internal/config/datasources/sqlite"
 	"github.com/balerter/balerter/internal/util"
 )
 
@@ -20,8 +22,12 @@ type DataSources struct {
 	Postgres []postgres.Postgres `json:"postgres" yaml:"postgres" hcl:"postgres,block"`
 	// MySQL for mysql data sources
 	MySQL []mysql.Mysql `json:"mysql" yaml:"mysql" hcl:"mysql,block"`
+	// Sqlite for sqlite data sources
+	Sqlite []sqlite.Sqlite `json:"sqlite" yaml:"sqlite" hcl:"sqlite,block"`
 	// Loki for Loki data sources
 	Loki []loki.Loki `json:"loki" yaml:"loki" hcl:"loki,block"`
+	// InfluxDB for influxdb data sources
+	InfluxDB []influxdb.InfluxDB `json:"influxdb" yaml:"influxdb" hcl:"influxdb,block"`
 }
 
 // Validate config
@@ -71,6 +77,17 @@ func (cfg DataSources) Validate() error {
 		return fmt.Errorf("found duplicated name for datasource 'mysql': %s", name)
 	}
 
+	names = names[:0]
+	for _, c := range cfg.Sqlite {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for datasource 'sqlite': %s", name)
+	}
+
 	names = names[:0]
 	for _, c := range cfg.Loki {
 		names = append(names, c.Name)
@@ -82,5 +99,16 @@ func (cfg DataSources) Validate() error {
 		return fmt.Errorf("found duplicated name for datasource 'loki': %s", name)
 	}
 
+	names = names[:0]
+	for _, c := range cfg.InfluxDB {
+		names = append(names, c.Name)
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	if name := util.CheckUnique(names); name != "" {
+		return fmt.Errorf("found duplicated name for datasource 'influxdb': %s", name)
+	}
+
 	return nil
 }
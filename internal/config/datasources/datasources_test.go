@@ -2,10 +2,12 @@ package datasources
 
 import (
 	"github.com/balerter/balerter/internal/config/datasources/clickhouse"
+	"github.com/balerter/balerter/internal/config/datasources/influxdb"
 	"github.com/balerter/balerter/internal/config/datasources/loki"
 	"github.com/balerter/balerter/internal/config/datasources/mysql"
 	"github.com/balerter/balerter/internal/config/datasources/postgres"
 	"github.com/balerter/balerter/internal/config/datasources/prometheus"
+	"github.com/balerter/balerter/internal/config/datasources/sqlite"
 	"testing"
 )
 
@@ -15,7 +17,9 @@ func TestDataSources_Validate(t *testing.T) {
 		Prometheus []prometheus.Prometheus
 		Postgres   []postgres.Postgres
 		MySQL      []mysql.Mysql
+		Sqlite     []sqlite.Sqlite
 		Loki       []loki.Loki
+		InfluxDB   []influxdb.InfluxDB
 	}
 	tests := []struct {
 		name    string
@@ -55,6 +59,14 @@ func TestDataSources_Validate(t *testing.T) {
 			wantErr: true,
 			errText: "found duplicated name for datasource 'mysql': a",
 		},
+		{
+			name: "duplicated sqlite",
+			fields: fields{
+				Sqlite: []sqlite.Sqlite{{Name: "a", Path: "a"}, {Name: "a", Path: "a"}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for datasource 'sqlite': a",
+		},
 		{
 			name: "duplicated loki",
 			fields: fields{
@@ -63,6 +75,14 @@ func TestDataSources_Validate(t *testing.T) {
 			wantErr: true,
 			errText: "found duplicated name for datasource 'loki': a",
 		},
+		{
+			name: "duplicated influxdb",
+			fields: fields{
+				InfluxDB: []influxdb.InfluxDB{{Name: "a", URL: "a", Version: 1}, {Name: "a", URL: "a", Version: 1}},
+			},
+			wantErr: true,
+			errText: "found duplicated name for datasource 'influxdb': a",
+		},
 		{
 			name: "ok",
 			fields: fields{
@@ -70,7 +90,9 @@ func TestDataSources_Validate(t *testing.T) {
 				Prometheus: []prometheus.Prometheus{{Name: "a", URL: "a"}, {Name: "a2", URL: "a"}},
 				Postgres:   []postgres.Postgres{{Name: "a", Host: "a", Port: 10}, {Name: "a2", Host: "a", Port: 10}},
 				MySQL:      []mysql.Mysql{{Name: "a", DSN: "a"}, {Name: "a2", DSN: "a"}},
+				Sqlite:     []sqlite.Sqlite{{Name: "a", Path: "a"}, {Name: "a2", Path: "a"}},
 				Loki:       []loki.Loki{{Name: "a", URL: "a"}, {Name: "a2", URL: "a"}},
+				InfluxDB:   []influxdb.InfluxDB{{Name: "a", URL: "a", Version: 1}, {Name: "a2", URL: "a", Org: "org"}},
 			},
 			wantErr: false,
 			errText: "",
@@ -83,7 +105,9 @@ func TestDataSources_Validate(t *testing.T) {
 				Prometheus: tt.fields.Prometheus,
 				Postgres:   tt.fields.Postgres,
 				MySQL:      tt.fields.MySQL,
+				Sqlite:     tt.fields.Sqlite,
 				Loki:       tt.fields.Loki,
+				InfluxDB:   tt.fields.InfluxDB,
 			}
 			err := cfg.Validate()
 			if (err != nil) != tt.wantErr {
@@ -0,0 +1,58 @@
+package influxdb
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"strings"
+)
+
+const (
+	// Version1 is InfluxDB 1.x with InfluxQL queries
+	Version1 = 1
+	// Version2 is InfluxDB 2.x with Flux queries
+	Version2 = 2
+)
+
+// InfluxDB datasource config
+type InfluxDB struct {
+	// Name of the datasource
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// URL value
+	URL string `json:"url" yaml:"url" hcl:"url"`
+	// Version of the API: 1 for InfluxQL queries, 2 for Flux queries. 2 by default
+	Version int `json:"version" yaml:"version" hcl:"version,optional"`
+	// Database is the default database for InfluxQL queries
+	Database string `json:"database" yaml:"database" hcl:"database,optional"`
+	// BasicAuth contains auth data for InfluxQL queries, if needed
+	BasicAuth *common.BasicAuth `json:"basicAuth" yaml:"basicAuth" hcl:"basicAuth,block"`
+	// Org is the organization for Flux queries
+	Org string `json:"org" yaml:"org" hcl:"org,optional"`
+	// Token is the API token
+	Token string `json:"token" yaml:"token" hcl:"token,optional"`
+	// Timeout value
+	Timeout int `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+}
+
+// Validate config
+func (cfg InfluxDB) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+	if strings.TrimSpace(cfg.URL) == "" {
+		return fmt.Errorf("url must be not empty")
+	}
+	switch cfg.Version {
+	case 0, Version2:
+		if strings.TrimSpace(cfg.Org) == "" {
+			return fmt.Errorf("org must be not empty for the version 2")
+		}
+	case Version1:
+	default:
+		return fmt.Errorf("version must be 1 or 2")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package influxdb
+
+import (
+	"testing"
+)
+
+func TestDataSourceInfluxDB_Validate(t *testing.T) {
+	type fields struct {
+		Name    string
+		URL     string
+		Version int
+		Org     string
+		Timeout int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			fields:  fields{Name: "", URL: ""},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty url",
+			fields:  fields{Name: "a", URL: ""},
+			wantErr: true,
+			errText: "url must be not empty",
+		},
+		{
+			name:    "empty org",
+			fields:  fields{Name: "a", URL: "a"},
+			wantErr: true,
+			errText: "org must be not empty for the version 2",
+		},
+		{
+			name:    "wrong version",
+			fields:  fields{Name: "a", URL: "a", Version: 3},
+			wantErr: true,
+			errText: "version must be 1 or 2",
+		},
+		{
+			name:    "wrong timeout",
+			fields:  fields{Name: "a", URL: "a", Version: 1, Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater than 0",
+		},
+		{
+			name:    "ok v1",
+			fields:  fields{Name: "a", URL: "a", Version: 1},
+			wantErr: false,
+			errText: "",
+		},
+		{
+			name:    "ok v2",
+			fields:  fields{Name: "a", URL: "a", Org: "org"},
+			wantErr: false,
+			errText: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := InfluxDB{
+				Name:    tt.fields.Name,
+				URL:     tt.fields.URL,
+				Version: tt.fields.Version,
+				Org:     tt.fields.Org,
+				Timeout: tt.fields.Timeout,
+			}
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
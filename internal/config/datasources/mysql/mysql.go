@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
 	"strings"
 )
 
@@ -13,6 +14,8 @@ type Mysql struct {
 	DSN string `json:"dsn" yaml:"dsn" hcl:"dsn"`
 	// Timeout value
 	Timeout int `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	// Conversion of the query result values
+	Conversion *common.Conversion `json:"conversion" yaml:"conversion" hcl:"conversion,block"`
 }
 
 // Validate config
@@ -27,5 +30,11 @@ func (cfg Mysql) Validate() error {
 		return fmt.Errorf("timeout must be greater than 0")
 	}
 
+	if cfg.Conversion != nil {
+		if err := cfg.Conversion.Validate(); err != nil {
+			return fmt.Errorf("error validate conversion: %w", err)
+		}
+	}
+
 	return nil
 }
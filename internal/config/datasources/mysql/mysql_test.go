@@ -2,13 +2,16 @@ package mysql
 
 import (
 	"testing"
+
+	"github.com/balerter/balerter/internal/config/common"
 )
 
 func TestDataSourceMysql_Validate(t *testing.T) {
 	type fields struct {
-		Name    string
-		DSN     string
-		Timeout int
+		Name       string
+		DSN        string
+		Timeout    int
+		Conversion *common.Conversion
 	}
 	tests := []struct {
 		name    string
@@ -40,13 +43,20 @@ func TestDataSourceMysql_Validate(t *testing.T) {
 			wantErr: false,
 			errText: "",
 		},
+		{
+			name:    "wrong conversion",
+			fields:  fields{Name: "foo", DSN: "a", Conversion: &common.Conversion{TimeFormat: "foo"}},
+			wantErr: true,
+			errText: "error validate conversion: timeFormat must be iso8601 or epoch",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := Mysql{
-				Name:    tt.fields.Name,
-				DSN:     tt.fields.DSN,
-				Timeout: tt.fields.Timeout,
+				Name:       tt.fields.Name,
+				DSN:        tt.fields.DSN,
+				Timeout:    tt.fields.Timeout,
+				Conversion: tt.fields.Conversion,
 			}
 			err := cfg.Validate()
 			if (err != nil) != tt.wantErr {
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
 	"strings"
 )
 
@@ -25,6 +26,8 @@ type Postgres struct {
 	SSLCertPath string `json:"sslCertPath" yaml:"sslCertPath" hcl:"sslCertPath,optional"`
 	// Timeout value
 	Timeout int `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	// Conversion of the query result values
+	Conversion *common.Conversion `json:"conversion" yaml:"conversion" hcl:"conversion,block"`
 }
 
 // Validate config
@@ -43,5 +46,11 @@ func (cfg Postgres) Validate() error {
 		return fmt.Errorf("timeout must be greater than 0")
 	}
 
+	if cfg.Conversion != nil {
+		if err := cfg.Conversion.Validate(); err != nil {
+			return fmt.Errorf("error validate conversion: %w", err)
+		}
+	}
+
 	return nil
 }
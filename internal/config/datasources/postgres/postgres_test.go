@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"testing"
+
+	"github.com/balerter/balerter/internal/config/common"
 )
 
 func TestDataSourcePostgres_Validate(t *testing.T) {
@@ -15,6 +17,7 @@ func TestDataSourcePostgres_Validate(t *testing.T) {
 		SSLMode     string
 		SSLCertPath string
 		Timeout     int
+		Conversion  *common.Conversion
 	}
 	tests := []struct {
 		name    string
@@ -52,6 +55,12 @@ func TestDataSourcePostgres_Validate(t *testing.T) {
 			wantErr: false,
 			errText: "",
 		},
+		{
+			name:    "wrong conversion",
+			fields:  fields{Name: "a", Host: "a", Port: 10, Conversion: &common.Conversion{TimeFormat: "foo"}},
+			wantErr: true,
+			errText: "error validate conversion: timeFormat must be iso8601 or epoch",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -65,6 +74,7 @@ func TestDataSourcePostgres_Validate(t *testing.T) {
 				SSLMode:     tt.fields.SSLMode,
 				SSLCertPath: tt.fields.SSLCertPath,
 				Timeout:     tt.fields.Timeout,
+				Conversion:  tt.fields.Conversion,
 			}
 			err := cfg.Validate()
 			if (err != nil) != tt.wantErr {
@@ -0,0 +1,47 @@
+package sqlite
+
+import (
+	"fmt"
+	"github.com/balerter/balerter/internal/config/common"
+	"strings"
+)
+
+// Sqlite datasource config
+type Sqlite struct {
+	// Name of the datasource
+	Name string `json:"name" yaml:"name" hcl:"name,label"`
+	// Path to database file
+	Path string `json:"path" yaml:"path" hcl:"path"`
+	// ReadOnly opens the database file in the read-only mode
+	ReadOnly bool `json:"readOnly" yaml:"readOnly" hcl:"readOnly,optional"`
+	// BusyTimeout is the time in milliseconds to wait for a locked database
+	BusyTimeout int `json:"busyTimeout" yaml:"busyTimeout" hcl:"busyTimeout,optional"`
+	// Timeout value
+	Timeout int `json:"timeout" yaml:"timeout" hcl:"timeout,optional"`
+	// Conversion of the query result values
+	Conversion *common.Conversion `json:"conversion" yaml:"conversion" hcl:"conversion,block"`
+}
+
+// Validate config
+func (cfg Sqlite) Validate() error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("name must be not empty")
+	}
+	if strings.TrimSpace(cfg.Path) == "" {
+		return fmt.Errorf("path must be not empty")
+	}
+	if cfg.BusyTimeout < 0 {
+		return fmt.Errorf("busyTimeout must be greater than 0")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must be greater than 0")
+	}
+
+	if cfg.Conversion != nil {
+		if err := cfg.Conversion.Validate(); err != nil {
+			return fmt.Errorf("error validate conversion: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/common"
+)
+
+func TestDataSourceSqlite_Validate(t *testing.T) {
+	type fields struct {
+		Name        string
+		Path        string
+		BusyTimeout int
+		Timeout     int
+		Conversion  *common.Conversion
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+		errText string
+	}{
+		{
+			name:    "empty name",
+			fields:  fields{Name: "", Path: ""},
+			wantErr: true,
+			errText: "name must be not empty",
+		},
+		{
+			name:    "empty path",
+			fields:  fields{Name: "foo", Path: ""},
+			wantErr: true,
+			errText: "path must be not empty",
+		},
+		{
+			name:    "wrong busy timeout",
+			fields:  fields{Name: "foo", Path: "a", BusyTimeout: -1},
+			wantErr: true,
+			errText: "busyTimeout must be greater than 0",
+		},
+		{
+			name:    "wrong timeout",
+			fields:  fields{Name: "foo", Path: "a", Timeout: -1},
+			wantErr: true,
+			errText: "timeout must be greater than 0",
+		},
+		{
+			name:    "ok",
+			fields:  fields{Name: "foo", Path: "a", BusyTimeout: 1000},
+			wantErr: false,
+			errText: "",
+		},
+		{
+			name:    "wrong conversion",
+			fields:  fields{Name: "foo", Path: "a", Conversion: &common.Conversion{TimeFormat: "foo"}},
+			wantErr: true,
+			errText: "error validate conversion: timeFormat must be iso8601 or epoch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Sqlite{
+				Name:        tt.fields.Name,
+				Path:        tt.fields.Path,
+				BusyTimeout: tt.fields.BusyTimeout,
+				Timeout:     tt.fields.Timeout,
+				Conversion:  tt.fields.Conversion,
+			}
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.errText {
+				t.Errorf("Validate() error = '%s', wantErrText '%s'", err.Error(), tt.errText)
+			}
+		})
+	}
+}
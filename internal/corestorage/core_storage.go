@@ -1,6 +1,7 @@
 package corestorage
 
 import (
+	"errors"
 	"github.com/balerter/balerter/internal/alert"
 	"net/http"
 )
@@ -9,6 +10,9 @@ import (
 //go:generate moq -out module_kv.go -skip-ensure -fmt goimports . KV
 //go:generate moq -out module_core_storage.go -skip-ensure -fmt goimports . CoreStorage
 
+// ErrKVNotFound is returned by KV.Get if the key does not exist
+var ErrKVNotFound = errors.New("variable not exists")
+
 // KV is an interface for KV storage
 type KV interface {
 	Put(string, string) error
@@ -19,6 +23,18 @@ type KV interface {
 	RunApiHandler(rw http.ResponseWriter, req *http.Request)
 }
 
+// Lookup returns the value of the key. Found is false if the key does not exist, the error is returned for the storage errors only
+func Lookup(kv KV, key string) (value string, found bool, err error) {
+	value, err = kv.Get(key)
+	if errors.Is(err, ErrKVNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return value, true, nil
+}
+
 // Alert is an interface for Alert storage
 type Alert interface {
 	// Update exists alert or create new
@@ -0,0 +1,38 @@
+package corestorage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	kv := &KVMock{
+		GetFunc: func(s string) (string, error) {
+			switch s {
+			case "foo":
+				return "bar", nil
+			case "err":
+				return "", fmt.Errorf("err1")
+			}
+			return "", ErrKVNotFound
+		},
+	}
+
+	v, found, err := Lookup(kv, "foo")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "bar", v)
+
+	v, found, err = Lookup(kv, "baz")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, "", v)
+
+	_, found, err = Lookup(kv, "err")
+	require.Error(t, err)
+	assert.False(t, found)
+	assert.Equal(t, "err1", err.Error())
+}
@@ -1,6 +1,10 @@
 package memory
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/balerter/balerter/internal/corestorage"
+)
 
 func (m *storageKV) Put(name, val string) error {
 	m.mxKV.Lock()
@@ -30,7 +34,7 @@ func (m *storageKV) Get(name string) (string, error) {
 
 	v, ok := m.kv[name]
 	if !ok {
-		return "", fmt.Errorf("variable not exists")
+		return "", corestorage.ErrKVNotFound
 	}
 
 	return v, nil
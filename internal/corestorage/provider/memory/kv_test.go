@@ -1,6 +1,7 @@
 package memory
 
 import (
+	"github.com/balerter/balerter/internal/corestorage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -57,6 +58,7 @@ func TestMemory_Get(t *testing.T) {
 
 	_, err := m.Get("foo")
 	require.Error(t, err)
+	assert.ErrorIs(t, err, corestorage.ErrKVNotFound)
 
 	m.kv["foo"] = "bar"
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/balerter/balerter/internal/config/storages/core/tables"
+	"github.com/balerter/balerter/internal/corestorage"
 	"net/http"
 	"time"
 
@@ -13,8 +14,8 @@ import (
 )
 
 var (
-	// ErrNoRow returns if rows is not found
-	ErrNoRow = errors.New("not found")
+	// ErrNoRow returns if rows is not found, it is the same as for the other KV storages
+	ErrNoRow = corestorage.ErrKVNotFound
 )
 
 // PostgresKV represent the Postgres implementation of the KV storage
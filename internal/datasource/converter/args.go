@@ -0,0 +1,168 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/jmoiron/sqlx"
+	lua "github.com/yuin/gopher-lua"
+)
+
+// Args are the bind arguments of the query, positional or named
+type Args struct {
+	Positional []interface{}
+	Named      map[string]interface{}
+}
+
+// ArgsFromLua returns the bind arguments of the lua table. An array is used as positional arguments
+// and a table with string keys as named arguments. Nil means no arguments
+func ArgsFromLua(v lua.LValue) (*Args, error) {
+	if v == lua.LNil {
+		return nil, nil
+	}
+
+	tbl, ok := v.(*lua.LTable)
+	if !ok {
+		return nil, fmt.Errorf("args must be a table, got %s", v.Type().String())
+	}
+
+	args := &Args{}
+
+	var err error
+
+	tbl.ForEach(func(key lua.LValue, value lua.LValue) {
+		if err != nil {
+			return
+		}
+
+		var arg interface{}
+		arg, err = FromLua(value)
+		if err != nil {
+			err = fmt.Errorf("arg %s: %w", key.String(), err)
+			return
+		}
+
+		if key.Type() == lua.LTString {
+			if args.Named == nil {
+				args.Named = map[string]interface{}{}
+			}
+			args.Named[key.String()] = arg
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Named != nil {
+		if tbl.Len() > 0 {
+			return nil, fmt.Errorf("args must be an array or a table with string keys, not both")
+		}
+		return args, nil
+	}
+
+	for i := 1; i <= tbl.Len(); i++ {
+		arg, _ := FromLua(tbl.RawGetInt(i))
+		args.Positional = append(args.Positional, arg)
+	}
+
+	return args, nil
+}
+
+// ArgsFromJSON returns the bind arguments of the json array (positional) or object (named)
+func ArgsFromJSON(s string) (*Args, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	args := &Args{}
+
+	switch t := v.(type) {
+	case []interface{}:
+		for idx, arg := range t {
+			a, err := fromJSON(arg)
+			if err != nil {
+				return nil, fmt.Errorf("arg %d: %w", idx+1, err)
+			}
+			args.Positional = append(args.Positional, a)
+		}
+	case map[string]interface{}:
+		args.Named = map[string]interface{}{}
+		for name, arg := range t {
+			a, err := fromJSON(arg)
+			if err != nil {
+				return nil, fmt.Errorf("arg %s: %w", name, err)
+			}
+			args.Named[name] = a
+		}
+	default:
+		return nil, fmt.Errorf("args must be an array or an object")
+	}
+
+	return args, nil
+}
+
+// ArgsFromParams returns the bind arguments of the CoreAPI request param 'args' with the json array or object.
+// Nil means no arguments
+func ArgsFromParams(params map[string]string) (*Args, error) {
+	v, ok := params["args"]
+	if !ok {
+		return nil, nil
+	}
+	return ArgsFromJSON(v)
+}
+
+// Bind returns the query and the arguments for the driver. Named arguments like :name are replaced
+// with the placeholders of the bindType (sqlx.DOLLAR, sqlx.QUESTION etc.).
+// In the queries with named arguments '::' means a literal ':', so postgres casts must be written as CAST(x AS type)
+func (a *Args) Bind(query string, bindType int) (string, []interface{}, error) {
+	if a == nil {
+		return query, nil, nil
+	}
+
+	if a.Named == nil {
+		return query, a.Positional, nil
+	}
+
+	q, args, err := sqlx.Named(query, a.Named)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sqlx.Rebind(bindType, q), args, nil
+}
+
+// FromLua converts the lua value to the bind argument. Integral numbers are converted to int64
+func FromLua(v lua.LValue) (interface{}, error) {
+	switch t := v.(type) {
+	case *lua.LNilType:
+		return nil, nil
+	case lua.LBool:
+		return bool(t), nil
+	case lua.LString:
+		return string(t), nil
+	case lua.LNumber:
+		return number(float64(t)), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", v.Type().String())
+}
+
+func fromJSON(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case nil, bool, string:
+		return t, nil
+	case float64:
+		return number(t), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %T", v)
+}
+
+func number(f float64) interface{} {
+	if f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+		return int64(f)
+	}
+	return f
+}
@@ -0,0 +1,110 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func TestArgsFromLua(t *testing.T) {
+	args, err := ArgsFromLua(lua.LNil)
+	require.NoError(t, err)
+	assert.Nil(t, args)
+
+	_, err = ArgsFromLua(lua.LString("foo"))
+	require.Error(t, err)
+	assert.Equal(t, "args must be a table, got string", err.Error())
+
+	tbl := &lua.LTable{}
+	tbl.Append(lua.LNumber(42))
+	tbl.Append(lua.LString("foo"))
+	tbl.Append(lua.LNumber(1.5))
+	tbl.Append(lua.LTrue)
+
+	args, err = ArgsFromLua(tbl)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(42), "foo", 1.5, true}, args.Positional)
+	assert.Nil(t, args.Named)
+
+	tbl = &lua.LTable{}
+	tbl.RawSetString("id", lua.LNumber(42))
+	tbl.RawSetString("name", lua.LString("foo"))
+
+	args, err = ArgsFromLua(tbl)
+	require.NoError(t, err)
+	assert.Nil(t, args.Positional)
+	assert.Equal(t, map[string]interface{}{"id": int64(42), "name": "foo"}, args.Named)
+
+	tbl.Append(lua.LNumber(1))
+	_, err = ArgsFromLua(tbl)
+	require.Error(t, err)
+	assert.Equal(t, "args must be an array or a table with string keys, not both", err.Error())
+
+	tbl = &lua.LTable{}
+	tbl.Append(&lua.LTable{})
+	_, err = ArgsFromLua(tbl)
+	require.Error(t, err)
+	assert.Equal(t, "arg 1: unsupported type table", err.Error())
+}
+
+func TestArgsFromJSON(t *testing.T) {
+	args, err := ArgsFromJSON(`[42, "foo", 1.5, null]`)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(42), "foo", 1.5, nil}, args.Positional)
+
+	args, err = ArgsFromJSON(`{"id": 42}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": int64(42)}, args.Named)
+
+	_, err = ArgsFromJSON(`42`)
+	require.Error(t, err)
+	assert.Equal(t, "args must be an array or an object", err.Error())
+
+	_, err = ArgsFromJSON(`[[1]]`)
+	require.Error(t, err)
+	assert.Equal(t, "arg 1: unsupported type []interface {}", err.Error())
+
+	_, err = ArgsFromJSON(`[`)
+	require.Error(t, err)
+}
+
+func TestArgsFromParams(t *testing.T) {
+	args, err := ArgsFromParams(map[string]string{})
+	require.NoError(t, err)
+	assert.Nil(t, args)
+
+	args, err = ArgsFromParams(map[string]string{"args": `[1]`})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(1)}, args.Positional)
+}
+
+func TestArgs_Bind(t *testing.T) {
+	var args *Args
+
+	q, a, err := args.Bind("SELECT 1", sqlx.DOLLAR)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT 1", q)
+	assert.Nil(t, a)
+
+	args = &Args{Positional: []interface{}{int64(1)}}
+	q, a, err = args.Bind("SELECT * FROM t WHERE id = $1", sqlx.DOLLAR)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM t WHERE id = $1", q)
+	assert.Equal(t, []interface{}{int64(1)}, a)
+
+	args = &Args{Named: map[string]interface{}{"id": int64(1), "name": "foo"}}
+	q, a, err = args.Bind("SELECT * FROM t WHERE id = :id AND name = :name OR id = :id", sqlx.DOLLAR)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM t WHERE id = $1 AND name = $2 OR id = $3", q)
+	assert.Equal(t, []interface{}{int64(1), "foo", int64(1)}, a)
+
+	q, _, err = args.Bind("SELECT * FROM t WHERE id = :id", sqlx.QUESTION)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM t WHERE id = ?", q)
+
+	_, _, err = args.Bind("SELECT * FROM t WHERE id = :foo", sqlx.QUESTION)
+	require.Error(t, err)
+}
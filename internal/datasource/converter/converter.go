@@ -1,11 +1,202 @@
 package converter
 
 import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/common"
 	lua "github.com/yuin/gopher-lua"
 )
 
-// FromDateBytes returns lua string from bytes
-func FromDateBytes(v interface{}) lua.LValue {
-	t := v.(*[]byte)
-	return lua.LString(*t)
+// Kind of the value in the text representation
+type Kind int
+
+const (
+	// KindString is kept as is
+	KindString Kind = iota
+	// KindInt is parsed as int64, or as uint64 if it overflows int64
+	KindInt
+	// KindFloat is parsed as float64
+	KindFloat
+	// KindDecimal is kept as Decimal
+	KindDecimal
+	// KindBool is parsed as bool
+	KindBool
+	// KindTime is parsed as time.Time in UTC with the layout
+	KindTime
+)
+
+// Decimal is the text representation of the decimal value
+type Decimal string
+
+// DecimalFromUnscaled returns the Decimal of the unscaled integer value and the scale, e.g. 12345 and 2 is 123.45
+func DecimalFromUnscaled(v *big.Int, scale int) Decimal {
+	s := new(big.Int).Abs(v).String()
+	if scale > 0 {
+		if len(s) <= scale {
+			s = strings.Repeat("0", scale-len(s)+1) + s
+		}
+		s = s[:len(s)-scale] + "." + s[len(s)-scale:]
+	}
+	if v.Sign() < 0 {
+		s = "-" + s
+	}
+	return Decimal(s)
+}
+
+// ParseText parses the text representation of the value of the kind
+func ParseText(raw []byte, kind Kind, layout string) (interface{}, error) {
+	s := string(raw)
+
+	switch kind {
+	case KindInt:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err == nil {
+			return v, nil
+		}
+		return strconv.ParseUint(s, 10, 64)
+	case KindFloat:
+		return strconv.ParseFloat(s, 64)
+	case KindDecimal:
+		return Decimal(s), nil
+	case KindBool:
+		return strconv.ParseBool(s)
+	case KindTime:
+		return time.Parse(layout, s)
+	}
+
+	return s, nil
+}
+
+// Converter converts the values of the sql query results to lua values and to values for the json response
+type Converter struct {
+	epochTime       bool
+	decimalAsString bool
+}
+
+// New creates new Converter. Nil config means ISO-8601 times and numeric decimals
+func New(cfg *common.Conversion) *Converter {
+	c := &Converter{}
+
+	if cfg != nil {
+		c.epochTime = cfg.TimeFormat == common.TimeFormatEpoch
+		c.decimalAsString = cfg.DecimalAsString
+	}
+
+	return c
+}
+
+// ToLua converts the value to the lua value. NULL is converted to nil, numbers to lua numbers,
+// arrays and maps to lua tables
+func (c *Converter) ToLua(v interface{}) lua.LValue {
+	switch t := v.(type) {
+	case nil:
+		return lua.LNil
+	case bool:
+		return lua.LBool(t)
+	case string:
+		return lua.LString(t)
+	case []byte:
+		return lua.LString(t)
+	case time.Time:
+		if c.epochTime {
+			return lua.LNumber(epoch(t))
+		}
+		return lua.LString(t.Format(time.RFC3339Nano))
+	case Decimal:
+		if c.decimalAsString {
+			return lua.LString(t)
+		}
+		f, err := strconv.ParseFloat(string(t), 64)
+		if err != nil {
+			return lua.LString(t)
+		}
+		return lua.LNumber(f)
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return lua.LNumber(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return lua.LNumber(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return lua.LNumber(rv.Float())
+	case reflect.Slice, reflect.Array:
+		if _, ok := v.(fmt.Stringer); ok {
+			break
+		}
+		tbl := &lua.LTable{}
+		for i := 0; i < rv.Len(); i++ {
+			tbl.Append(c.ToLua(rv.Index(i).Interface()))
+		}
+		return tbl
+	case reflect.Map:
+		tbl := &lua.LTable{}
+		iter := rv.MapRange()
+		for iter.Next() {
+			tbl.RawSet(lua.LString(fmt.Sprintf("%v", iter.Key().Interface())), c.ToLua(iter.Value().Interface()))
+		}
+		return tbl
+	}
+
+	return lua.LString(fmt.Sprintf("%v", v))
+}
+
+// ToAPI converts the value to the value for the json response. NaN and infinite decimals are kept as strings,
+// json has no numbers for them
+func (c *Converter) ToAPI(v interface{}) interface{} {
+	switch t := v.(type) {
+	case nil, bool, string:
+		return t
+	case []byte:
+		return string(t)
+	case time.Time:
+		if c.epochTime {
+			return epoch(t)
+		}
+		return t.Format(time.RFC3339Nano)
+	case Decimal:
+		if c.decimalAsString {
+			return string(t)
+		}
+		f, err := strconv.ParseFloat(string(t), 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return string(t)
+		}
+		return f
+	case fmt.Stringer:
+		return t.String()
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		res := make([]interface{}, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			res = append(res, c.ToAPI(rv.Index(i).Interface()))
+		}
+		return res
+	case reflect.Map:
+		res := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			res[fmt.Sprintf("%v", iter.Key().Interface())] = c.ToAPI(iter.Value().Interface())
+		}
+		return res
+	}
+
+	return v
+}
+
+// epoch returns the unix timestamp in seconds with the fractional part
+func epoch(t time.Time) float64 {
+	return float64(t.Unix()) + float64(t.Nanosecond())/float64(time.Second)
 }
@@ -1,40 +1,120 @@
 package converter
 
 import (
-	lua "github.com/yuin/gopher-lua"
-	"reflect"
+	"math/big"
+	"net"
 	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
 )
 
-func TestFromDateBytes(t *testing.T) {
-	type args struct {
-		v interface{}
+func TestDecimalFromUnscaled(t *testing.T) {
+	tests := []struct {
+		name     string
+		unscaled int64
+		scale    int
+		want     Decimal
+	}{
+		{name: "scale 0", unscaled: 123, scale: 0, want: "123"},
+		{name: "scale 2", unscaled: 12345, scale: 2, want: "123.45"},
+		{name: "less than 1", unscaled: 5, scale: 3, want: "0.005"},
+		{name: "negative", unscaled: -12345, scale: 4, want: "-1.2345"},
+		{name: "negative less than 1", unscaled: -5, scale: 2, want: "-0.05"},
+		{name: "zero", unscaled: 0, scale: 2, want: "0.00"},
 	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DecimalFromUnscaled(big.NewInt(tt.unscaled), tt.scale))
+		})
+	}
+}
+
+func TestParseText(t *testing.T) {
 	tests := []struct {
-		name string
-		args args
-		want lua.LValue
+		name    string
+		raw     string
+		kind    Kind
+		layout  string
+		want    interface{}
+		wantErr bool
 	}{
-		{
-			name: "empty",
-			args: args{
-				v: &[]byte{},
-			},
-			want: lua.LString(""),
-		},
-		{
-			name: "string",
-			args: args{
-				v: &[]byte{0x23, 0x24, 0x25},
-			},
-			want: lua.LString("#$%"),
-		},
+		{name: "string", raw: "foo", kind: KindString, want: "foo"},
+		{name: "int", raw: "-42", kind: KindInt, want: int64(-42)},
+		{name: "uint", raw: "18446744073709551615", kind: KindInt, want: uint64(18446744073709551615)},
+		{name: "bad int", raw: "foo", kind: KindInt, wantErr: true},
+		{name: "float", raw: "1.5", kind: KindFloat, want: 1.5},
+		{name: "decimal", raw: "123.4500", kind: KindDecimal, want: Decimal("123.4500")},
+		{name: "bool", raw: "1", kind: KindBool, want: true},
+		{name: "time", raw: "2022-01-02 03:04:05.5", kind: KindTime, layout: "2006-01-02 15:04:05.999999",
+			want: time.Date(2022, 1, 2, 3, 4, 5, 500000000, time.UTC)},
+		{name: "bad time", raw: "0000-00-00", kind: KindTime, layout: "2006-01-02", wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := FromDateBytes(tt.args.v); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("FromDateBytes() = %v, want %v", got, tt.want)
+			got, err := ParseText([]byte(tt.raw), tt.kind, tt.layout)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
 			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func TestConverter_ToLua(t *testing.T) {
+	ts := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	c := New(nil)
+
+	assert.Equal(t, lua.LNil, c.ToLua(nil))
+	assert.Equal(t, lua.LTrue, c.ToLua(true))
+	assert.Equal(t, lua.LString("foo"), c.ToLua("foo"))
+	assert.Equal(t, lua.LString("foo"), c.ToLua([]byte("foo")))
+	assert.Equal(t, lua.LNumber(42), c.ToLua(int8(42)))
+	assert.Equal(t, lua.LNumber(42), c.ToLua(uint64(42)))
+	assert.Equal(t, lua.LNumber(1.5), c.ToLua(float32(1.5)))
+	assert.Equal(t, lua.LNumber(123.45), c.ToLua(Decimal("123.45")))
+	assert.Equal(t, lua.LString("2022-01-02T03:04:05Z"), c.ToLua(ts))
+	assert.Equal(t, lua.LString("127.0.0.1"), c.ToLua(net.IPv4(127, 0, 0, 1)))
+
+	arr := c.ToLua([]int32{1, 2})
+	require.Equal(t, lua.LTTable, arr.Type())
+	assert.Equal(t, 2, arr.(*lua.LTable).Len())
+	assert.Equal(t, lua.LNumber(2), arr.(*lua.LTable).RawGetInt(2))
+
+	m := c.ToLua(map[string]interface{}{"a": 1.5})
+	require.Equal(t, lua.LTTable, m.Type())
+	assert.Equal(t, lua.LNumber(1.5), m.(*lua.LTable).RawGetString("a"))
+
+	c = New(&common.Conversion{TimeFormat: common.TimeFormatEpoch, DecimalAsString: true})
+
+	assert.Equal(t, lua.LNumber(1641092645), c.ToLua(ts))
+	assert.Equal(t, lua.LNumber(1641092645.25), c.ToLua(ts.Add(time.Millisecond*250)))
+	assert.Equal(t, lua.LString("123.45"), c.ToLua(Decimal("123.45")))
+}
+
+func TestConverter_ToAPI(t *testing.T) {
+	ts := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	c := New(nil)
+
+	assert.Nil(t, c.ToAPI(nil))
+	assert.Equal(t, "foo", c.ToAPI([]byte("foo")))
+	assert.Equal(t, int64(42), c.ToAPI(int64(42)))
+	assert.Equal(t, 123.45, c.ToAPI(Decimal("123.45")))
+	assert.Equal(t, "NaN", c.ToAPI(Decimal("NaN")))
+	assert.Equal(t, "-Infinity", c.ToAPI(Decimal("-Infinity")))
+	assert.Equal(t, "2022-01-02T03:04:05Z", c.ToAPI(ts))
+	assert.Equal(t, "127.0.0.1", c.ToAPI(net.IPv4(127, 0, 0, 1)))
+	assert.Equal(t, []interface{}{"2022-01-02T03:04:05Z"}, c.ToAPI([]time.Time{ts}))
+
+	c = New(&common.Conversion{TimeFormat: common.TimeFormatEpoch, DecimalAsString: true})
+
+	assert.Equal(t, float64(1641092645), c.ToAPI(ts))
+	assert.Equal(t, "123.45", c.ToAPI(Decimal("123.45")))
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"github.com/balerter/balerter/internal/config/datasources"
 	"github.com/balerter/balerter/internal/datasource/provider/clickhouse"
+	"github.com/balerter/balerter/internal/datasource/provider/influxdb"
 	"github.com/balerter/balerter/internal/datasource/provider/loki"
 	"github.com/balerter/balerter/internal/datasource/provider/mysql"
 	"github.com/balerter/balerter/internal/datasource/provider/postgres"
 	"github.com/balerter/balerter/internal/datasource/provider/prometheus"
+	"github.com/balerter/balerter/internal/datasource/provider/sqlite"
 	"github.com/balerter/balerter/internal/modules"
 	"net/http"
 	"strings"
@@ -71,6 +73,14 @@ func (m *Manager) Init(cfg *datasources.DataSources) error {
 		m.modules[module.Name()] = module
 	}
 
+	for idx := range cfg.Sqlite {
+		module, err := sqlite.New(cfg.Sqlite[idx], sqlx.Connect, m.logger)
+		if err != nil {
+			return err
+		}
+		m.modules[module.Name()] = module
+	}
+
 	for idx := range cfg.Loki {
 		module, err := loki.New(cfg.Loki[idx], m.logger)
 		if err != nil {
@@ -79,6 +89,14 @@ func (m *Manager) Init(cfg *datasources.DataSources) error {
 		m.modules[module.Name()] = module
 	}
 
+	for idx := range cfg.InfluxDB {
+		module, err := influxdb.New(cfg.InfluxDB[idx], m.logger)
+		if err != nil {
+			return err
+		}
+		m.modules[module.Name()] = module
+	}
+
 	return nil
 }
 
@@ -5,10 +5,12 @@ import (
 	"github.com/balerter/balerter/internal/config/common"
 	"github.com/balerter/balerter/internal/config/datasources"
 	clickhouseCfg "github.com/balerter/balerter/internal/config/datasources/clickhouse"
+	"github.com/balerter/balerter/internal/config/datasources/influxdb"
 	"github.com/balerter/balerter/internal/config/datasources/loki"
 	"github.com/balerter/balerter/internal/config/datasources/mysql"
 	"github.com/balerter/balerter/internal/config/datasources/postgres"
 	"github.com/balerter/balerter/internal/config/datasources/prometheus"
+	"github.com/balerter/balerter/internal/config/datasources/sqlite"
 	"github.com/balerter/balerter/internal/modules"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
@@ -159,6 +161,18 @@ func TestManager_Init(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "err sqlite",
+			fields: fields{
+				modules: map[string]modules.Module{},
+			},
+			args: args{
+				cfg: &datasources.DataSources{
+					Sqlite: []sqlite.Sqlite{{Name: "foo", Path: "/not/exists/db.sqlite", ReadOnly: true}},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "err loki",
 			fields: fields{
@@ -171,6 +185,18 @@ func TestManager_Init(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "err influxdb",
+			fields: fields{
+				modules: map[string]modules.Module{},
+			},
+			args: args{
+				cfg: &datasources.DataSources{
+					InfluxDB: []influxdb.InfluxDB{{URL: "://://"}},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "ok",
 			fields: fields{
@@ -3,10 +3,12 @@ package manager
 import (
 	"github.com/balerter/balerter/internal/config/datasources"
 	"github.com/balerter/balerter/internal/datasource/provider/clickhouse"
+	"github.com/balerter/balerter/internal/datasource/provider/influxdb"
 	"github.com/balerter/balerter/internal/datasource/provider/loki"
 	"github.com/balerter/balerter/internal/datasource/provider/mysql"
 	"github.com/balerter/balerter/internal/datasource/provider/postgres"
 	"github.com/balerter/balerter/internal/datasource/provider/prometheus"
+	"github.com/balerter/balerter/internal/datasource/provider/sqlite"
 	moduleMock "github.com/balerter/balerter/internal/mock"
 	"github.com/balerter/balerter/internal/modules"
 	"go.uber.org/zap"
@@ -77,11 +79,21 @@ func (m *Manager) Init(cfg *datasources.DataSources) error {
 		m.modules[mod.Name()] = mod
 	}
 
+	for idx := range cfg.Sqlite {
+		mod := moduleMock.New(sqlite.ModuleName(cfg.Sqlite[idx].Name), sqlite.Methods(), m.logger)
+		m.modules[mod.Name()] = mod
+	}
+
 	for idx := range cfg.Loki {
 		mod := moduleMock.New(loki.ModuleName(cfg.Loki[idx].Name), loki.Methods(), m.logger)
 		m.modules[mod.Name()] = mod
 	}
 
+	for idx := range cfg.InfluxDB {
+		mod := moduleMock.New(influxdb.ModuleName(cfg.InfluxDB[idx].Name), influxdb.Methods(), m.logger)
+		m.modules[mod.Name()] = mod
+	}
+
 	return nil
 }
 
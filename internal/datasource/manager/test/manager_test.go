@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"github.com/balerter/balerter/internal/config/datasources"
 	"github.com/balerter/balerter/internal/config/datasources/clickhouse"
+	"github.com/balerter/balerter/internal/config/datasources/influxdb"
 	"github.com/balerter/balerter/internal/config/datasources/loki"
 	"github.com/balerter/balerter/internal/config/datasources/mysql"
 	"github.com/balerter/balerter/internal/config/datasources/postgres"
 	"github.com/balerter/balerter/internal/config/datasources/prometheus"
+	"github.com/balerter/balerter/internal/config/datasources/sqlite"
 	"github.com/balerter/balerter/internal/modules"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -90,13 +92,15 @@ func TestInit(t *testing.T) {
 		Prometheus: []prometheus.Prometheus{{Name: "prom1"}},
 		Postgres:   []postgres.Postgres{{Name: "pg1"}},
 		MySQL:      []mysql.Mysql{{Name: "mysql1"}},
+		Sqlite:     []sqlite.Sqlite{{Name: "sqlite1"}},
 		Loki:       []loki.Loki{{Name: "loki1"}},
+		InfluxDB:   []influxdb.InfluxDB{{Name: "influx1"}},
 	}
 
 	err := m.Init(cfg)
 	require.NoError(t, err)
 
-	assert.Equal(t, 5, len(m.modules))
+	assert.Equal(t, 7, len(m.modules))
 
 	mod, ok := m.modules["clickhouse.ch1"]
 	assert.True(t, ok)
@@ -118,10 +122,20 @@ func TestInit(t *testing.T) {
 	require.NotNil(t, mod)
 	assert.Equal(t, "mysql.mysql1", mod.Name())
 
+	mod, ok = m.modules["sqlite.sqlite1"]
+	assert.True(t, ok)
+	require.NotNil(t, mod)
+	assert.Equal(t, "sqlite.sqlite1", mod.Name())
+
 	mod, ok = m.modules["loki.loki1"]
 	assert.True(t, ok)
 	require.NotNil(t, mod)
 	assert.Equal(t, "loki.loki1", mod.Name())
+
+	mod, ok = m.modules["influxdb.influx1"]
+	assert.True(t, ok)
+	require.NotNil(t, mod)
+	assert.Equal(t, "influxdb.influx1", mod.Name())
 }
 
 func TestGet(t *testing.T) {
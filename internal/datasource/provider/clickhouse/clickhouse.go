@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"github.com/ClickHouse/clickhouse-go"
 	clickhouseCfg "github.com/balerter/balerter/internal/config/datasources/clickhouse"
+	"github.com/balerter/balerter/internal/datasource/converter"
 	"github.com/balerter/balerter/internal/modules"
 	"github.com/jmoiron/sqlx"
 	lua "github.com/yuin/gopher-lua"
@@ -40,18 +41,20 @@ type dbConnection interface {
 
 // Clickhouse represents datasource of type Clickhouse
 type Clickhouse struct {
-	name    string
-	logger  *zap.Logger
-	db      dbConnection
-	timeout time.Duration
+	name      string
+	logger    *zap.Logger
+	db        dbConnection
+	timeout   time.Duration
+	converter *converter.Converter
 }
 
 // New creates new Clickhouse datasource
 func New(cfg clickhouseCfg.Clickhouse, logger *zap.Logger) (*Clickhouse, error) {
 	c := &Clickhouse{
-		name:    ModuleName(cfg.Name),
-		logger:  logger,
-		timeout: time.Millisecond * time.Duration(cfg.Timeout),
+		name:      ModuleName(cfg.Name),
+		logger:    logger,
+		timeout:   time.Millisecond * time.Duration(cfg.Timeout),
+		converter: converter.New(cfg.Conversion),
 	}
 
 	if c.timeout == 0 {
@@ -4,12 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strconv"
-	"time"
 
 	"github.com/balerter/balerter/internal/datasource/converter"
-	lua "github.com/yuin/gopher-lua"
-
+	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
 
@@ -18,10 +15,20 @@ func (m *Clickhouse) CoreApiHandler(method string, parts []string, params map[st
 		return nil, http.StatusBadRequest, fmt.Errorf("unknown method %q", method)
 	}
 
+	args, err := converter.ArgsFromParams(params)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error parse args, %w", err)
+	}
+
+	q, bindArgs, err := args.Bind(string(body), sqlx.QUESTION)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error bind args, %w", err)
+	}
+
 	ctx, ctxCancel := context.WithTimeout(context.Background(), m.timeout)
 	defer ctxCancel()
 
-	rows, err := m.db.QueryContext(ctx, string(body))
+	rows, err := m.db.QueryContext(ctx, q, bindArgs...)
 	if err != nil {
 		m.logger.Error("error clickhouse query", zap.ByteString("query", body), zap.Error(err))
 		return nil, http.StatusInternalServerError, err
@@ -31,11 +38,9 @@ func (m *Clickhouse) CoreApiHandler(method string, parts []string, params map[st
 	cct, _ := rows.ColumnTypes()
 
 	dest := make([]interface{}, 0)
-	ffs := make([]func(v interface{}) lua.LValue, 0)
 
 	for range cct {
-		dest = append(dest, new([]byte))
-		ffs = append(ffs, converter.FromDateBytes)
+		dest = append(dest, new(interface{}))
 	}
 
 	var result []map[string]any
@@ -49,41 +54,7 @@ func (m *Clickhouse) CoreApiHandler(method string, parts []string, params map[st
 		row := map[string]any{}
 
 		for idx, c := range cct {
-			raw := string(*dest[idx].(*[]byte))
-
-			switch c.DatabaseTypeName() {
-			case "Int64", "Int32", "Int16", "Int8", "Int":
-				v, err := strconv.ParseInt(raw, 10, 64)
-				if err != nil {
-					m.logger.Error("error parse value", zap.String("value", raw), zap.Error(err))
-					return nil, http.StatusInternalServerError, err
-				}
-				row[c.Name()] = v
-			case "UInt64", "UInt32", "UInt16", "UInt8", "Uint":
-				v, err := strconv.ParseUint(raw, 10, 64)
-				if err != nil {
-					m.logger.Error("error parse value", zap.String("value", raw), zap.Error(err))
-					return nil, http.StatusInternalServerError, err
-				}
-				row[c.Name()] = v
-			case "Float64", "Float32":
-				v, err := strconv.ParseFloat(raw, 64)
-				if err != nil {
-					m.logger.Error("error parse value", zap.String("value", raw), zap.Error(err))
-					return nil, http.StatusInternalServerError, err
-				}
-				row[c.Name()] = v
-			case "DateTime":
-				v, err := time.Parse(time.RFC3339, raw)
-				if err != nil {
-					m.logger.Error("error parse value", zap.String("value", raw), zap.Error(err))
-					return nil, http.StatusInternalServerError, err
-				}
-				row[c.Name()] = v
-
-			default:
-				row[c.Name()] = raw
-			}
+			row[c.Name()] = m.converter.ToAPI(value(*dest[idx].(*interface{}), c))
 		}
 
 		result = append(result, row)
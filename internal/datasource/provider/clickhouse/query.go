@@ -2,8 +2,11 @@ package clickhouse
 
 import (
 	"context"
+	"database/sql"
+	"math/big"
 
 	"github.com/balerter/balerter/internal/datasource/converter"
+	"github.com/jmoiron/sqlx"
 
 	lua "github.com/yuin/gopher-lua"
 	"go.uber.org/zap"
@@ -14,12 +17,28 @@ func (m *Clickhouse) query(luaState *lua.LState) int {
 
 	m.logger.Debug("call clickhouse query", zap.String("query", q))
 
+	args, err := converter.ArgsFromLua(luaState.Get(2))
+	if err != nil {
+		m.logger.Error("error parse clickhouse query args", zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error parse args: " + err.Error()))
+		return 2
+	}
+
+	boundQuery, bindArgs, err := args.Bind(q, sqlx.QUESTION)
+	if err != nil {
+		m.logger.Error("error bind clickhouse query args", zap.String("query", q), zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error bind args: " + err.Error()))
+		return 2
+	}
+
 	ctx, ctxCancel := context.WithTimeout(context.Background(), m.timeout)
 	defer ctxCancel()
 
-	rows, err := m.db.QueryContext(ctx, q)
+	rows, err := m.db.QueryContext(ctx, boundQuery, bindArgs...)
 	if err != nil {
-		m.logger.Error("error clickhouse query", zap.String("query", q), zap.Error(err))
+		m.logger.Error("error clickhouse query", zap.String("query", boundQuery), zap.Error(err))
 		luaState.Push(lua.LNil)
 		luaState.Push(lua.LString(err.Error()))
 		return 2
@@ -29,11 +48,9 @@ func (m *Clickhouse) query(luaState *lua.LState) int {
 	cct, _ := rows.ColumnTypes()
 
 	dest := make([]interface{}, 0)
-	ffs := make([]func(v interface{}) lua.LValue, 0)
 
 	for range cct {
-		dest = append(dest, new([]byte))
-		ffs = append(ffs, converter.FromDateBytes)
+		dest = append(dest, new(interface{}))
 	}
 
 	result := &lua.LTable{}
@@ -49,7 +66,7 @@ func (m *Clickhouse) query(luaState *lua.LState) int {
 		row := &lua.LTable{}
 
 		for idx, c := range cct {
-			v := ffs[idx](dest[idx])
+			v := m.converter.ToLua(value(*dest[idx].(*interface{}), c))
 			row.RawSet(lua.LString(c.Name()), v)
 		}
 
@@ -60,3 +77,42 @@ func (m *Clickhouse) query(luaState *lua.LState) int {
 	luaState.Push(lua.LNil)
 	return 2
 }
+
+// value returns the scanned value. Decimals are returned by the driver as unscaled integers,
+// Decimal128 as 16 little-endian bytes, so they are converted to converter.Decimal with the column scale
+func value(v interface{}, c *sql.ColumnType) interface{} {
+	_, scale, ok := c.DecimalSize()
+	if !ok {
+		return v
+	}
+
+	var unscaled *big.Int
+
+	switch t := v.(type) {
+	case int32:
+		unscaled = big.NewInt(int64(t))
+	case int64:
+		unscaled = big.NewInt(t)
+	case []byte:
+		unscaled = fromLittleEndian(t)
+	default:
+		return v
+	}
+
+	return converter.DecimalFromUnscaled(unscaled, int(scale))
+}
+
+// fromLittleEndian returns the integer of the little-endian two's complement bytes
+func fromLittleEndian(b []byte) *big.Int {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+
+	v := new(big.Int).SetBytes(be)
+	if len(be) > 0 && be[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(be)*8))) //nolint:gomnd // bits in the byte
+	}
+
+	return v
+}
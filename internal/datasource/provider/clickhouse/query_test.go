@@ -3,11 +3,15 @@ package clickhouse
 import (
 	"context"
 	"fmt"
+	"github.com/balerter/balerter/internal/datasource/converter"
 	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	lua "github.com/yuin/gopher-lua"
 	"go.uber.org/zap"
+	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -37,9 +41,10 @@ func getDB(t *testing.T) *sqlx.DB {
 
 func TestClickhouse_query(t *testing.T) {
 	ch := &Clickhouse{
-		db:      getDB(t),
-		logger:  zap.NewNop(),
-		timeout: time.Second,
+		db:        getDB(t),
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		converter: converter.New(nil),
 	}
 
 	state := lua.NewState()
@@ -62,3 +67,104 @@ func TestClickhouse_query(t *testing.T) {
 	})
 	assert.True(t, found)
 }
+
+func TestFromLittleEndian(t *testing.T) {
+	assert.Equal(t, "12345", fromLittleEndian([]byte{0x39, 0x30, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}).String())
+	assert.Equal(t, "-2", fromLittleEndian([]byte{0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}).String())
+}
+
+// newArgsDB returns the sqlite database with the same '?' placeholders, so the args binding is tested without the clickhouse server
+func newArgsDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Connect("sqlite3", filepath.Join(t.TempDir(), "db.sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec("CREATE TABLE items (id INTEGER, name TEXT)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO items VALUES (1, 'Foo'), (20, 'Bar')")
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestQuery_Args(t *testing.T) {
+	m := &Clickhouse{
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		db:        newArgsDB(t),
+		converter: converter.New(nil),
+	}
+
+	args := &lua.LTable{}
+	args.Append(lua.LNumber(20))
+
+	luaState := lua.NewState()
+	luaState.Push(lua.LString("SELECT name FROM items WHERE id = ?"))
+	luaState.Push(args)
+
+	n := m.query(luaState)
+	assert.Equal(t, 2, n)
+	require.Equal(t, lua.LTNil, luaState.Get(4).Type())
+
+	res := luaState.Get(3).(*lua.LTable)
+	require.Equal(t, 1, res.Len())
+	assert.Equal(t, lua.LString("Bar"), res.RawGetInt(1).(*lua.LTable).RawGetString("name"))
+
+	args = &lua.LTable{}
+	args.RawSetString("name", lua.LString("Foo"))
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT id FROM items WHERE name = :name"))
+	luaState.Push(args)
+
+	n = m.query(luaState)
+	assert.Equal(t, 2, n)
+	require.Equal(t, lua.LTNil, luaState.Get(4).Type())
+
+	res = luaState.Get(3).(*lua.LTable)
+	require.Equal(t, 1, res.Len())
+	assert.Equal(t, lua.LNumber(1), res.RawGetInt(1).(*lua.LTable).RawGetString("id"))
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT id FROM items WHERE name = :foo"))
+	luaState.Push(args)
+
+	n = m.query(luaState)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "error bind args: could not find name foo in map[string]interface {}{\"name\":\"Foo\"}", luaState.Get(4).String())
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT id FROM items"))
+	luaState.Push(lua.LNumber(1))
+
+	n = m.query(luaState)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "error parse args: args must be a table, got number", luaState.Get(4).String())
+}
+
+func TestCoreApiHandler_Args(t *testing.T) {
+	m := &Clickhouse{
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		db:        newArgsDB(t),
+		converter: converter.New(nil),
+	}
+
+	res, _, err := m.CoreApiHandler("query", nil, map[string]string{"args": `{"id": 20}`}, []byte("SELECT name FROM items WHERE id = :id"))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"name": "Bar"}}, res)
+
+	res, _, err = m.CoreApiHandler("query", nil, map[string]string{"args": `["Foo"]`}, []byte("SELECT id FROM items WHERE name = ?"))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"id": int64(1)}}, res)
+
+	_, code, err := m.CoreApiHandler("query", nil, map[string]string{"args": `[`}, []byte("SELECT name FROM items WHERE id = ?"))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	_, code, err = m.CoreApiHandler("query", nil, map[string]string{"args": `{"id": 20}`}, []byte("SELECT name FROM items WHERE id = :foo"))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "error bind args, could not find name foo in map[string]interface {}{\"id\":20}", err.Error())
+}
@@ -0,0 +1,28 @@
+package influxdb
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func (m *InfluxDB) CoreApiHandler(method string, parts []string, params map[string]string, body []byte) (any, int, error) {
+	if method != "query" {
+		return nil, http.StatusBadRequest, fmt.Errorf("unknown method %q", method)
+	}
+
+	if len(body) == 0 {
+		return nil, http.StatusBadRequest, fmt.Errorf("query must be not empty")
+	}
+
+	opts := &queryOptions{}
+	if v, ok := params["db"]; ok {
+		opts.DB = v
+	}
+
+	series, err := m.query(string(body), opts)
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("error sending request: %v", err)
+	}
+
+	return series, 0, nil
+}
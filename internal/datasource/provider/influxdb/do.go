@@ -0,0 +1,393 @@
+package influxdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/datasources/influxdb"
+)
+
+const (
+	epQueryV1 = "/query"
+	epQueryV2 = "/api/v2/query"
+
+	fieldMeasurement = "_measurement"
+	fieldField       = "_field"
+	fieldTime        = "_time"
+	fieldValue       = "_value"
+)
+
+// Series is a time series with the tags as metrics, like the prometheus range result
+type Series struct {
+	Metrics map[string]string `json:"metrics"`
+	Values  []Sample          `json:"values"`
+}
+
+// Sample is a value of the series. Timestamp is the unix time in seconds,
+// Value is float64 for the numeric and boolean fields and string for the string fields
+type Sample struct {
+	Timestamp float64     `json:"timestamp"`
+	Value     interface{} `json:"value"`
+}
+
+type queryOptions struct {
+	DB string
+}
+
+type v1Response struct {
+	Results []struct {
+		Series []struct {
+			Name    string            `json:"name"`
+			Tags    map[string]string `json:"tags"`
+			Columns []string          `json:"columns"`
+			Values  [][]interface{}   `json:"values"`
+		} `json:"series"`
+		Error string `json:"error"`
+	} `json:"results"`
+	Error string `json:"error"`
+}
+
+func (m *InfluxDB) query(query string, opts *queryOptions) ([]*Series, error) {
+	if m.version == influxdb.Version1 {
+		return m.queryV1(query, opts)
+	}
+	return m.queryV2(query)
+}
+
+// queryV1 sends the InfluxQL query. Each field column of the result series is returned as a separate series
+func (m *InfluxDB) queryV1(query string, opts *queryOptions) ([]*Series, error) {
+	u := *m.url
+
+	q := &url.Values{}
+	q.Add("q", query)
+	q.Add("epoch", "ms")
+	db := m.database
+	if opts.DB != "" {
+		db = opts.DB
+	}
+	if db != "" {
+		q.Add("db", db)
+	}
+	u.RawQuery = q.Encode()
+	u.Path = strings.TrimSuffix(u.Path, "/") + epQueryV1
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := m.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp v1Response
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("error decode response, %w", err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("query error, %s", resp.Error)
+	}
+
+	var result []*Series
+
+	for _, r := range resp.Results {
+		if r.Error != "" {
+			return nil, fmt.Errorf("query error, %s", r.Error)
+		}
+
+		for _, s := range r.Series {
+			for idx, column := range s.Columns {
+				if column == "time" {
+					continue
+				}
+
+				series := &Series{Metrics: map[string]string{fieldMeasurement: s.Name, fieldField: column}}
+				for k, v := range s.Tags {
+					series.Metrics[k] = v
+				}
+
+				for _, row := range s.Values {
+					if len(row) != len(s.Columns) || row[idx] == nil {
+						continue
+					}
+					series.Values = append(series.Values, Sample{
+						Timestamp: v1Timestamp(row, s.Columns),
+						Value:     sampleValue(row[idx]),
+					})
+				}
+
+				result = append(result, series)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func v1Timestamp(row []interface{}, columns []string) float64 {
+	for idx, column := range columns {
+		if column == "time" {
+			if ts, ok := row[idx].(float64); ok {
+				return ts / 1000 //nolint:gomnd // ms in the second
+			}
+		}
+	}
+	return 0
+}
+
+func sampleValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case bool:
+		if t {
+			return float64(1)
+		}
+		return float64(0)
+	case float64, string:
+		return t
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// queryV2 sends the Flux query. Each table of the result is returned as a series with the group key columns as metrics
+func (m *InfluxDB) queryV2(query string) ([]*Series, error) {
+	u := *m.url
+
+	q := &url.Values{}
+	q.Add("org", m.org)
+	u.RawQuery = q.Encode()
+	u.Path = strings.TrimSuffix(u.Path, "/") + epQueryV2
+
+	reqBody, err := json.Marshal(map[string]interface{}{
+		"query": query,
+		"type":  "flux",
+		"dialect": map[string]interface{}{
+			"header":      true,
+			"annotations": []string{"datatype", "group", "default"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/csv")
+
+	body, err := m.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCSV(body)
+}
+
+// parseCSV parses the annotated CSV of the Flux query result. If the table has no _value column,
+// e.g. after pivot(), each numeric column which is not in the group key is returned as a separate series
+func parseCSV(body []byte) ([]*Series, error) {
+	r := csv.NewReader(bytes.NewReader(body))
+	r.FieldsPerRecord = -1
+
+	var datatypes, groups, defaults, header []string
+
+	var result []*Series
+	seriesByKey := map[string]*Series{}
+
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error parse csv, %w", err)
+		}
+
+		if strings.HasPrefix(rec[0], "#") {
+			switch rec[0] {
+			case "#datatype":
+				datatypes = rec
+				groups = nil
+				defaults = nil
+				header = nil
+			case "#group":
+				groups = rec
+			case "#default":
+				defaults = rec
+			}
+			continue
+		}
+
+		if header == nil {
+			header = rec
+			continue
+		}
+
+		if len(header) > 1 && header[1] == "error" && len(rec) > 1 {
+			return nil, fmt.Errorf("query error, %s", rec[1])
+		}
+
+		if len(rec) != len(header) {
+			return nil, fmt.Errorf("error parse csv, wrong fields count")
+		}
+
+		// the empty cells have the value of the #default annotation, e.g. the result name
+		row := map[string]string{}
+		for idx, column := range header {
+			row[column] = rec[idx]
+			if rec[idx] == "" && idx < len(defaults) {
+				row[column] = defaults[idx]
+			}
+		}
+
+		var ts float64
+		if v, ok := row[fieldTime]; ok && v != "" {
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, fmt.Errorf("error parse time %s, %w", v, err)
+			}
+			ts = float64(t.Unix()) + float64(t.Nanosecond())/float64(time.Second)
+		}
+
+		for _, idx := range valueColumns(header, datatypes, groups) {
+			if rec[idx] == "" {
+				continue
+			}
+
+			key := row["result"] + "/" + row["table"] + "/" + header[idx]
+
+			series, ok := seriesByKey[key]
+			if !ok {
+				series = &Series{Metrics: metrics(header, groups, datatypes, rec)}
+				if header[idx] != fieldValue {
+					series.Metrics[fieldField] = header[idx]
+				}
+				seriesByKey[key] = series
+				result = append(result, series)
+			}
+
+			series.Values = append(series.Values, Sample{Timestamp: ts, Value: csvValue(rec[idx], datatype(datatypes, idx))})
+		}
+	}
+
+	return result, nil
+}
+
+func isService(column string) bool {
+	switch column {
+	case "", "result", "table", "_start", "_stop", fieldTime:
+		return true
+	}
+	return false
+}
+
+func isGroup(groups []string, idx int) bool {
+	return idx < len(groups) && groups[idx] == "true"
+}
+
+func datatype(datatypes []string, idx int) string {
+	if idx < len(datatypes) {
+		return datatypes[idx]
+	}
+	return ""
+}
+
+func valueColumns(header, datatypes, groups []string) []int {
+	for idx, column := range header {
+		if column == fieldValue {
+			return []int{idx}
+		}
+	}
+
+	var res []int
+	for idx, column := range header {
+		if isService(column) || isGroup(groups, idx) {
+			continue
+		}
+		switch datatype(datatypes, idx) {
+		case "double", "long", "unsignedLong", "boolean":
+			res = append(res, idx)
+		}
+	}
+	return res
+}
+
+// metrics returns the group key columns. Without the group annotation all string columns are used
+func metrics(header, groups, datatypes, rec []string) map[string]string {
+	res := map[string]string{}
+	for idx, column := range header {
+		if isService(column) || column == fieldValue {
+			continue
+		}
+		if groups != nil && !isGroup(groups, idx) {
+			continue
+		}
+		if groups == nil && datatype(datatypes, idx) != "string" {
+			continue
+		}
+		res[column] = rec[idx]
+	}
+	return res
+}
+
+func csvValue(s, datatype string) interface{} {
+	switch datatype {
+	case "boolean":
+		if s == "true" {
+			return float64(1)
+		}
+		return float64(0)
+	case "string":
+		return s
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return s
+	}
+	return v
+}
+
+func (m *InfluxDB) send(req *http.Request) ([]byte, error) {
+	if m.token != "" {
+		req.Header.Add("Authorization", "Token "+m.token)
+	} else if m.basicAuthUsername != "" {
+		ba := base64.StdEncoding.EncodeToString([]byte(m.basicAuthUsername + ":" + m.basicAuthPassword))
+		req.Header.Add("Authorization", "Basic "+ba)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	req = req.WithContext(ctx)
+
+	res, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error read response body, %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response code %d, %s", res.StatusCode, body)
+	}
+
+	return body, nil
+}
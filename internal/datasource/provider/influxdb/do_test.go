@@ -0,0 +1,163 @@
+package influxdb
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/datasources/influxdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const csvResponse = `#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string,string
+#group,false,false,true,true,false,false,true,true,true
+#default,_result,,,,,,,,
+,result,table,_start,_stop,_time,_value,_field,_measurement,host
+,,0,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:00:00Z,1.5,usage,cpu,a
+,,0,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:00:10.5Z,2.5,usage,cpu,a
+,,1,2022-01-01T00:00:00Z,2022-01-01T01:00:00Z,2022-01-01T00:00:00Z,3,usage,cpu,b
+
+#datatype,string,long,dateTime:RFC3339,string,double,boolean
+#group,false,false,false,true,false,false
+#default,_result,,,,,
+,result,table,_time,host,temp,alarm
+,,2,2022-01-01T00:00:00Z,c,20.5,true
+`
+
+func newTestInfluxDB(t *testing.T, version int, handler http.HandlerFunc) *InfluxDB {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	return &InfluxDB{
+		logger:   zap.NewNop(),
+		name:     "influxdb.foo",
+		url:      u,
+		version:  version,
+		database: "db1",
+		org:      "org1",
+		token:    "token1",
+		client:   srv.Client(),
+		timeout:  time.Second,
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	series, err := parseCSV([]byte(csvResponse))
+	require.NoError(t, err)
+	require.Equal(t, 4, len(series))
+
+	assert.Equal(t, map[string]string{"_field": "usage", "_measurement": "cpu", "host": "a"}, series[0].Metrics)
+	assert.Equal(t, []Sample{{Timestamp: 1640995200, Value: 1.5}, {Timestamp: 1640995210.5, Value: 2.5}}, series[0].Values)
+
+	assert.Equal(t, map[string]string{"_field": "usage", "_measurement": "cpu", "host": "b"}, series[1].Metrics)
+	assert.Equal(t, []Sample{{Timestamp: 1640995200, Value: float64(3)}}, series[1].Values)
+
+	assert.Equal(t, map[string]string{"_field": "temp", "host": "c"}, series[2].Metrics)
+	assert.Equal(t, []Sample{{Timestamp: 1640995200, Value: 20.5}}, series[2].Values)
+
+	assert.Equal(t, map[string]string{"_field": "alarm", "host": "c"}, series[3].Metrics)
+	assert.Equal(t, []Sample{{Timestamp: 1640995200, Value: float64(1)}}, series[3].Values)
+}
+
+func TestParseCSV_yields(t *testing.T) {
+	body := `#datatype,string,long,dateTime:RFC3339,double
+#group,false,false,false,false
+#default,first,,,
+,result,table,_time,_value
+,,0,2022-01-01T00:00:00Z,1
+
+#datatype,string,long,dateTime:RFC3339,double
+#group,false,false,false,false
+#default,second,,,
+,result,table,_time,_value
+,,0,2022-01-01T00:00:00Z,2
+`
+
+	series, err := parseCSV([]byte(body))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(series))
+	assert.Equal(t, []Sample{{Timestamp: 1640995200, Value: float64(1)}}, series[0].Values)
+	assert.Equal(t, []Sample{{Timestamp: 1640995200, Value: float64(2)}}, series[1].Values)
+}
+
+func TestParseCSV_error(t *testing.T) {
+	_, err := parseCSV([]byte("#datatype,string,string\n#group,true,true\n#default,,\n,error,reference\n,bad query,897\n"))
+	require.Error(t, err)
+	assert.Equal(t, "query error, bad query", err.Error())
+
+	_, err = parseCSV([]byte(",result,table,_time,_value\n,,0,foo,1\n"))
+	require.Error(t, err)
+}
+
+func TestQueryV2(t *testing.T) {
+	m := newTestInfluxDB(t, influxdb.Version2, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "/api/v2/query", req.URL.Path)
+		assert.Equal(t, "org1", req.URL.Query().Get("org"))
+		assert.Equal(t, "Token token1", req.Header.Get("Authorization"))
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), `"query":"from(bucket: \"b\")"`)
+		assert.Contains(t, string(body), `"type":"flux"`)
+
+		rw.Write([]byte(csvResponse))
+	})
+
+	series, err := m.query(`from(bucket: "b")`, &queryOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(series))
+}
+
+func TestQueryV1(t *testing.T) {
+	m := newTestInfluxDB(t, influxdb.Version1, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, "/query", req.URL.Path)
+		assert.Equal(t, "SELECT * FROM cpu", req.URL.Query().Get("q"))
+		assert.Equal(t, "db2", req.URL.Query().Get("db"))
+		assert.Equal(t, "ms", req.URL.Query().Get("epoch"))
+
+		rw.Write([]byte(`{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},
+"columns":["time","usage","state"],"values":[[1640995200000,1.5,"ok"],[1640995210500,null,true]]}]}]}`))
+	})
+
+	series, err := m.query("SELECT * FROM cpu", &queryOptions{DB: "db2"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(series))
+
+	assert.Equal(t, map[string]string{"_measurement": "cpu", "_field": "usage", "host": "a"}, series[0].Metrics)
+	assert.Equal(t, []Sample{{Timestamp: 1640995200, Value: 1.5}}, series[0].Values)
+
+	assert.Equal(t, map[string]string{"_measurement": "cpu", "_field": "state", "host": "a"}, series[1].Metrics)
+	assert.Equal(t, []Sample{{Timestamp: 1640995200, Value: "ok"}, {Timestamp: 1640995210.5, Value: float64(1)}}, series[1].Values)
+}
+
+func TestQueryV1_error(t *testing.T) {
+	m := newTestInfluxDB(t, influxdb.Version1, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "db1", req.URL.Query().Get("db"))
+		rw.Write([]byte(`{"results":[{"statement_id":0,"error":"database not found: db1"}]}`))
+	})
+
+	_, err := m.query("SELECT * FROM cpu", &queryOptions{})
+	require.Error(t, err)
+	assert.Equal(t, "query error, database not found: db1", err.Error())
+}
+
+func TestSend_error_status(t *testing.T) {
+	m := newTestInfluxDB(t, influxdb.Version2, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusUnauthorized)
+		rw.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`))
+	})
+
+	_, err := m.query("foo", &queryOptions{})
+	require.Error(t, err)
+	assert.Equal(t, `unexpected response code 401, {"code":"unauthorized","message":"unauthorized access"}`, err.Error())
+}
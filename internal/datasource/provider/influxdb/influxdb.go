@@ -0,0 +1,114 @@
+package influxdb
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/datasources/influxdb"
+	"github.com/balerter/balerter/internal/modules"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+var (
+	defaultTimeout = time.Second * 5
+)
+
+// ModuleName returns the module name
+func ModuleName(name string) string {
+	return "influxdb." + name
+}
+
+// Methods returns module methods
+func Methods() []string {
+	return []string{
+		"query",
+	}
+}
+
+type httpClient interface {
+	CloseIdleConnections()
+	Do(r *http.Request) (*http.Response, error)
+}
+
+// InfluxDB represents the datasource of the type InfluxDB
+type InfluxDB struct {
+	logger            *zap.Logger
+	name              string
+	url               *url.URL
+	version           int
+	database          string
+	basicAuthUsername string
+	basicAuthPassword string
+	org               string
+	token             string
+	client            httpClient
+	timeout           time.Duration
+}
+
+// New creates new InfluxDB datasource
+func New(cfg influxdb.InfluxDB, logger *zap.Logger) (*InfluxDB, error) {
+	m := &InfluxDB{
+		logger:   logger,
+		name:     ModuleName(cfg.Name),
+		version:  cfg.Version,
+		database: cfg.Database,
+		org:      cfg.Org,
+		token:    cfg.Token,
+		timeout:  time.Millisecond * time.Duration(cfg.Timeout),
+	}
+
+	if m.version == 0 {
+		m.version = influxdb.Version2
+	}
+
+	if cfg.BasicAuth != nil {
+		m.basicAuthUsername = cfg.BasicAuth.Username
+		m.basicAuthPassword = cfg.BasicAuth.Password
+	}
+
+	if m.timeout == 0 {
+		m.timeout = defaultTimeout
+	}
+
+	var err error
+
+	m.url, err = url.Parse(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	m.client = &http.Client{
+		Timeout: m.timeout,
+	}
+
+	return m, nil
+}
+
+// Stop the datasource
+func (m *InfluxDB) Stop() error {
+	m.client.CloseIdleConnections()
+	return nil
+}
+
+// Name returns the datasource name
+func (m *InfluxDB) Name() string {
+	return m.name
+}
+
+// GetLoader returns the datasource lua loader
+func (m *InfluxDB) GetLoader(_ modules.Job) lua.LGFunction {
+	return m.loader
+}
+
+func (m *InfluxDB) loader(luaState *lua.LState) int {
+	var exports = map[string]lua.LGFunction{
+		"query": m.doQuery,
+	}
+
+	mod := luaState.SetFuncs(luaState.NewTable(), exports)
+
+	luaState.Push(mod)
+	return 1
+}
@@ -0,0 +1,74 @@
+package influxdb
+
+import (
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/common"
+	"github.com/balerter/balerter/internal/config/datasources/influxdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+func TestNew(t *testing.T) {
+	m, err := New(influxdb.InfluxDB{
+		Name: "influx1",
+		URL:  "http://domain.com",
+		Org:  "org",
+	}, zap.NewNop())
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "influxdb.influx1", m.name)
+	assert.Equal(t, influxdb.Version2, m.version)
+	assert.Equal(t, defaultTimeout, m.timeout)
+
+	m, err = New(influxdb.InfluxDB{
+		Name:      "influx1",
+		URL:       "http://domain.com",
+		Version:   influxdb.Version1,
+		BasicAuth: &common.BasicAuth{Username: "user", Password: "secret"},
+	}, zap.NewNop())
+
+	require.NoError(t, err)
+
+	assert.Equal(t, influxdb.Version1, m.version)
+	assert.Equal(t, "user", m.basicAuthUsername)
+}
+
+func TestNew_fail_url(t *testing.T) {
+	_, err := New(influxdb.InfluxDB{
+		Name: "influx1",
+		URL:  "$% a.a",
+	}, zap.NewNop())
+
+	require.Error(t, err)
+	assert.Equal(t, "parse \"$% a.a\": invalid URL escape \"% a\"", err.Error())
+}
+
+func TestName(t *testing.T) {
+	m := &InfluxDB{name: "influx1"}
+	assert.Equal(t, "influx1", m.Name())
+}
+
+func TestGetLoader(t *testing.T) {
+	m := &InfluxDB{}
+
+	loader := m.GetLoader(nil)
+
+	luaState := lua.NewState()
+
+	n := loader(luaState)
+	assert.Equal(t, 1, n)
+
+	v := luaState.Get(1).(*lua.LTable)
+
+	for _, method := range Methods() {
+		assert.IsType(t, &lua.LFunction{}, v.RawGet(lua.LString(method)))
+	}
+}
+
+func TestModuleName(t *testing.T) {
+	assert.Equal(t, "influxdb.foo", ModuleName("foo"))
+}
@@ -0,0 +1,107 @@
+package influxdb
+
+import (
+	"fmt"
+
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+func (m *InfluxDB) getQuery(luaState *lua.LState) (string, error) {
+	queryV := luaState.Get(1)
+	if queryV.Type() != lua.LTString {
+		return "", fmt.Errorf("query must be a string")
+	}
+	query := string(queryV.(lua.LString))
+	if query == "" {
+		return "", fmt.Errorf("query must be not empty")
+	}
+	return query, nil
+}
+
+func (m *InfluxDB) parseQueryOptions(luaState *lua.LState) (*queryOptions, error) {
+	options := luaState.Get(2)
+	opts := &queryOptions{}
+
+	if options.Type() == lua.LTNil {
+		return opts, nil
+	}
+	if options.Type() != lua.LTTable {
+		return nil, fmt.Errorf("options must be a table")
+	}
+
+	db := options.(*lua.LTable).RawGetString("db")
+	if db.Type() == lua.LTNil {
+		return opts, nil
+	}
+	if db.Type() != lua.LTString {
+		return nil, fmt.Errorf("db must be a string")
+	}
+
+	opts.DB = string(db.(lua.LString))
+
+	return opts, nil
+}
+
+func (m *InfluxDB) doQuery(luaState *lua.LState) int {
+	query, err := m.getQuery(luaState)
+	if err != nil {
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString(err.Error()))
+		return 2
+	}
+
+	options, err := m.parseQueryOptions(luaState)
+	if err != nil {
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString(err.Error()))
+		return 2
+	}
+
+	m.logger.Debug("call influxdb query", zap.String("name", m.name), zap.String("query", query))
+
+	series, err := m.query(query, options)
+	if err != nil {
+		m.logger.Error("error send query to influxdb", zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error send query to influxdb: " + err.Error()))
+		return 2
+	}
+
+	luaState.Push(seriesToLua(series))
+	luaState.Push(lua.LNil)
+
+	return 2
+}
+
+func seriesToLua(series []*Series) *lua.LTable {
+	tbl := &lua.LTable{}
+	for _, s := range series {
+		row := &lua.LTable{}
+
+		metrics := &lua.LTable{}
+		for key, val := range s.Metrics {
+			metrics.RawSet(lua.LString(key), lua.LString(val))
+		}
+
+		values := &lua.LTable{}
+		for _, val := range s.Values {
+			value := &lua.LTable{}
+			value.RawSet(lua.LString("timestamp"), lua.LNumber(val.Timestamp))
+			switch v := val.Value.(type) {
+			case float64:
+				value.RawSet(lua.LString("value"), lua.LNumber(v))
+			case string:
+				value.RawSet(lua.LString("value"), lua.LString(v))
+			}
+
+			values.Append(value)
+		}
+
+		row.RawSet(lua.LString("metrics"), metrics)
+		row.RawSet(lua.LString("values"), values)
+		tbl.Append(row)
+	}
+
+	return tbl
+}
@@ -0,0 +1,87 @@
+package influxdb
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/datasources/influxdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func TestDoQuery(t *testing.T) {
+	m := newTestInfluxDB(t, influxdb.Version2, func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(csvResponse))
+	})
+
+	luaState := lua.NewState()
+	luaState.Push(lua.LString(`from(bucket: "b")`))
+
+	n := m.doQuery(luaState)
+	assert.Equal(t, 2, n)
+	require.Equal(t, lua.LTNil, luaState.Get(3).Type())
+
+	res := luaState.Get(2).(*lua.LTable)
+	require.Equal(t, 4, res.Len())
+
+	row := res.RawGetInt(1).(*lua.LTable)
+	assert.Equal(t, lua.LString("a"), row.RawGetString("metrics").(*lua.LTable).RawGetString("host"))
+
+	values := row.RawGetString("values").(*lua.LTable)
+	require.Equal(t, 2, values.Len())
+	assert.Equal(t, lua.LNumber(1640995210.5), values.RawGetInt(2).(*lua.LTable).RawGetString("timestamp"))
+	assert.Equal(t, lua.LNumber(2.5), values.RawGetInt(2).(*lua.LTable).RawGetString("value"))
+}
+
+func TestDoQuery_errors(t *testing.T) {
+	m := newTestInfluxDB(t, influxdb.Version1, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+	})
+
+	luaState := lua.NewState()
+	luaState.Push(lua.LString(""))
+	m.doQuery(luaState)
+	assert.Equal(t, "query must be not empty", luaState.Get(3).String())
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT 1"))
+	luaState.Push(lua.LNumber(1))
+	m.doQuery(luaState)
+	assert.Equal(t, "options must be a table", luaState.Get(4).String())
+
+	opts := &lua.LTable{}
+	opts.RawSetString("db", lua.LNumber(1))
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT 1"))
+	luaState.Push(opts)
+	m.doQuery(luaState)
+	assert.Equal(t, "db must be a string", luaState.Get(4).String())
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT 1"))
+	m.doQuery(luaState)
+	assert.Equal(t, "error send query to influxdb: unexpected response code 500, ", luaState.Get(3).String())
+}
+
+func TestCoreApiHandler(t *testing.T) {
+	m := newTestInfluxDB(t, influxdb.Version1, func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "db2", req.URL.Query().Get("db"))
+		rw.Write([]byte(`{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","usage"],"values":[[1640995200000,1.5]]}]}]}`))
+	})
+
+	_, code, err := m.CoreApiHandler("range", nil, nil, []byte("SELECT 1"))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	_, code, err = m.CoreApiHandler("query", nil, nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	res, _, err := m.CoreApiHandler("query", nil, map[string]string{"db": "db2"}, []byte("SELECT usage FROM cpu"))
+	require.NoError(t, err)
+	assert.Equal(t, []*Series{{
+		Metrics: map[string]string{"_measurement": "cpu", "_field": "usage"},
+		Values:  []Sample{{Timestamp: 1640995200, Value: 1.5}},
+	}}, res)
+}
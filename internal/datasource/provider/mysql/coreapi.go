@@ -3,10 +3,11 @@ package mysql
 import (
 	"context"
 	"fmt"
-	"go.uber.org/zap"
 	"net/http"
-	"strconv"
-	"time"
+
+	"github.com/balerter/balerter/internal/datasource/converter"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
 )
 
 func (m *MySQL) CoreApiHandler(method string, parts []string, params map[string]string, body []byte) (any, int, error) {
@@ -14,10 +15,20 @@ func (m *MySQL) CoreApiHandler(method string, parts []string, params map[string]
 		return nil, http.StatusBadRequest, fmt.Errorf("unknown method: %q", method)
 	}
 
+	args, err := converter.ArgsFromParams(params)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error parse args, %w", err)
+	}
+
+	q, bindArgs, err := args.Bind(string(body), sqlx.QUESTION)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error bind args, %w", err)
+	}
+
 	ctx, ctxCancel := context.WithTimeout(context.Background(), m.timeout)
 	defer ctxCancel()
 
-	rows, err := m.db.QueryContext(ctx, string(body))
+	rows, err := m.db.QueryContext(ctx, q, bindArgs...)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
@@ -28,7 +39,7 @@ func (m *MySQL) CoreApiHandler(method string, parts []string, params map[string]
 	dest := make([]any, 0)
 
 	for range cct {
-		dest = append(dest, new([]byte))
+		dest = append(dest, new(any))
 	}
 
 	var result []map[string]any
@@ -42,34 +53,7 @@ func (m *MySQL) CoreApiHandler(method string, parts []string, params map[string]
 		row := map[string]any{}
 
 		for idx, c := range cct {
-			raw := string(*dest[idx].(*[]byte))
-
-			switch c.DatabaseTypeName() {
-			case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "BIGINT":
-				v, err := strconv.ParseInt(raw, 10, 64)
-				if err != nil {
-					m.logger.Error("error parse value", zap.String("value", raw), zap.Error(err))
-					return nil, http.StatusInternalServerError, err
-				}
-				row[c.Name()] = v
-			case "DECIMAL", "NUMERIC", "FLOAT", "DOUBLE":
-				v, err := strconv.ParseFloat(raw, 64)
-				if err != nil {
-					m.logger.Error("error parse value", zap.String("value", raw), zap.Error(err))
-					return nil, http.StatusInternalServerError, err
-				}
-				row[c.Name()] = v
-			case "DATETIME":
-				v, err := time.Parse("2006-01-02 15:04:05", raw)
-				if err != nil {
-					m.logger.Error("error parse value", zap.String("value", raw), zap.Error(err))
-					return nil, http.StatusInternalServerError, err
-				}
-				row[c.Name()] = v
-
-			default:
-				row[c.Name()] = raw
-			}
+			row[c.Name()] = m.converter.ToAPI(value(*dest[idx].(*any), c))
 		}
 
 		result = append(result, row)
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"github.com/balerter/balerter/internal/config/datasources/mysql"
+	"github.com/balerter/balerter/internal/datasource/converter"
 	"github.com/balerter/balerter/internal/modules"
 	_ "github.com/go-sql-driver/mysql" // import DB driver
 	"github.com/jmoiron/sqlx"
@@ -28,10 +29,11 @@ func Methods() []string {
 
 // MySQL represent the datasource of type MySQL
 type MySQL struct {
-	name    string
-	logger  *zap.Logger
-	db      *sqlx.DB
-	timeout time.Duration
+	name      string
+	logger    *zap.Logger
+	db        *sqlx.DB
+	timeout   time.Duration
+	converter *converter.Converter
 }
 
 // SQLConnFunc represent ConnFunc
@@ -40,9 +42,10 @@ type SQLConnFunc func(string, string) (*sqlx.DB, error)
 // New creates new MySQL datasource
 func New(cfg mysql.Mysql, sqlConnFunc SQLConnFunc, logger *zap.Logger) (*MySQL, error) {
 	p := &MySQL{
-		name:    ModuleName(cfg.Name),
-		logger:  logger,
-		timeout: time.Millisecond * time.Duration(cfg.Timeout),
+		name:      ModuleName(cfg.Name),
+		logger:    logger,
+		timeout:   time.Millisecond * time.Duration(cfg.Timeout),
+		converter: converter.New(cfg.Conversion),
 	}
 
 	if p.timeout == 0 {
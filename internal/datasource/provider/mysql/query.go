@@ -2,22 +2,46 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
+
 	"github.com/balerter/balerter/internal/datasource/converter"
+	"github.com/jmoiron/sqlx"
 	lua "github.com/yuin/gopher-lua"
 	"go.uber.org/zap"
 )
 
+const (
+	datetimeLayout = "2006-01-02 15:04:05.999999"
+	dateLayout     = "2006-01-02"
+)
+
 func (m *MySQL) query(luaState *lua.LState) int {
 	q := luaState.Get(1).String()
 
 	m.logger.Debug("call mysql query", zap.String("query", q))
 
+	args, err := converter.ArgsFromLua(luaState.Get(2))
+	if err != nil {
+		m.logger.Error("error parse mysql query args", zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error parse args: " + err.Error()))
+		return 2
+	}
+
+	boundQuery, bindArgs, err := args.Bind(q, sqlx.QUESTION)
+	if err != nil {
+		m.logger.Error("error bind mysql query args", zap.String("query", q), zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error bind args: " + err.Error()))
+		return 2
+	}
+
 	ctx, ctxCancel := context.WithTimeout(context.Background(), m.timeout)
 	defer ctxCancel()
 
-	rows, err := m.db.QueryContext(ctx, q)
+	rows, err := m.db.QueryContext(ctx, boundQuery, bindArgs...)
 	if err != nil {
-		m.logger.Error("error mysql query", zap.String("query", q), zap.Error(err))
+		m.logger.Error("error mysql query", zap.String("query", boundQuery), zap.Error(err))
 		luaState.Push(lua.LNil)
 		luaState.Push(lua.LString(err.Error()))
 		return 2
@@ -27,11 +51,9 @@ func (m *MySQL) query(luaState *lua.LState) int {
 	cct, _ := rows.ColumnTypes()
 
 	dest := make([]interface{}, 0)
-	ffs := make([]func(v interface{}) lua.LValue, 0)
 
 	for range cct {
-		dest = append(dest, new([]byte))
-		ffs = append(ffs, converter.FromDateBytes)
+		dest = append(dest, new(interface{}))
 	}
 
 	result := &lua.LTable{}
@@ -47,7 +69,7 @@ func (m *MySQL) query(luaState *lua.LState) int {
 		row := &lua.LTable{}
 
 		for idx, c := range cct {
-			v := ffs[idx](dest[idx])
+			v := m.converter.ToLua(value(*dest[idx].(*interface{}), c))
 			row.RawSet(lua.LString(c.Name()), v)
 		}
 
@@ -64,3 +86,39 @@ func (m *MySQL) query(luaState *lua.LState) int {
 	luaState.Push(lua.LNil)
 	return 2
 }
+
+// value returns the value of the row. The text protocol returns all values as bytes,
+// so they are parsed by the column type. The value is kept as a string, if it can't be parsed, e.g. a zero date
+func value(v interface{}, c *sql.ColumnType) interface{} {
+	raw, ok := v.([]byte)
+	if !ok {
+		return v
+	}
+
+	kind, layout := columnKind(c.DatabaseTypeName())
+
+	res, err := converter.ParseText(raw, kind, layout)
+	if err != nil {
+		return string(raw)
+	}
+
+	return res
+}
+
+// columnKind returns the kind of the column values and the layout of the time values
+func columnKind(typeName string) (converter.Kind, string) {
+	switch typeName {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "BIGINT", "YEAR":
+		return converter.KindInt, ""
+	case "DECIMAL":
+		return converter.KindDecimal, ""
+	case "FLOAT", "DOUBLE":
+		return converter.KindFloat, ""
+	case "DATETIME", "TIMESTAMP":
+		return converter.KindTime, datetimeLayout
+	case "DATE":
+		return converter.KindTime, dateLayout
+	}
+
+	return converter.KindString, ""
+}
@@ -2,11 +2,15 @@ package mysql
 
 import (
 	"math/rand"
+	"net/http"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/balerter/balerter/internal/datasource/converter"
 	"github.com/balerter/balerter/internal/luaformatter"
 	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	lua "github.com/yuin/gopher-lua"
@@ -29,8 +33,9 @@ func getDB(t *testing.T) *sqlx.DB {
 
 func TestQuery_ErrorQuery(t *testing.T) {
 	m := &MySQL{
-		logger:  zap.NewNop(),
-		timeout: time.Second,
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		converter: converter.New(nil),
 	}
 
 	query := "simple query"
@@ -57,9 +62,10 @@ func TestQuery(t *testing.T) {
 	query := "SELECT '1' AS ID,'Foo' AS Name UNION SELECT '20','Bar'"
 
 	m := &MySQL{
-		logger:  zap.NewNop(),
-		timeout: time.Second,
-		db:      db,
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		db:        db,
+		converter: converter.New(nil),
 	}
 
 	luaState := lua.NewState()
@@ -88,3 +94,115 @@ func TestQuery(t *testing.T) {
 	assert.Equal(t, "{\"ID\":\"1\",\"Name\":\"Foo\"}", row1str)
 	assert.Equal(t, "{\"ID\":\"20\",\"Name\":\"Bar\"}", row2str)
 }
+
+func TestColumnKind(t *testing.T) {
+	kind, _ := columnKind("BIGINT")
+	assert.Equal(t, converter.KindInt, kind)
+	kind, _ = columnKind("DECIMAL")
+	assert.Equal(t, converter.KindDecimal, kind)
+	kind, _ = columnKind("DOUBLE")
+	assert.Equal(t, converter.KindFloat, kind)
+	kind, layout := columnKind("DATETIME")
+	assert.Equal(t, converter.KindTime, kind)
+	assert.Equal(t, datetimeLayout, layout)
+	kind, layout = columnKind("DATE")
+	assert.Equal(t, converter.KindTime, kind)
+	assert.Equal(t, dateLayout, layout)
+	kind, _ = columnKind("VARCHAR")
+	assert.Equal(t, converter.KindString, kind)
+}
+
+// newArgsDB returns the sqlite database with the same '?' placeholders, so the args binding is tested without the mysql server
+func newArgsDB(t *testing.T) *sqlx.DB {
+	db, err := sqlx.Connect("sqlite3", filepath.Join(t.TempDir(), "db.sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec("CREATE TABLE items (id INTEGER, name TEXT)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO items VALUES (1, 'Foo'), (20, 'Bar')")
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestQuery_Args(t *testing.T) {
+	m := &MySQL{
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		db:        newArgsDB(t),
+		converter: converter.New(nil),
+	}
+
+	args := &lua.LTable{}
+	args.Append(lua.LNumber(20))
+
+	luaState := lua.NewState()
+	luaState.Push(lua.LString("SELECT name FROM items WHERE id = ?"))
+	luaState.Push(args)
+
+	n := m.query(luaState)
+	assert.Equal(t, 2, n)
+	require.Equal(t, lua.LTNil, luaState.Get(4).Type())
+
+	res := luaState.Get(3).(*lua.LTable)
+	require.Equal(t, 1, res.Len())
+	assert.Equal(t, lua.LString("Bar"), res.RawGetInt(1).(*lua.LTable).RawGetString("name"))
+
+	args = &lua.LTable{}
+	args.RawSetString("name", lua.LString("Foo"))
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT id FROM items WHERE name = :name"))
+	luaState.Push(args)
+
+	n = m.query(luaState)
+	assert.Equal(t, 2, n)
+	require.Equal(t, lua.LTNil, luaState.Get(4).Type())
+
+	res = luaState.Get(3).(*lua.LTable)
+	require.Equal(t, 1, res.Len())
+	assert.Equal(t, lua.LNumber(1), res.RawGetInt(1).(*lua.LTable).RawGetString("id"))
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT id FROM items WHERE name = :foo"))
+	luaState.Push(args)
+
+	n = m.query(luaState)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "error bind args: could not find name foo in map[string]interface {}{\"name\":\"Foo\"}", luaState.Get(4).String())
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT id FROM items"))
+	luaState.Push(lua.LNumber(1))
+
+	n = m.query(luaState)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "error parse args: args must be a table, got number", luaState.Get(4).String())
+}
+
+func TestCoreApiHandler_Args(t *testing.T) {
+	m := &MySQL{
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		db:        newArgsDB(t),
+		converter: converter.New(nil),
+	}
+
+	res, _, err := m.CoreApiHandler("query", nil, map[string]string{"args": `{"id": 20}`}, []byte("SELECT name FROM items WHERE id = :id"))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"name": "Bar"}}, res)
+
+	res, _, err = m.CoreApiHandler("query", nil, map[string]string{"args": `["Foo"]`}, []byte("SELECT id FROM items WHERE name = ?"))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"id": int64(1)}}, res)
+
+	_, code, err := m.CoreApiHandler("query", nil, map[string]string{"args": `[`}, []byte("SELECT name FROM items WHERE id = ?"))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	_, code, err = m.CoreApiHandler("query", nil, map[string]string{"args": `{"id": 20}`}, []byte("SELECT name FROM items WHERE id = :foo"))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "error bind args, could not find name foo in map[string]interface {}{\"id\":20}", err.Error())
+}
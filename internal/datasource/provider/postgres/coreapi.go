@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/balerter/balerter/internal/datasource/converter"
+	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 )
 
@@ -13,10 +15,20 @@ func (m *Postgres) CoreApiHandler(method string, parts []string, params map[stri
 		return nil, http.StatusBadRequest, fmt.Errorf("unknown method %q", method)
 	}
 
+	args, err := converter.ArgsFromParams(params)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error parse args, %w", err)
+	}
+
+	q, bindArgs, err := args.Bind(string(body), sqlx.DOLLAR)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error bind args, %w", err)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
 	defer cancel()
 
-	rows, err := m.db.Query(ctx, string(body))
+	rows, err := m.db.Query(ctx, q, bindArgs...)
 	if err != nil {
 		m.logger.Error("error postgres query", zap.ByteString("query", body), zap.Error(err))
 		return nil, http.StatusBadRequest, err
@@ -35,7 +47,7 @@ func (m *Postgres) CoreApiHandler(method string, parts []string, params map[stri
 		row := map[string]any{}
 
 		for idx, fd := range rows.FieldDescriptions() {
-			row[string(fd.Name)] = values[idx]
+			row[string(fd.Name)] = m.converter.ToAPI(value(values[idx], fd.DataTypeOID))
 		}
 
 		result = append(result, row)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/balerter/balerter/internal/config/datasources/postgres"
+	"github.com/balerter/balerter/internal/datasource/converter"
 	"github.com/balerter/balerter/internal/modules"
 
 	"github.com/jackc/pgx/v4"
@@ -39,10 +40,11 @@ type dbpool interface {
 
 // Postgres represents the datasource of the type Postgres
 type Postgres struct {
-	name    string
-	logger  *zap.Logger
-	db      dbpool
-	timeout time.Duration
+	name      string
+	logger    *zap.Logger
+	db        dbpool
+	timeout   time.Duration
+	converter *converter.Converter
 }
 
 type SQLConnectFunc func(ctx context.Context, connString string) (*pgxpool.Pool, error)
@@ -50,9 +52,10 @@ type SQLConnectFunc func(ctx context.Context, connString string) (*pgxpool.Pool,
 // New creates new Postgres datasource
 func New(cfg postgres.Postgres, connFunc SQLConnectFunc, logger *zap.Logger) (*Postgres, error) {
 	p := &Postgres{
-		name:    ModuleName(cfg.Name),
-		logger:  logger,
-		timeout: time.Millisecond * time.Duration(cfg.Timeout),
+		name:      ModuleName(cfg.Name),
+		logger:    logger,
+		timeout:   time.Millisecond * time.Duration(cfg.Timeout),
+		converter: converter.New(cfg.Conversion),
 	}
 
 	if p.timeout == 0 {
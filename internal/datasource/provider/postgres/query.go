@@ -3,7 +3,11 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"math/big"
 
+	"github.com/balerter/balerter/internal/datasource/converter"
+	"github.com/jackc/pgtype"
+	"github.com/jmoiron/sqlx"
 	lua "github.com/yuin/gopher-lua"
 	"go.uber.org/zap"
 )
@@ -13,12 +17,28 @@ func (m *Postgres) query(luaState *lua.LState) int {
 
 	m.logger.Debug("call postgres query", zap.String("query", q))
 
+	args, err := converter.ArgsFromLua(luaState.Get(2))
+	if err != nil {
+		m.logger.Error("error parse postgres query args", zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error parse args: " + err.Error()))
+		return 2
+	}
+
+	boundQuery, bindArgs, err := args.Bind(q, sqlx.DOLLAR)
+	if err != nil {
+		m.logger.Error("error bind postgres query args", zap.String("query", q), zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error bind args: " + err.Error()))
+		return 2
+	}
+
 	ctx, ctxCancel := context.WithTimeout(context.Background(), m.timeout)
 	defer ctxCancel()
 
-	rows, err := m.db.Query(ctx, q)
+	rows, err := m.db.Query(ctx, boundQuery, bindArgs...)
 	if err != nil {
-		m.logger.Error("error postgres query", zap.String("query", q), zap.Error(err))
+		m.logger.Error("error postgres query", zap.String("query", boundQuery), zap.Error(err))
 		luaState.Push(lua.LNil)
 		luaState.Push(lua.LString(err.Error()))
 		return 2
@@ -39,7 +59,7 @@ func (m *Postgres) query(luaState *lua.LState) int {
 		row := &lua.LTable{}
 
 		for idx, fd := range rows.FieldDescriptions() {
-			row.RawSet(lua.LString(fd.Name), lua.LString(fmt.Sprintf("%v", values[idx])))
+			row.RawSet(lua.LString(fd.Name), m.converter.ToLua(value(values[idx], fd.DataTypeOID)))
 		}
 
 		result.Append(row)
@@ -55,3 +75,32 @@ func (m *Postgres) query(luaState *lua.LState) int {
 	luaState.Push(lua.LNil)
 	return 2
 }
+
+// value returns the value of the row. Numerics are returned by pgx as pgtype.Numeric and uuids as [16]byte.
+// The infinite numerics, dates and timestamps are returned as pgtype.InfinityModifier, the column type tells them apart
+func value(v interface{}, oid uint32) interface{} {
+	switch t := v.(type) {
+	case pgtype.InfinityModifier:
+		if oid == pgtype.NumericOID {
+			if t == pgtype.NegativeInfinity {
+				return converter.Decimal("-Infinity")
+			}
+			return converter.Decimal("Infinity")
+		}
+		// 'infinity' or '-infinity' as postgres writes the dates and timestamps
+		return t.String()
+	case pgtype.Numeric:
+		if t.NaN {
+			return converter.Decimal("NaN")
+		}
+		if t.Exp >= 0 {
+			exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(t.Exp)), nil) //nolint:gomnd // decimal base
+			return converter.Decimal(new(big.Int).Mul(t.Int, exp).String())
+		}
+		return converter.DecimalFromUnscaled(t.Int, int(-t.Exp))
+	case [16]byte:
+		return fmt.Sprintf("%x-%x-%x-%x-%x", t[0:4], t[4:6], t[6:8], t[8:10], t[10:16])
+	}
+
+	return v
+}
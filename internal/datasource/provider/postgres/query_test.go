@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"github.com/balerter/balerter/internal/config/datasources/postgres"
+	"github.com/balerter/balerter/internal/datasource/converter"
 	"github.com/balerter/balerter/internal/luaformatter"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	lua "github.com/yuin/gopher-lua"
 	"go.uber.org/zap"
+	"math"
+	"math/big"
 	"testing"
 	"time"
 )
@@ -26,9 +30,10 @@ func TestQuery_ErrorQuery(t *testing.T) {
 	}
 
 	m := &Postgres{
-		logger:  zap.NewNop(),
-		timeout: time.Second,
-		db:      dbmock,
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		db:        dbmock,
+		converter: converter.New(nil),
 	}
 
 	luaState := lua.NewState()
@@ -43,6 +48,45 @@ func TestQuery_ErrorQuery(t *testing.T) {
 	assert.Equal(t, "err1", e.String())
 }
 
+func TestQuery_Args(t *testing.T) {
+	dbmock := &dbpoolMock{
+		QueryFunc: func(_ context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+			return nil, fmt.Errorf("err1")
+		},
+	}
+
+	m := &Postgres{
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		db:        dbmock,
+		converter: converter.New(nil),
+	}
+
+	args := &lua.LTable{}
+	args.RawSetString("id", lua.LNumber(42))
+	args.RawSetString("name", lua.LString("foo"))
+
+	luaState := lua.NewState()
+	luaState.Push(lua.LString("SELECT * FROM t WHERE id = :id AND name = :name"))
+	luaState.Push(args)
+
+	n := m.query(luaState)
+	assert.Equal(t, 2, n)
+
+	calls := dbmock.QueryCalls()
+	require.Equal(t, 1, len(calls))
+	assert.Equal(t, "SELECT * FROM t WHERE id = $1 AND name = $2", calls[0].SQL)
+	assert.Equal(t, []interface{}{int64(42), "foo"}, calls[0].Args)
+
+	_, _, err := m.CoreApiHandler("query", nil, map[string]string{"args": `[42]`}, []byte("SELECT * FROM t WHERE id = $1"))
+	require.Error(t, err)
+
+	calls = dbmock.QueryCalls()
+	require.Equal(t, 2, len(calls))
+	assert.Equal(t, "SELECT * FROM t WHERE id = $1", calls[1].SQL)
+	assert.Equal(t, []interface{}{int64(42)}, calls[1].Args)
+}
+
 func TestQuery(t *testing.T) {
 	cfg := postgres.Postgres{
 		Name:        "pg1",
@@ -84,6 +128,30 @@ func TestQuery(t *testing.T) {
 	row2str, err := luaformatter.TableToString(row2.(*lua.LTable))
 	require.NoError(t, err)
 
-	assert.Equal(t, `{"birthday":"<nil>","id":"1","is_male":"true","name":"Foo"}`, row1str)
-	assert.Equal(t, `{"birthday":"2004-10-19 10:23:54 +0000 UTC","id":"20","is_male":"false","name":"Bar"}`, row2str)
+	assert.Equal(t, `{"id":1,"is_male":true,"name":"Foo"}`, row1str)
+	assert.Equal(t, `{"birthday":"2004-10-19T10:23:54Z","id":20,"is_male":false,"name":"Bar"}`, row2str)
+}
+
+func TestValue(t *testing.T) {
+	n := pgtype.Numeric{}
+	require.NoError(t, n.Set("123.45"))
+	assert.Equal(t, converter.Decimal("123.45"), value(n, pgtype.NumericOID))
+	require.NoError(t, n.Set("-0.05"))
+	assert.Equal(t, converter.Decimal("-0.05"), value(n, pgtype.NumericOID))
+	assert.Equal(t, converter.Decimal("1200"), value(pgtype.Numeric{Int: big.NewInt(12), Exp: 2, Status: pgtype.Present}, pgtype.NumericOID))
+
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", value([16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1,
+		0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}, pgtype.UUIDOID))
+	assert.Equal(t, int32(1), value(int32(1), pgtype.Int4OID))
+	assert.Nil(t, value(nil, pgtype.Int4OID))
+
+	assert.Equal(t, converter.Decimal("Infinity"), value(pgtype.Infinity, pgtype.NumericOID))
+	assert.Equal(t, converter.Decimal("-Infinity"), value(pgtype.NegativeInfinity, pgtype.NumericOID))
+	assert.Equal(t, "infinity", value(pgtype.Infinity, pgtype.TimestamptzOID))
+	assert.Equal(t, "-infinity", value(pgtype.NegativeInfinity, pgtype.DateOID))
+
+	c := converter.New(nil)
+	assert.Equal(t, lua.LNumber(math.Inf(1)), c.ToLua(value(pgtype.Infinity, pgtype.NumericOID)))
+	assert.Equal(t, lua.LNumber(math.Inf(-1)), c.ToLua(value(pgtype.NegativeInfinity, pgtype.NumericOID)))
+	assert.Equal(t, lua.LString("infinity"), c.ToLua(value(pgtype.Infinity, pgtype.TimestampOID)))
 }
@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/balerter/balerter/internal/datasource/converter"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+func (m *SQLite) CoreApiHandler(method string, parts []string, params map[string]string, body []byte) (any, int, error) {
+	if method != "query" {
+		return nil, http.StatusBadRequest, fmt.Errorf("unknown method %q", method)
+	}
+
+	args, err := converter.ArgsFromParams(params)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error parse args, %w", err)
+	}
+
+	q, bindArgs, err := args.Bind(string(body), sqlx.QUESTION)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("error bind args, %w", err)
+	}
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), m.timeout)
+	defer ctxCancel()
+
+	rows, err := m.db.QueryContext(ctx, q, bindArgs...)
+	if err != nil {
+		m.logger.Error("error sqlite query", zap.ByteString("query", body), zap.Error(err))
+		return nil, http.StatusBadRequest, err
+	}
+	defer rows.Close()
+
+	cols, _ := rows.Columns()
+
+	dest := make([]any, 0)
+
+	for range cols {
+		dest = append(dest, new(any))
+	}
+
+	var result []map[string]any
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			m.logger.Error("error scan", zap.Error(err))
+			return nil, http.StatusInternalServerError, err
+		}
+
+		row := map[string]any{}
+
+		for idx, c := range cols {
+			row[c] = m.converter.ToAPI(*dest[idx].(*any))
+		}
+
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		m.logger.Error("rows error", zap.Error(err))
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return result, 0, nil
+}
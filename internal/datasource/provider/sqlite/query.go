@@ -0,0 +1,82 @@
+package sqlite
+
+import (
+	"context"
+
+	"github.com/balerter/balerter/internal/datasource/converter"
+	"github.com/jmoiron/sqlx"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+func (m *SQLite) query(luaState *lua.LState) int {
+	q := luaState.Get(1).String()
+
+	m.logger.Debug("call sqlite query", zap.String("query", q))
+
+	args, err := converter.ArgsFromLua(luaState.Get(2))
+	if err != nil {
+		m.logger.Error("error parse sqlite query args", zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error parse args: " + err.Error()))
+		return 2
+	}
+
+	boundQuery, bindArgs, err := args.Bind(q, sqlx.QUESTION)
+	if err != nil {
+		m.logger.Error("error bind sqlite query args", zap.String("query", q), zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error bind args: " + err.Error()))
+		return 2
+	}
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), m.timeout)
+	defer ctxCancel()
+
+	rows, err := m.db.QueryContext(ctx, boundQuery, bindArgs...)
+	if err != nil {
+		m.logger.Error("error sqlite query", zap.String("query", boundQuery), zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString(err.Error()))
+		return 2
+	}
+	defer rows.Close()
+
+	cols, _ := rows.Columns()
+
+	dest := make([]interface{}, 0)
+
+	for range cols {
+		dest = append(dest, new(interface{}))
+	}
+
+	result := &lua.LTable{}
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			m.logger.Error("error scan", zap.Error(err))
+			luaState.Push(lua.LNil)
+			luaState.Push(lua.LString("error scan: " + err.Error()))
+			return 2
+		}
+
+		row := &lua.LTable{}
+
+		for idx, c := range cols {
+			v := m.converter.ToLua(*dest[idx].(*interface{}))
+			row.RawSet(lua.LString(c), v)
+		}
+
+		result.Append(row)
+	}
+	if err := rows.Err(); err != nil {
+		m.logger.Error("error next", zap.Error(err))
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString("error next: " + err.Error()))
+		return 2
+	}
+
+	luaState.Push(result)
+	luaState.Push(lua.LNil)
+	return 2
+}
@@ -0,0 +1,156 @@
+package sqlite
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/datasource/converter"
+	"github.com/balerter/balerter/internal/luaformatter"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+func newTestSQLite(t *testing.T) *SQLite {
+	db, err := sqlx.Connect("sqlite3", filepath.Join(t.TempDir(), "db.sqlite"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec("CREATE TABLE items (id INTEGER, name TEXT, price REAL, note TEXT)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO items VALUES (1, 'Foo', 1.5, NULL), (20, 'Bar', 2, 'baz')")
+	require.NoError(t, err)
+
+	return &SQLite{
+		logger:    zap.NewNop(),
+		timeout:   time.Second,
+		db:        db,
+		converter: converter.New(nil),
+	}
+}
+
+func TestQuery_ErrorQuery(t *testing.T) {
+	m := newTestSQLite(t)
+
+	luaState := lua.NewState()
+	luaState.Push(lua.LString("simple query"))
+
+	n := m.query(luaState)
+
+	assert.Equal(t, 2, n)
+
+	e := luaState.Get(3)
+	assert.Equal(t, lua.LTString, e.Type())
+	assert.Equal(t, `near "simple": syntax error`, e.String())
+}
+
+func TestQuery(t *testing.T) {
+	m := newTestSQLite(t)
+
+	luaState := lua.NewState()
+	luaState.Push(lua.LString("SELECT id, name, price, note FROM items ORDER BY id"))
+
+	n := m.query(luaState)
+
+	assert.Equal(t, 2, n)
+
+	arg2 := luaState.Get(2)
+	arg3 := luaState.Get(3)
+
+	assert.Equal(t, arg3.Type(), lua.LTNil)
+	assert.Equal(t, arg2.Type(), lua.LTTable)
+
+	n = arg2.(*lua.LTable).Len()
+	assert.Equal(t, 2, n)
+	row1 := arg2.(*lua.LTable).RawGet(lua.LNumber(1))
+	row2 := arg2.(*lua.LTable).RawGet(lua.LNumber(2))
+
+	row1str, err := luaformatter.TableToString(row1.(*lua.LTable))
+	require.NoError(t, err)
+	row2str, err := luaformatter.TableToString(row2.(*lua.LTable))
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"id":1,"name":"Foo","price":1.5}`, row1str)
+	assert.Equal(t, `{"id":20,"name":"Bar","note":"baz","price":2}`, row2str)
+}
+
+func TestQuery_Args(t *testing.T) {
+	m := newTestSQLite(t)
+
+	args := &lua.LTable{}
+	args.Append(lua.LNumber(20))
+
+	luaState := lua.NewState()
+	luaState.Push(lua.LString("SELECT name FROM items WHERE id = ?"))
+	luaState.Push(args)
+
+	n := m.query(luaState)
+	assert.Equal(t, 2, n)
+	require.Equal(t, lua.LTNil, luaState.Get(4).Type())
+
+	res := luaState.Get(3).(*lua.LTable)
+	require.Equal(t, 1, res.Len())
+	assert.Equal(t, lua.LString("Bar"), res.RawGetInt(1).(*lua.LTable).RawGetString("name"))
+
+	args = &lua.LTable{}
+	args.RawSetString("name", lua.LString("Foo"))
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT id FROM items WHERE name = :name"))
+	luaState.Push(args)
+
+	n = m.query(luaState)
+	assert.Equal(t, 2, n)
+	require.Equal(t, lua.LTNil, luaState.Get(4).Type())
+
+	res = luaState.Get(3).(*lua.LTable)
+	require.Equal(t, 1, res.Len())
+	assert.Equal(t, lua.LNumber(1), res.RawGetInt(1).(*lua.LTable).RawGetString("id"))
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT id FROM items WHERE name = :foo"))
+	luaState.Push(args)
+
+	n = m.query(luaState)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "error bind args: could not find name foo in map[string]interface {}{\"name\":\"Foo\"}", luaState.Get(4).String())
+
+	luaState = lua.NewState()
+	luaState.Push(lua.LString("SELECT id FROM items"))
+	luaState.Push(lua.LNumber(1))
+
+	n = m.query(luaState)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "error parse args: args must be a table, got number", luaState.Get(4).String())
+}
+
+func TestCoreApiHandler(t *testing.T) {
+	m := newTestSQLite(t)
+
+	_, code, err := m.CoreApiHandler("foo", nil, nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	_, code, err = m.CoreApiHandler("query", nil, nil, []byte("simple query"))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	res, _, err := m.CoreApiHandler("query", nil, nil, []byte("SELECT id, name, price, note FROM items ORDER BY id"))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{
+		{"id": int64(1), "name": "Foo", "price": 1.5, "note": nil},
+		{"id": int64(20), "name": "Bar", "price": float64(2), "note": "baz"},
+	}, res)
+
+	res, _, err = m.CoreApiHandler("query", nil, map[string]string{"args": `{"id": 20}`}, []byte("SELECT name FROM items WHERE id = :id"))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"name": "Bar"}}, res)
+
+	_, code, err = m.CoreApiHandler("query", nil, map[string]string{"args": `[`}, []byte("SELECT name FROM items WHERE id = ?"))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
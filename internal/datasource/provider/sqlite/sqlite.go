@@ -0,0 +1,112 @@
+package sqlite
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/datasources/sqlite"
+	"github.com/balerter/balerter/internal/datasource/converter"
+	"github.com/balerter/balerter/internal/modules"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // import DB driver
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+var (
+	defaultTimeout = time.Second * 5
+)
+
+// ModuleName returns the module name
+func ModuleName(name string) string {
+	return "sqlite." + name
+}
+
+// Methods returns module methods
+func Methods() []string {
+	return []string{
+		"query",
+	}
+}
+
+// SQLite represent the datasource of type SQLite
+type SQLite struct {
+	name      string
+	logger    *zap.Logger
+	db        *sqlx.DB
+	timeout   time.Duration
+	converter *converter.Converter
+}
+
+// SQLConnFunc represent ConnFunc
+type SQLConnFunc func(string, string) (*sqlx.DB, error)
+
+// New creates new SQLite datasource
+func New(cfg sqlite.Sqlite, sqlConnFunc SQLConnFunc, logger *zap.Logger) (*SQLite, error) {
+	p := &SQLite{
+		name:      ModuleName(cfg.Name),
+		logger:    logger,
+		timeout:   time.Millisecond * time.Duration(cfg.Timeout),
+		converter: converter.New(cfg.Conversion),
+	}
+
+	if p.timeout == 0 {
+		p.timeout = defaultTimeout
+	}
+
+	var err error
+
+	p.db, err = sqlConnFunc("sqlite3", dsn(cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.db.Ping(); err != nil {
+		p.db.Close()
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// dsn builds the driver DSN as the file URI with the read-only mode and the busy timeout params
+func dsn(cfg sqlite.Sqlite) string {
+	params := url.Values{}
+	if cfg.ReadOnly {
+		params.Set("mode", "ro")
+	}
+	if cfg.BusyTimeout > 0 {
+		params.Set("_busy_timeout", strconv.Itoa(cfg.BusyTimeout))
+	}
+
+	u := url.URL{Scheme: "file", Opaque: (&url.URL{Path: cfg.Path}).EscapedPath(), RawQuery: params.Encode()}
+
+	return u.String()
+}
+
+// Stop the datasource
+func (m *SQLite) Stop() error {
+	return m.db.Close()
+}
+
+// Name returns the datasource name
+func (m *SQLite) Name() string {
+	return m.name
+}
+
+// GetLoader returns the datasource lua loader
+func (m *SQLite) GetLoader(_ modules.Job) lua.LGFunction {
+	return m.loader
+}
+
+func (m *SQLite) loader(luaState *lua.LState) int {
+	var exports = map[string]lua.LGFunction{
+		"query": m.query,
+	}
+
+	mod := luaState.SetFuncs(luaState.NewTable(), exports)
+
+	luaState.Push(mod)
+	return 1
+}
@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/balerter/balerter/internal/config/datasources/sqlite"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+func TestNew_ErrorConnect(t *testing.T) {
+	mockConnFunc := func(string, string) (*sqlx.DB, error) {
+		return nil, fmt.Errorf("err1")
+	}
+
+	cfg := sqlite.Sqlite{}
+
+	_, err := New(cfg, mockConnFunc, zap.NewNop())
+
+	require.Error(t, err)
+	assert.Equal(t, "err1", err.Error())
+}
+
+func TestNew(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "db.sqlite")
+
+	p, err := New(sqlite.Sqlite{Name: "foo", Path: path}, sqlx.Connect, zap.NewNop())
+	require.NoError(t, err)
+	defer p.Stop()
+
+	assert.Equal(t, "sqlite.foo", p.Name())
+	assert.Equal(t, defaultTimeout, p.timeout)
+}
+
+func TestNew_readOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "db.sqlite")
+
+	_, err := New(sqlite.Sqlite{Name: "foo", Path: path, ReadOnly: true}, sqlx.Connect, zap.NewNop())
+	require.Error(t, err)
+
+	db, err := sqlx.Connect("sqlite3", path)
+	require.NoError(t, err)
+	_, err = db.Exec("CREATE TABLE t (id INTEGER)")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	p, err := New(sqlite.Sqlite{Name: "foo", Path: path, ReadOnly: true}, sqlx.Connect, zap.NewNop())
+	require.NoError(t, err)
+	defer p.Stop()
+
+	_, err = p.db.Exec("INSERT INTO t (id) VALUES (1)")
+	require.Error(t, err)
+}
+
+func TestDSN(t *testing.T) {
+	assert.Equal(t, "file:/tmp/db.sqlite", dsn(sqlite.Sqlite{Path: "/tmp/db.sqlite"}))
+	assert.Equal(t, "file:data/my%20db.sqlite?_busy_timeout=1000&mode=ro",
+		dsn(sqlite.Sqlite{Path: "data/my db.sqlite", ReadOnly: true, BusyTimeout: 1000}))
+}
+
+func TestName(t *testing.T) {
+	p := &SQLite{name: "Foo"}
+	assert.Equal(t, "Foo", p.Name())
+}
+
+func TestGetLoader(t *testing.T) {
+	p := &SQLite{}
+
+	loader := p.GetLoader(nil)
+
+	luaState := lua.NewState()
+
+	n := loader(luaState)
+	assert.Equal(t, 1, n)
+
+	v := luaState.Get(1).(*lua.LTable)
+
+	for _, method := range Methods() {
+		assert.IsType(t, &lua.LFunction{}, v.RawGet(lua.LString(method)))
+	}
+}
+
+func TestModuleName(t *testing.T) {
+	assert.Equal(t, "sqlite.Foo", ModuleName("Foo"))
+}
@@ -0,0 +1,39 @@
+package message
+
+import (
+	"time"
+)
+
+// Event is the message with the alert metadata for machine consumers
+type Event struct {
+	Message *Message    `json:"message"`
+	Alert   *EventAlert `json:"alert"`
+}
+
+// EventAlert is the alert metadata of the Event
+type EventAlert struct {
+	Name       string    `json:"name"`
+	Level      string    `json:"level"`
+	Count      int       `json:"count"`
+	Start      time.Time `json:"start"`
+	LastChange time.Time `json:"last_change"`
+}
+
+// NewEvent creates new Event for the message
+func NewEvent(m *Message) *Event {
+	e := &Event{
+		Message: m,
+		Alert: &EventAlert{
+			Name:  m.AlertName,
+			Level: m.Level,
+		},
+	}
+
+	if m.Alert != nil {
+		e.Alert.Count = m.Alert.Count
+		e.Alert.Start = m.Alert.Start
+		e.Alert.LastChange = m.Alert.LastChange
+	}
+
+	return e
+}
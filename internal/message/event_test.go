@@ -0,0 +1,31 @@
+package message
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	start := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	m := New("error", "foo", "bar", "", nil)
+	m.Alert = &alert.Alert{Name: "foo", Level: alert.LevelError, Count: 3, Start: start, LastChange: start}
+
+	data, err := json.Marshal(NewEvent(m))
+	require.NoError(t, err)
+	assert.Equal(t, `{"message":{"level":"error","alert_name":"foo","text":"bar"},`+
+		`"alert":{"name":"foo","level":"error","count":3,"start":"2022-01-02T03:04:05Z","last_change":"2022-01-02T03:04:05Z"}}`,
+		string(data))
+}
+
+func TestNewEvent_without_alert(t *testing.T) {
+	e := NewEvent(New("success", "foo", "bar", "", nil))
+	assert.Equal(t, "foo", e.Alert.Name)
+	assert.Equal(t, "success", e.Alert.Level)
+	assert.Equal(t, 0, e.Alert.Count)
+}
@@ -0,0 +1,161 @@
+package message
+
+import (
+	"strings"
+
+	"github.com/balerter/balerter/internal/alert"
+)
+
+// LimitMode defines how the text over the channel max length is handled
+type LimitMode int
+
+const (
+	// LimitSplit splits the text into several messages, on line boundaries if possible
+	LimitSplit LimitMode = iota
+	// LimitTruncate cuts the text and adds the truncate mark
+	LimitTruncate
+	// LimitAttach cuts the text and attaches the full text as a file
+	LimitAttach
+)
+
+const (
+	// TruncateMark is added to the truncated text, with ", see <link>" if the message has a link
+	TruncateMark = "\n…truncated"
+	// AttachMark is added to the truncated text, if the full text is attached
+	AttachMark = "\n…truncated, see the attached file"
+
+	// TextAttachmentName is the name of the file with the full text in the LimitAttach mode
+	TextAttachmentName = "message.txt"
+
+	defaultMaxParts = 10
+)
+
+// Limit is the text length limit declared by the channel
+type Limit struct {
+	// MaxLength is the max count of the characters of the text. Zero means no limit
+	MaxLength int
+	Mode      LimitMode
+	// MaxParts is the max count of the messages in the LimitSplit mode, the last one is truncated. 10 by default
+	MaxParts int
+}
+
+// ApplyLimit returns the messages to send instead of the message, so each text fits the limit.
+// In the LimitSplit mode the image, the fields and the links are sent with the first part only.
+// The link, if not empty, is added to the truncate mark, e.g. the first link of the alert
+func ApplyLimit(mes *Message, limit Limit, link string) []*Message {
+	if limit.MaxLength <= 0 || len([]rune(mes.Text)) <= limit.MaxLength {
+		return []*Message{mes}
+	}
+
+	mark := truncateMark(link, limit.MaxLength)
+
+	switch limit.Mode {
+	case LimitTruncate:
+		m := *mes
+		m.Text = Truncate(mes.Text, limit.MaxLength, mark)
+		return []*Message{&m}
+	case LimitAttach:
+		m := *mes
+		m.Text = Truncate(mes.Text, limit.MaxLength, AttachMark)
+		m.Attachments = append(append([]alert.Attachment{}, mes.Attachments...), alert.Attachment{
+			Name:        TextAttachmentName,
+			ContentType: "text/plain; charset=utf-8",
+			Data:        []byte(mes.Text),
+		})
+		return []*Message{&m}
+	}
+
+	maxParts := limit.MaxParts
+	if maxParts <= 0 {
+		maxParts = defaultMaxParts
+	}
+
+	parts := Split(mes.Text, limit.MaxLength, maxParts, mark)
+	result := make([]*Message, 0, len(parts))
+	for idx, part := range parts {
+		m := *mes
+		m.Text = part
+		if idx > 0 {
+			m.Image = ""
+			m.Fields = nil
+			m.Links = nil
+			m.Attachments = nil
+		}
+		result = append(result, &m)
+	}
+
+	return result
+}
+
+// truncateMark returns the truncate mark with the link. The link is omitted if the mark takes more than half of the limit
+func truncateMark(link string, limit int) string {
+	if link == "" {
+		return TruncateMark
+	}
+	mark := TruncateMark + ", see " + link
+	if len([]rune(mark)) > limit/2 {
+		return TruncateMark
+	}
+	return mark
+}
+
+// FirstLink returns the URL of the first link of the message or empty string
+func FirstLink(mes *Message) string {
+	if len(mes.Links) == 0 {
+		return ""
+	}
+	return mes.Links[0].URL
+}
+
+// Truncate cuts the string to limit characters including the mark
+func Truncate(s string, limit int, mark string) string {
+	r := []rune(s)
+	if len(r) <= limit {
+		return s
+	}
+	n := limit - len([]rune(mark))
+	if n < 0 {
+		return string(r[:limit])
+	}
+	return string(r[:n]) + mark
+}
+
+// Split the text to parts with length up to limit. It prefers line boundaries, then spaces.
+// If the text doesn't fit in maxParts, the last part is truncated with the mark
+func Split(s string, limit, maxParts int, mark string) []string {
+	var parts []string
+
+	r := []rune(strings.TrimSpace(s))
+	for len(r) > limit {
+		if len(parts) == maxParts-1 {
+			parts = append(parts, Truncate(string(r), limit, mark))
+			return parts
+		}
+
+		cut := lastIndex(r[:limit+1], '\n')
+		if cut <= 0 {
+			cut = lastIndex(r[:limit+1], ' ')
+		}
+		if cut <= 0 {
+			cut = limit
+		}
+
+		parts = append(parts, strings.TrimSpace(string(r[:cut])))
+		r = []rune(strings.TrimSpace(string(r[cut:])))
+	}
+
+	if len(r) > 0 {
+		parts = append(parts, string(r))
+	}
+
+	return parts
+}
+
+func lastIndex(r []rune, c rune) int {
+	for i := len(r) - 1; i >= 0; i-- {
+		if r[i] == c {
+			return i
+		}
+	}
+	return -1
+}
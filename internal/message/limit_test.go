@@ -0,0 +1,106 @@
+package message
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/balerter/balerter/internal/alert"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "short", Truncate("short", 10, "..."))
+	assert.Equal(t, "1234567...", Truncate("1234567890abc", 10, "..."))
+	assert.Equal(t, "Приве...", Truncate("Привет, мир", 8, "..."))
+	assert.Equal(t, "12", Truncate("1234", 2, "..."))
+}
+
+func TestSplit(t *testing.T) {
+	parts := Split("line one\nline two is longer\nthree", 20, 5, "...")
+	assert.Equal(t, []string{"line one", "line two is longer", "three"}, parts)
+
+	parts = Split("aaaaaaaaaabbbbbbbbbbcc", 10, 5, "...")
+	assert.Equal(t, []string{"aaaaaaaaaa", "bbbbbbbbbb", "cc"}, parts)
+
+	parts = Split(strings.Repeat("word ", 20), 10, 2, "...")
+	assert.Equal(t, []string{"word word", "word wo..."}, parts)
+
+	parts = Split("short", 10, 5, "...")
+	assert.Equal(t, []string{"short"}, parts)
+}
+
+func TestApplyLimit(t *testing.T) {
+	mes := New("error", "alert1", "line one\nline two", "image", map[string]string{"a": "b"})
+	mes.Links = []alert.Link{{Label: "dashboard", URL: "https://example.com/d/1"}}
+
+	res := ApplyLimit(mes, Limit{}, "")
+	require.Equal(t, 1, len(res))
+	assert.Same(t, mes, res[0])
+
+	res = ApplyLimit(mes, Limit{MaxLength: 100}, "")
+	require.Equal(t, 1, len(res))
+	assert.Same(t, mes, res[0])
+
+	res = ApplyLimit(mes, Limit{MaxLength: 10, Mode: LimitSplit}, "")
+	require.Equal(t, 2, len(res))
+	assert.Equal(t, "line one", res[0].Text)
+	assert.Equal(t, "image", res[0].Image)
+	assert.Equal(t, map[string]string{"a": "b"}, res[0].Fields)
+	assert.Equal(t, mes.Links, res[0].Links)
+	assert.Equal(t, "line two", res[1].Text)
+	assert.Equal(t, "", res[1].Image)
+	assert.Nil(t, res[1].Fields)
+	assert.Nil(t, res[1].Links)
+	assert.Equal(t, "alert1", res[1].AlertName)
+	assert.Equal(t, "line one\nline two", mes.Text)
+
+	long := strings.Repeat("a", 100)
+	mes.Text = long
+
+	res = ApplyLimit(mes, Limit{MaxLength: 50, Mode: LimitTruncate}, "")
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, 50, len([]rune(res[0].Text)))
+	assert.True(t, strings.HasSuffix(res[0].Text, TruncateMark))
+	assert.Nil(t, res[0].Attachments)
+
+	res = ApplyLimit(mes, Limit{MaxLength: 50, Mode: LimitAttach}, "")
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, 50, len([]rune(res[0].Text)))
+	assert.True(t, strings.HasSuffix(res[0].Text, AttachMark))
+	require.Equal(t, 1, len(res[0].Attachments))
+	assert.Equal(t, TextAttachmentName, res[0].Attachments[0].Name)
+	assert.Equal(t, []byte(long), res[0].Attachments[0].Data)
+	assert.Nil(t, mes.Attachments)
+
+	res = ApplyLimit(mes, Limit{MaxLength: 20, Mode: LimitSplit, MaxParts: 3}, "")
+	require.Equal(t, 3, len(res))
+	assert.True(t, strings.HasSuffix(res[2].Text, TruncateMark))
+}
+
+func TestApplyLimit_link(t *testing.T) {
+	mes := &Message{Text: strings.Repeat("a", 200)}
+	link := "https://example.com/d/1"
+
+	res := ApplyLimit(mes, Limit{MaxLength: 100, Mode: LimitTruncate}, link)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, 100, len([]rune(res[0].Text)))
+	assert.True(t, strings.HasSuffix(res[0].Text, "\n…truncated, see https://example.com/d/1"))
+
+	res = ApplyLimit(mes, Limit{MaxLength: 90, Mode: LimitSplit, MaxParts: 2}, link)
+	require.Equal(t, 2, len(res))
+	assert.True(t, strings.HasSuffix(res[1].Text, "\n…truncated, see https://example.com/d/1"))
+
+	// the link doesn't fit the short limit
+	res = ApplyLimit(mes, Limit{MaxLength: 40, Mode: LimitTruncate}, link)
+	assert.True(t, strings.HasSuffix(res[0].Text, "a"+TruncateMark))
+
+	// the attached file is referred instead of the link
+	res = ApplyLimit(mes, Limit{MaxLength: 100, Mode: LimitAttach}, link)
+	assert.True(t, strings.HasSuffix(res[0].Text, AttachMark))
+}
+
+func TestFirstLink(t *testing.T) {
+	assert.Equal(t, "", FirstLink(&Message{}))
+	assert.Equal(t, "https://a", FirstLink(&Message{Links: []alert.Link{{Label: "a", URL: "https://a"}, {Label: "b", URL: "https://b"}}}))
+}
@@ -1,5 +1,9 @@
 package message
 
+import (
+	"github.com/balerter/balerter/internal/alert"
+)
+
 // Message represents a Message struct
 type Message struct {
 	Level     string            `json:"level"`
@@ -7,6 +11,15 @@ type Message struct {
 	Text      string            `json:"text"`
 	Image     string            `json:"image,omitempty"`
 	Fields    map[string]string `json:"fields,omitempty"`
+	// Script is the name of the script which sent the alert. May be empty
+	Script string `json:"script,omitempty"`
+	// Links are rendered as the buttons or the links, the channels without the native support add them to the text
+	Links []alert.Link `json:"links,omitempty"`
+	// Attachments are sent as files by the channels which support uploads
+	Attachments []alert.Attachment `json:"-"`
+
+	// Alert contains the alert state at the moment of sending. May be nil
+	Alert *alert.Alert `json:"-"`
 }
 
 // New returns new Message instance
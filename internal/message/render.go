@@ -0,0 +1,30 @@
+package message
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Render replaces {LEVEL}, {ALERT_NAME}, {TEXT}, {IMAGE} and {FIELDS} placeholders in the template.
+// {FIELDS} is replaced with 'key = value' lines sorted by key
+func (m *Message) Render(template string) string {
+	keys := make([]string, 0, len(m.Fields))
+	for k := range m.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fields := make([]string, 0, len(keys))
+	for _, k := range keys {
+		fields = append(fields, fmt.Sprintf("%s = %s", k, m.Fields[k]))
+	}
+
+	return strings.NewReplacer(
+		"{LEVEL}", m.Level,
+		"{ALERT_NAME}", m.AlertName,
+		"{TEXT}", m.Text,
+		"{IMAGE}", m.Image,
+		"{FIELDS}", strings.Join(fields, "\n"),
+	).Replace(template)
+}
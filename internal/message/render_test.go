@@ -0,0 +1,25 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessage_Render(t *testing.T) {
+	m := &Message{
+		Level:     "error",
+		AlertName: "foo",
+		Text:      "bar",
+		Image:     "http://domain.com/image.png",
+		Fields:    map[string]string{"b": "2", "a": "1"},
+	}
+
+	s := m.Render("[{LEVEL}] {ALERT_NAME}: {TEXT} {IMAGE}\n{FIELDS}")
+	assert.Equal(t, "[error] foo: bar http://domain.com/image.png\na = 1\nb = 2", s)
+}
+
+func TestMessage_Render_empty_fields(t *testing.T) {
+	m := &Message{Text: "bar"}
+	assert.Equal(t, "bar|", m.Render("{TEXT}|{FIELDS}"))
+}
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/balerter/balerter/internal/alert"
 
@@ -49,3 +50,24 @@ func SetScriptsActive(name string, active bool) {
 		return 0
 	})
 }
+
+var channelsHealth sync.Map
+
+// SetChannelHealth updates data for metrics balerter_channel_healthy
+func SetChannelHealth(name string, healthy bool) {
+	channelsHealth.Store(name, healthy)
+
+	metricsName := fmt.Sprintf("balerter_channel_healthy{name=%q}", name)
+	metrics.GetOrCreateGauge(metricsName, func() float64 {
+		if v, ok := channelsHealth.Load(name); ok && v.(bool) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// IncChannelFallback updates data for metrics balerter_channel_fallback_total
+func IncChannelFallback(name, fallback string) {
+	metricsName := fmt.Sprintf("balerter_channel_fallback_total{name=%q,fallback=%q}", name, fallback)
+	metrics.GetOrCreateCounter(metricsName).Inc()
+}
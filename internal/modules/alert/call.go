@@ -5,6 +5,9 @@ import (
 	"github.com/balerter/balerter/internal/alert"
 	lua "github.com/yuin/gopher-lua"
 	"go.uber.org/zap"
+	"mime"
+	"net/http"
+	"path"
 	"strings"
 )
 
@@ -131,10 +134,127 @@ func (a *Alert) getAlertData(luaState *lua.LState) (alertName, alertText string,
 		options.Image = imageVal.String()
 	}
 
+	attachmentsVal := alertOptions.RawGetString("attachments")
+	if attachmentsVal != lua.LNil {
+		if attachmentsVal.Type() != lua.LTTable {
+			err = fmt.Errorf("attachments must be a table")
+			return
+		}
+		options.Attachments, err = getAttachments(attachmentsVal.(*lua.LTable))
+		if err != nil {
+			return
+		}
+	}
+
+	linksVal := alertOptions.RawGetString("links")
+	if linksVal != lua.LNil {
+		if linksVal.Type() != lua.LTTable {
+			err = fmt.Errorf("links must be a table")
+			return
+		}
+		options.Links, err = getLinks(linksVal.(*lua.LTable))
+		if err != nil {
+			return
+		}
+	}
+
 	return alertName, alertText, options, nil
 }
 
-func (a *Alert) callFromLua(scriptChannels []string, escalate map[int][]string, alertLevel alert.Level) lua.LGFunction {
+// getLinks parses the list of the tables like {label = "Runbook", url = "https://..."}
+func getLinks(tbl *lua.LTable) ([]alert.Link, error) {
+	var links []alert.Link
+	var err error
+
+	tbl.ForEach(func(_ lua.LValue, value lua.LValue) {
+		if err != nil {
+			return
+		}
+		if value.Type() != lua.LTTable {
+			err = fmt.Errorf("link must be a table")
+			return
+		}
+		t := value.(*lua.LTable)
+
+		label := t.RawGetString("label")
+		u := t.RawGetString("url")
+		if label.Type() != lua.LTString || u.Type() != lua.LTString {
+			err = fmt.Errorf("link label and url must be strings")
+			return
+		}
+
+		l := alert.Link{Label: label.String(), URL: u.String()}
+		if err = l.Validate(); err != nil {
+			return
+		}
+
+		links = append(links, l)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// getAttachments parses the list of the tables like {name = "rows.csv", data = csv, content_type = "text/csv"}.
+// The content type is detected by the file extension or the data, if it is not set
+func getAttachments(tbl *lua.LTable) ([]alert.Attachment, error) {
+	var attachments []alert.Attachment
+	var err error
+
+	tbl.ForEach(func(_ lua.LValue, value lua.LValue) {
+		if err != nil {
+			return
+		}
+		if value.Type() != lua.LTTable {
+			err = fmt.Errorf("attachment must be a table")
+			return
+		}
+		t := value.(*lua.LTable)
+
+		name := t.RawGetString("name")
+		if name.Type() != lua.LTString || name.String() == "" {
+			err = fmt.Errorf("attachment name must be a not empty string")
+			return
+		}
+		data := t.RawGetString("data")
+		if data.Type() != lua.LTString {
+			err = fmt.Errorf("attachment data must be a string")
+			return
+		}
+
+		a := alert.Attachment{
+			Name: name.String(),
+			Data: []byte(data.String()),
+		}
+
+		contentType := t.RawGetString("content_type")
+		switch contentType.Type() {
+		case lua.LTNil:
+			a.ContentType = mime.TypeByExtension(path.Ext(a.Name))
+			if a.ContentType == "" {
+				a.ContentType = http.DetectContentType(a.Data)
+			}
+		case lua.LTString:
+			a.ContentType = contentType.String()
+		default:
+			err = fmt.Errorf("attachment content_type must be a string")
+			return
+		}
+
+		attachments = append(attachments, a)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (a *Alert) callFromLua(scriptName string, scriptChannels []string, escalate map[int][]string, alertLevel alert.Level) lua.LGFunction {
 	return func(luaState *lua.LState) int {
 		name, text, options, err := a.getAlertData(luaState)
 		if err != nil {
@@ -142,6 +262,7 @@ func (a *Alert) callFromLua(scriptChannels []string, escalate map[int][]string,
 			luaState.Push(lua.LString("error get arguments: " + err.Error()))
 			return 1
 		}
+		options.Script = scriptName
 
 		_, _, errCall := a.call(name, text, scriptChannels, escalate, alertLevel, options)
 		if errCall != nil {
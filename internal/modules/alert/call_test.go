@@ -451,6 +451,203 @@ func TestManager_getAlertData(t *testing.T) {
 			wantErr:          true,
 			wantErrString:    "fields option must be a table",
 		},
+		{
+			name:   "with links",
+			fields: defaultFields,
+			args: args{
+				luaState: func() *lua.LState {
+					L := lua.NewState()
+					L.Push(lua.LString("alertName1"))
+					L.Push(lua.LString("alertText1"))
+					l1 := &lua.LTable{}
+					l1.RawSetString("label", lua.LString("Runbook"))
+					l1.RawSetString("url", lua.LString("https://wiki.example.com/runbook"))
+					links := &lua.LTable{}
+					links.Append(l1)
+					opts := &lua.LTable{}
+					opts.RawSet(lua.LString("links"), links)
+					L.Push(opts)
+					return L
+				}(),
+			},
+			wantAlertName:    "alertName1",
+			wantAlertText:    "alertText1",
+			wantAlertOptions: &alert2.Options{Links: []alert2.Link{{Label: "Runbook", URL: "https://wiki.example.com/runbook"}}},
+			wantErr:          false,
+			wantErrString:    "",
+		},
+		{
+			name:   "with links not a table",
+			fields: defaultFields,
+			args: args{
+				luaState: func() *lua.LState {
+					L := lua.NewState()
+					L.Push(lua.LString("alertName1"))
+					L.Push(lua.LString("alertText1"))
+					opts := &lua.LTable{}
+					opts.RawSet(lua.LString("links"), lua.LString("foo"))
+					L.Push(opts)
+					return L
+				}(),
+			},
+			wantAlertName:    "alertName1",
+			wantAlertText:    "alertText1",
+			wantAlertOptions: &alert2.Options{},
+			wantErr:          true,
+			wantErrString:    "links must be a table",
+		},
+		{
+			name:   "with link url not a string",
+			fields: defaultFields,
+			args: args{
+				luaState: func() *lua.LState {
+					L := lua.NewState()
+					L.Push(lua.LString("alertName1"))
+					L.Push(lua.LString("alertText1"))
+					l1 := &lua.LTable{}
+					l1.RawSetString("label", lua.LString("Runbook"))
+					l1.RawSetString("url", lua.LNumber(42))
+					links := &lua.LTable{}
+					links.Append(l1)
+					opts := &lua.LTable{}
+					opts.RawSet(lua.LString("links"), links)
+					L.Push(opts)
+					return L
+				}(),
+			},
+			wantAlertName:    "alertName1",
+			wantAlertText:    "alertText1",
+			wantAlertOptions: &alert2.Options{},
+			wantErr:          true,
+			wantErrString:    "link label and url must be strings",
+		},
+		{
+			name:   "with link bad url",
+			fields: defaultFields,
+			args: args{
+				luaState: func() *lua.LState {
+					L := lua.NewState()
+					L.Push(lua.LString("alertName1"))
+					L.Push(lua.LString("alertText1"))
+					l1 := &lua.LTable{}
+					l1.RawSetString("label", lua.LString("Runbook"))
+					l1.RawSetString("url", lua.LString("wiki"))
+					links := &lua.LTable{}
+					links.Append(l1)
+					opts := &lua.LTable{}
+					opts.RawSet(lua.LString("links"), links)
+					L.Push(opts)
+					return L
+				}(),
+			},
+			wantAlertName:    "alertName1",
+			wantAlertText:    "alertText1",
+			wantAlertOptions: &alert2.Options{},
+			wantErr:          true,
+			wantErrString:    "link url must be an absolute http or https url, wiki",
+		},
+		{
+			name:   "with attachments",
+			fields: defaultFields,
+			args: args{
+				luaState: func() *lua.LState {
+					L := lua.NewState()
+					L.Push(lua.LString("alertName1"))
+					L.Push(lua.LString("alertText1"))
+					a1 := &lua.LTable{}
+					a1.RawSetString("name", lua.LString("rows.csv"))
+					a1.RawSetString("data", lua.LString("a,b"))
+					a2 := &lua.LTable{}
+					a2.RawSetString("name", lua.LString("dump"))
+					a2.RawSetString("data", lua.LString("{}"))
+					a2.RawSetString("content_type", lua.LString("application/json"))
+					attachments := &lua.LTable{}
+					attachments.Append(a1)
+					attachments.Append(a2)
+					opts := &lua.LTable{}
+					opts.RawSet(lua.LString("attachments"), attachments)
+					L.Push(opts)
+					return L
+				}(),
+			},
+			wantAlertName: "alertName1",
+			wantAlertText: "alertText1",
+			wantAlertOptions: &alert2.Options{Attachments: []alert2.Attachment{
+				{Name: "rows.csv", ContentType: "text/csv; charset=utf-8", Data: []byte("a,b")},
+				{Name: "dump", ContentType: "application/json", Data: []byte("{}")},
+			}},
+			wantErr:       false,
+			wantErrString: "",
+		},
+		{
+			name:   "with attachments not a table",
+			fields: defaultFields,
+			args: args{
+				luaState: func() *lua.LState {
+					L := lua.NewState()
+					L.Push(lua.LString("alertName1"))
+					L.Push(lua.LString("alertText1"))
+					opts := &lua.LTable{}
+					opts.RawSet(lua.LString("attachments"), lua.LString("foo"))
+					L.Push(opts)
+					return L
+				}(),
+			},
+			wantAlertName:    "alertName1",
+			wantAlertText:    "alertText1",
+			wantAlertOptions: &alert2.Options{},
+			wantErr:          true,
+			wantErrString:    "attachments must be a table",
+		},
+		{
+			name:   "with attachment without name",
+			fields: defaultFields,
+			args: args{
+				luaState: func() *lua.LState {
+					L := lua.NewState()
+					L.Push(lua.LString("alertName1"))
+					L.Push(lua.LString("alertText1"))
+					a1 := &lua.LTable{}
+					a1.RawSetString("data", lua.LString("a,b"))
+					attachments := &lua.LTable{}
+					attachments.Append(a1)
+					opts := &lua.LTable{}
+					opts.RawSet(lua.LString("attachments"), attachments)
+					L.Push(opts)
+					return L
+				}(),
+			},
+			wantAlertName:    "alertName1",
+			wantAlertText:    "alertText1",
+			wantAlertOptions: &alert2.Options{},
+			wantErr:          true,
+			wantErrString:    "attachment name must be a not empty string",
+		},
+		{
+			name:   "with attachment data not a string",
+			fields: defaultFields,
+			args: args{
+				luaState: func() *lua.LState {
+					L := lua.NewState()
+					L.Push(lua.LString("alertName1"))
+					L.Push(lua.LString("alertText1"))
+					a1 := &lua.LTable{}
+					a1.RawSetString("name", lua.LString("rows.csv"))
+					a1.RawSetString("data", lua.LNumber(42))
+					attachments := &lua.LTable{}
+					attachments.Append(a1)
+					opts := &lua.LTable{}
+					opts.RawSet(lua.LString("attachments"), attachments)
+					L.Push(opts)
+					return L
+				}(),
+			},
+			wantAlertName:    "alertName1",
+			wantAlertText:    "alertText1",
+			wantAlertOptions: &alert2.Options{},
+			wantErr:          true,
+			wantErrString:    "attachment data must be a string",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -494,6 +691,12 @@ func optionsAreEqual(got, want alert2.Options) bool {
 	if got.Image != want.Image {
 		return false
 	}
+	if !reflect.DeepEqual(got.Attachments, want.Attachments) {
+		return false
+	}
+	if !reflect.DeepEqual(got.Links, want.Links) {
+		return false
+	}
 	for k, v := range got.Fields {
 		wantV, ok := want.Fields[k]
 		if !ok {
@@ -517,7 +720,7 @@ func TestAlert_call_error_get_alertData(t *testing.T) {
 		logger: zap.NewNop(),
 	}
 
-	f := a.callFromLua(nil, map[int][]string{}, alert2.LevelError)
+	f := a.callFromLua("", nil, map[int][]string{}, alert2.LevelError)
 
 	ls := lua.NewState()
 
@@ -544,7 +747,7 @@ func TestAlert_call_error_update(t *testing.T) {
 		},
 	}
 
-	f := a.callFromLua(j.Script().Channels, map[int][]string{}, alert2.LevelError)
+	f := a.callFromLua("", j.Script().Channels, map[int][]string{}, alert2.LevelError)
 
 	ls := lua.NewState()
 	ls.Push(lua.LString("foo"))
@@ -581,7 +784,7 @@ func TestAlert_call_level_was_updated(t *testing.T) {
 		},
 	}
 
-	f := a.callFromLua(j.Script().Channels, map[int][]string{}, alert2.LevelError)
+	f := a.callFromLua("", j.Script().Channels, map[int][]string{}, alert2.LevelError)
 
 	ls := lua.NewState()
 	ls.Push(lua.LString("foo"))
@@ -618,7 +821,7 @@ func TestAlert_call_level_was_not_updated(t *testing.T) {
 		},
 	}
 
-	f := a.callFromLua(j.Script().Channels, map[int][]string{}, alert2.LevelError)
+	f := a.callFromLua("", j.Script().Channels, map[int][]string{}, alert2.LevelError)
 
 	ls := lua.NewState()
 	ls.Push(lua.LString("foo"))
@@ -657,7 +860,7 @@ func TestAlert_call_repeat(t *testing.T) {
 		},
 	}
 
-	f := a.callFromLua(j.Script().Channels, map[int][]string{}, alert2.LevelError)
+	f := a.callFromLua("", j.Script().Channels, map[int][]string{}, alert2.LevelError)
 
 	ls := lua.NewState()
 	ls.Push(lua.LString("id"))
@@ -696,7 +899,7 @@ func TestAlert_call_escalate(t *testing.T) {
 		logger:    zap.NewNop(),
 	}
 
-	f := a.callFromLua(nil, map[int][]string{10: {"foo", "bar"}}, alert2.LevelError)
+	f := a.callFromLua("", nil, map[int][]string{10: {"foo", "bar"}}, alert2.LevelError)
 
 	ls := lua.NewState()
 	ls.Push(lua.LString("id"))
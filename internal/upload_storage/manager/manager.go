@@ -1,6 +1,7 @@
 package manager
 
 import (
+	"context"
 	"fmt"
 	"github.com/balerter/balerter/internal/config/storages/upload"
 	"github.com/balerter/balerter/internal/modules"
@@ -13,13 +14,20 @@ type Manager struct {
 	logger *zap.Logger
 
 	modules map[string]modules.Module
+	// uploaders contains the storages by the config name, they are used to upload the alerts attachments
+	uploaders map[string]uploader
+}
+
+type uploader interface {
+	Upload(ctx context.Context, filename, contentType string, data []byte) (string, error)
 }
 
 // New creates new upload storage manager
 func New(logger *zap.Logger) *Manager {
 	m := &Manager{
-		logger:  logger,
-		modules: make(map[string]modules.Module),
+		logger:    logger,
+		modules:   make(map[string]modules.Module),
+		uploaders: make(map[string]uploader),
 	}
 
 	return m
@@ -37,6 +45,7 @@ func (m *Manager) Init(cfg *upload.Upload) error {
 		}
 
 		m.modules[module.Name()] = module
+		m.uploaders[cfg.S3[idx].Name] = module
 	}
 
 	return nil
@@ -52,3 +61,20 @@ func (m *Manager) Get() []modules.Module {
 
 	return mm
 }
+
+// Upload uploads the file to the storage and returns the file URL.
+// The storage name may be empty, if only one storage is configured
+func (m *Manager) Upload(ctx context.Context, storage, filename, contentType string, data []byte) (string, error) {
+	if storage == "" && len(m.uploaders) == 1 {
+		for name := range m.uploaders {
+			storage = name
+		}
+	}
+
+	u, ok := m.uploaders[storage]
+	if !ok {
+		return "", fmt.Errorf("upload storage '%s' not found", storage)
+	}
+
+	return u.Upload(ctx, filename, contentType, data)
+}
@@ -1,6 +1,7 @@
 package manager
 
 import (
+	"context"
 	"testing"
 
 	"github.com/balerter/balerter/internal/config/storages/upload"
@@ -38,7 +39,8 @@ func TestManager_Get(t *testing.T) {
 
 func TestManager_Init(t *testing.T) {
 	m := &Manager{
-		modules: map[string]modules.Module{},
+		modules:   map[string]modules.Module{},
+		uploaders: map[string]uploader{},
 	}
 
 	err := m.Init(&upload.Upload{S3: []s3.S3{{
@@ -56,4 +58,39 @@ func TestManager_Init(t *testing.T) {
 	require.True(t, ok)
 
 	assert.Equal(t, "s3.f1", md.Name())
+	assert.Contains(t, m.uploaders, "f1")
+}
+
+type uploaderFunc func(ctx context.Context, filename, contentType string, data []byte) (string, error)
+
+func (f uploaderFunc) Upload(ctx context.Context, filename, contentType string, data []byte) (string, error) {
+	return f(ctx, filename, contentType, data)
+}
+
+func TestManager_Upload(t *testing.T) {
+	u := uploaderFunc(func(_ context.Context, filename, contentType string, data []byte) (string, error) {
+		assert.Equal(t, "rows.csv", filename)
+		assert.Equal(t, "text/csv", contentType)
+		assert.Equal(t, []byte("a,b"), data)
+		return "https://bucket.example.com/rows.csv", nil
+	})
+
+	m := &Manager{uploaders: map[string]uploader{"foo": u}}
+
+	url, err := m.Upload(context.Background(), "", "rows.csv", "text/csv", []byte("a,b"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://bucket.example.com/rows.csv", url)
+
+	url, err = m.Upload(context.Background(), "foo", "rows.csv", "text/csv", []byte("a,b"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://bucket.example.com/rows.csv", url)
+
+	_, err = m.Upload(context.Background(), "bar", "rows.csv", "text/csv", []byte("a,b"))
+	require.Error(t, err)
+	assert.Equal(t, "upload storage 'bar' not found", err.Error())
+
+	m.uploaders["bar"] = u
+	_, err = m.Upload(context.Background(), "", "rows.csv", "text/csv", []byte("a,b"))
+	require.Error(t, err)
+	assert.Equal(t, "upload storage '' not found", err.Error())
 }
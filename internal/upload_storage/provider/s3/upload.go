@@ -60,6 +60,23 @@ func (p *Provider) upload(luaState *lua.LState, extension string) int {
 
 	filename = filename + "." + extension
 
+	ctx, ctxCancel := context.WithTimeout(context.Background(), defaultUploadTimeout)
+	defer ctxCancel()
+
+	resultFilename, err := p.Upload(ctx, filename, "image/png", data)
+	if err != nil {
+		luaState.Push(lua.LNil)
+		luaState.Push(lua.LString(err.Error()))
+		return 2 //nolint:gomnd // params count
+	}
+
+	luaState.Push(lua.LString(resultFilename))
+
+	return 1
+}
+
+// Upload puts the public object to the bucket and returns the object URL
+func (p *Provider) Upload(ctx context.Context, filename, contentType string, data []byte) (string, error) {
 	creds := credentials.NewStaticCredentials(p.key, p.secret, "")
 
 	cfg := &aws.Config{
@@ -72,9 +89,6 @@ func (p *Provider) upload(luaState *lua.LState, extension string) int {
 
 	svc := s3.New(sess, cfg)
 
-	ctx, ctxCancel := context.WithTimeout(context.Background(), defaultUploadTimeout)
-	defer ctxCancel()
-
 	obj := &s3.PutObjectInput{}
 
 	obj.SetACL("public-read")
@@ -82,18 +96,12 @@ func (p *Provider) upload(luaState *lua.LState, extension string) int {
 	obj.SetKey(filename)
 	obj.SetBody(bytes.NewReader(data))
 	obj.SetContentLength(int64(len(data)))
-	obj.SetContentType("image/png")
+	obj.SetContentType(contentType)
 
-	_, err = svc.PutObjectWithContext(ctx, obj)
+	_, err := svc.PutObjectWithContext(ctx, obj)
 	if err != nil {
-		luaState.Push(lua.LNil)
-		luaState.Push(lua.LString("error upload object: " + err.Error()))
-		return 2 //nolint:gomnd // params count
+		return "", fmt.Errorf("error upload object: %w", err)
 	}
 
-	resultFilename := fmt.Sprintf("https://%s.%s/%s", p.bucket, p.endpoint, filename)
-
-	luaState.Push(lua.LString(resultFilename))
-
-	return 1
+	return fmt.Sprintf("https://%s.%s/%s", p.bucket, p.endpoint, filename), nil
 }
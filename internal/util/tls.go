@@ -0,0 +1,40 @@
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/balerter/balerter/internal/config/common"
+)
+
+// TLSConfig creates tls.Config from the TLS config section
+func TLSConfig(cfg *common.TLS) (*tls.Config, error) {
+	c := &tls.Config{
+		ServerName:         cfg.ServerName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, // nolint:gosec // configured by user
+	}
+
+	if cfg.CA != "" {
+		caCert, err := os.ReadFile(cfg.CA)
+		if err != nil {
+			return nil, fmt.Errorf("error read ca file, %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("error append ca certificate")
+		}
+		c.RootCAs = pool
+	}
+
+	if cfg.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.Cert, cfg.Key)
+		if err != nil {
+			return nil, fmt.Errorf("error load client certificate, %w", err)
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+
+	return c, nil
+}
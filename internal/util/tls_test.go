@@ -0,0 +1,72 @@
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/balerter/balerter/internal/config/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "balerter"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return certPath, keyPath
+}
+
+func TestTLSConfig(t *testing.T) {
+	certPath, keyPath := writeTestCert(t, t.TempDir())
+
+	c, err := TLSConfig(&common.TLS{CA: certPath, Cert: certPath, Key: keyPath, ServerName: "foo"})
+	require.NoError(t, err)
+	assert.Equal(t, "foo", c.ServerName)
+	assert.NotNil(t, c.RootCAs)
+	assert.Equal(t, 1, len(c.Certificates))
+}
+
+func TestTLSConfig_errors(t *testing.T) {
+	_, err := TLSConfig(&common.TLS{CA: "/not/exists"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error read ca file")
+
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.pem")
+	require.NoError(t, os.WriteFile(bad, []byte("foo"), 0600))
+
+	_, err = TLSConfig(&common.TLS{CA: bad})
+	require.Error(t, err)
+	assert.Equal(t, "error append ca certificate", err.Error())
+
+	_, err = TLSConfig(&common.TLS{Cert: bad, Key: bad})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error load client certificate")
+}